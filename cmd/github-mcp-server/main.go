@@ -77,6 +77,11 @@ var (
 				}
 			}
 
+			repoAllowlist, err := repoAllowlistFromConfig()
+			if err != nil {
+				return err
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			stdioServerConfig := ghmcp.StdioServerConfig{
 				Version:              version,
@@ -91,9 +96,13 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				DefaultPageSize:      viper.GetInt("default-page-size"),
 				LockdownMode:         viper.GetBool("lockdown-mode"),
 				InsidersMode:         viper.GetBool("insiders"),
 				ExcludeTools:         excludeTools,
+				RepoAllowlist:        repoAllowlist,
+				RedactSecrets:        viper.GetBool("redact-secrets"),
+				SecretPatterns:       viper.GetStringSlice("secret-patterns"),
 				RepoAccessCacheTTL:   &ttl,
 			}
 			return ghmcp.RunStdioServer(stdioServerConfig)
@@ -105,6 +114,11 @@ var (
 		Short: "Start HTTP server",
 		Long:  `Start an HTTP server that listens for MCP requests over HTTP.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
+			repoAllowlist, err := repoAllowlistFromConfig()
+			if err != nil {
+				return err
+			}
+
 			ttl := viper.GetDuration("repo-access-cache-ttl")
 			httpConfig := ghhttp.ServerConfig{
 				Version:              version,
@@ -116,7 +130,11 @@ var (
 				EnableCommandLogging: viper.GetBool("enable-command-logging"),
 				LogFilePath:          viper.GetString("log-file"),
 				ContentWindowSize:    viper.GetInt("content-window-size"),
+				DefaultPageSize:      viper.GetInt("default-page-size"),
 				LockdownMode:         viper.GetBool("lockdown-mode"),
+				RepoAllowlist:        repoAllowlist,
+				RedactSecrets:        viper.GetBool("redact-secrets"),
+				SecretPatterns:       viper.GetStringSlice("secret-patterns"),
 				RepoAccessCacheTTL:   &ttl,
 				ScopeChallenge:       viper.GetBool("scope-challenge"),
 			}
@@ -144,7 +162,11 @@ func init() {
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Save translations to a JSON file")
 	rootCmd.PersistentFlags().String("gh-host", "", "Specify the GitHub hostname (for GitHub Enterprise etc.)")
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
+	rootCmd.PersistentFlags().Int("default-page-size", 0, "Default number of results per page for list tools when perPage is omitted (max 100, 0 uses GitHub's default)")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
+	rootCmd.PersistentFlags().Bool("redact-secrets", false, "Redact tokens and other secrets from the output of log and file content tools")
+	rootCmd.PersistentFlags().StringArray("secret-patterns", nil, "Additional regular expression to redact when --redact-secrets is set (may be repeated)")
+	rootCmd.PersistentFlags().StringSlice("repo-allowlist", nil, "Comma-separated list of owner/repo patterns (e.g. octo-org/*) that tools may act on; all repositories are allowed if unset")
 	rootCmd.PersistentFlags().Bool("insiders", false, "Enable insiders features")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
 
@@ -166,7 +188,11 @@ func init() {
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("host", rootCmd.PersistentFlags().Lookup("gh-host"))
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
+	_ = viper.BindPFlag("default-page-size", rootCmd.PersistentFlags().Lookup("default-page-size"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
+	_ = viper.BindPFlag("redact-secrets", rootCmd.PersistentFlags().Lookup("redact-secrets"))
+	_ = viper.BindPFlag("secret-patterns", rootCmd.PersistentFlags().Lookup("secret-patterns"))
+	_ = viper.BindPFlag("repo_allowlist", rootCmd.PersistentFlags().Lookup("repo-allowlist"))
 	_ = viper.BindPFlag("insiders", rootCmd.PersistentFlags().Lookup("insiders"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
 	_ = viper.BindPFlag("port", httpCmd.Flags().Lookup("port"))
@@ -178,6 +204,18 @@ func init() {
 	rootCmd.AddCommand(httpCmd)
 }
 
+// repoAllowlistFromConfig reads the repository allowlist from the --repo-allowlist
+// flag or the GITHUB_REPO_ALLOWLIST environment variable.
+func repoAllowlistFromConfig() ([]string, error) {
+	var repoAllowlist []string
+	if viper.IsSet("repo_allowlist") {
+		if err := viper.UnmarshalKey("repo_allowlist", &repoAllowlist); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal repo-allowlist: %w", err)
+		}
+	}
+	return repoAllowlist, nil
+}
+
 func initConfig() {
 	// Initialize Viper configuration
 	viper.SetEnvPrefix("github")
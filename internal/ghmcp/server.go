@@ -101,7 +101,26 @@ func createGitHubClients(cfg github.MCPServerConfig, apiHost utils.APIHostResolv
 	}, nil
 }
 
-func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Server, error) {
+// InventoryConfig holds the settings NewStdioMCPServer applies to the tool
+// inventory, which the MCP server itself does not use.
+type InventoryConfig struct {
+	// DefaultPageSize is the perPage list tools use when the caller omits it (0 = GitHub's default)
+	DefaultPageSize int
+
+	// RepoAllowlist restricts tools to repositories matching these "owner/repo"
+	// patterns (e.g. "octo-org/*"). Empty allows all repositories.
+	RepoAllowlist []string
+
+	// RedactSecrets scrubs tokens and other secrets from the output of log and
+	// file content tools, see github.SecretRedactor.
+	RedactSecrets bool
+
+	// SecretPatterns are regular expressions matched by the secret redaction in
+	// addition to github.DefaultSecretPatterns.
+	SecretPatterns []string
+}
+
+func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig, invCfg InventoryConfig) (*mcp.Server, error) {
 	apiHost, err := utils.NewAPIHost(cfg.Host)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API host: %w", err)
@@ -128,7 +147,10 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		},
 		cfg.ContentWindowSize,
 		featureChecker,
-	)
+	).WithAPIHost(apiHost)
+	// A stdio server serves a single session, so metadata can be cached for its lifetime
+	deps.RepoMetadataCache = github.NewRepoMetadataCache(github.DefaultRepoMetadataCacheTTL)
+
 	// Build and register the tool/resource/prompt inventory
 	inventoryBuilder := github.NewInventory(cfg.Translator).
 		WithDeprecatedAliases(github.DeprecatedToolAliases).
@@ -138,7 +160,18 @@ func NewStdioMCPServer(ctx context.Context, cfg github.MCPServerConfig) (*mcp.Se
 		WithExcludeTools(cfg.ExcludeTools).
 		WithServerInstructions().
 		WithFeatureChecker(featureChecker).
-		WithInsidersMode(cfg.InsidersMode)
+		WithInsidersMode(cfg.InsidersMode).
+		WithIncludeExperimental(cfg.InsidersMode).
+		WithDefaultPageSize(invCfg.DefaultPageSize).
+		WithRepoAllowlist(invCfg.RepoAllowlist)
+
+	if invCfg.RedactSecrets {
+		redactor, err := github.NewSecretRedactor(invCfg.SecretPatterns)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secret redactor: %w", err)
+		}
+		inventoryBuilder = inventoryBuilder.WithResultPostProcessor(redactor.PostProcessor())
+	}
 
 	// Apply token scope filtering if scopes are known (for PAT filtering)
 	if cfg.TokenScopes != nil {
@@ -209,6 +242,9 @@ type StdioServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultPageSize is the perPage list tools use when the caller omits it (0 = GitHub's default)
+	DefaultPageSize int
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
@@ -220,6 +256,18 @@ type StdioServerConfig struct {
 	// explicitly listed in EnabledTools.
 	ExcludeTools []string
 
+	// RepoAllowlist restricts tools to repositories matching these "owner/repo"
+	// patterns (e.g. "octo-org/*"). Empty allows all repositories.
+	RepoAllowlist []string
+
+	// RedactSecrets scrubs tokens and other secrets from the output of log and
+	// file content tools, see github.SecretRedactor.
+	RedactSecrets bool
+
+	// SecretPatterns are regular expressions matched by the secret redaction in
+	// addition to github.DefaultSecretPatterns.
+	SecretPatterns []string
+
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 }
@@ -281,6 +329,11 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		Logger:            logger,
 		RepoAccessTTL:     cfg.RepoAccessCacheTTL,
 		TokenScopes:       tokenScopes,
+	}, InventoryConfig{
+		DefaultPageSize: cfg.DefaultPageSize,
+		RepoAllowlist:   cfg.RepoAllowlist,
+		RedactSecrets:   cfg.RedactSecrets,
+		SecretPatterns:  cfg.SecretPatterns,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
@@ -6,8 +6,12 @@ import (
 	"github.com/github/github-mcp-server/pkg/utils"
 )
 
+// tokenCtxKey is the context key for the TokenInfo of the current request.
 type tokenCtxKey struct{}
 
+// TokenInfo is the GitHub token that authenticates the current request. When present
+// in the context, tool dependencies build request-scoped clients from it instead of
+// using a server-wide token.
 type TokenInfo struct {
 	Token     string
 	TokenType utils.TokenType
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/google/go-github/v82/github"
@@ -65,6 +66,9 @@ func (e *GitHubRawAPIError) Error() string {
 
 type GitHubErrorKey struct{}
 type GitHubCtxErrors struct {
+	// mu guards the slices, which handlers running concurrently on one context
+	// (e.g. batch_call sub-calls) may append to at the same time
+	mu      sync.Mutex
 	api     []*GitHubAPIError
 	graphQL []*GitHubGraphQLError
 	raw     []*GitHubRawAPIError
@@ -77,9 +81,11 @@ func ContextWithGitHubErrors(ctx context.Context) context.Context {
 	}
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
 		// If the context already has GitHubCtxErrors, we just empty the slices to start fresh
+		val.mu.Lock()
 		val.api = []*GitHubAPIError{}
 		val.graphQL = []*GitHubGraphQLError{}
 		val.raw = []*GitHubRawAPIError{}
+		val.mu.Unlock()
 	} else {
 		// If not, we create a new GitHubCtxErrors and set it in the context
 		ctx = context.WithValue(ctx, GitHubErrorKey{}, &GitHubCtxErrors{})
@@ -91,6 +97,8 @@ func ContextWithGitHubErrors(ctx context.Context) context.Context {
 // GetGitHubAPIErrors retrieves the slice of GitHubAPIErrors from the context.
 func GetGitHubAPIErrors(ctx context.Context) ([]*GitHubAPIError, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		return val.api, nil // return the slice of API errors from the context
 	}
 	return nil, fmt.Errorf("context does not contain GitHubCtxErrors")
@@ -99,6 +107,8 @@ func GetGitHubAPIErrors(ctx context.Context) ([]*GitHubAPIError, error) {
 // GetGitHubGraphQLErrors retrieves the slice of GitHubGraphQLErrors from the context.
 func GetGitHubGraphQLErrors(ctx context.Context) ([]*GitHubGraphQLError, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		return val.graphQL, nil // return the slice of GraphQL errors from the context
 	}
 	return nil, fmt.Errorf("context does not contain GitHubCtxErrors")
@@ -107,6 +117,8 @@ func GetGitHubGraphQLErrors(ctx context.Context) ([]*GitHubGraphQLError, error)
 // GetGitHubRawAPIErrors retrieves the slice of GitHubRawAPIErrors from the context.
 func GetGitHubRawAPIErrors(ctx context.Context) ([]*GitHubRawAPIError, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		return val.raw, nil // return the slice of raw API errors from the context
 	}
 	return nil, fmt.Errorf("context does not contain GitHubCtxErrors")
@@ -130,6 +142,8 @@ func NewGitHubGraphQLErrorToCtx(ctx context.Context, message string, err error)
 
 func addGitHubAPIErrorToContext(ctx context.Context, err *GitHubAPIError) (context.Context, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		val.api = append(val.api, err) // append the error to the existing slice in the context
 		return ctx, nil
 	}
@@ -138,6 +152,8 @@ func addGitHubAPIErrorToContext(ctx context.Context, err *GitHubAPIError) (conte
 
 func addGitHubGraphQLErrorToContext(ctx context.Context, err *GitHubGraphQLError) (context.Context, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		val.graphQL = append(val.graphQL, err) // append the error to the existing slice in the context
 		return ctx, nil
 	}
@@ -146,6 +162,8 @@ func addGitHubGraphQLErrorToContext(ctx context.Context, err *GitHubGraphQLError
 
 func addRawAPIErrorToContext(ctx context.Context, err *GitHubRawAPIError) (context.Context, error) {
 	if val, ok := ctx.Value(GitHubErrorKey{}).(*GitHubCtxErrors); ok {
+		val.mu.Lock()
+		defer val.mu.Unlock()
 		val.raw = append(val.raw, err)
 		return ctx, nil
 	}
@@ -159,7 +177,11 @@ func NewGitHubAPIErrorResponse(ctx context.Context, message string, resp *github
 	if ctx != nil {
 		_, _ = addGitHubAPIErrorToContext(ctx, apiErr) // Explicitly ignore error for graceful handling
 	}
-	return utils.NewToolResultErrorFromErr(message, err)
+	var httpResp *http.Response
+	if resp != nil {
+		httpResp = resp.Response
+	}
+	return withErrorKind(utils.NewToolResultErrorFromErr(message, err), classify(httpResp, err))
 }
 
 // NewGitHubGraphQLErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
@@ -168,7 +190,7 @@ func NewGitHubGraphQLErrorResponse(ctx context.Context, message string, err erro
 	if ctx != nil {
 		_, _ = addGitHubGraphQLErrorToContext(ctx, graphQLErr) // Explicitly ignore error for graceful handling
 	}
-	return utils.NewToolResultErrorFromErr(message, err)
+	return withErrorKind(utils.NewToolResultErrorFromErr(message, err), ClassifyError(err))
 }
 
 // NewGitHubRawAPIErrorResponse returns an mcp.NewToolResultError and retains the error in the context for access via middleware
@@ -177,7 +199,7 @@ func NewGitHubRawAPIErrorResponse(ctx context.Context, message string, resp *htt
 	if ctx != nil {
 		_, _ = addRawAPIErrorToContext(ctx, rawErr) // Explicitly ignore error for graceful handling
 	}
-	return utils.NewToolResultErrorFromErr(message, err)
+	return withErrorKind(utils.NewToolResultErrorFromErr(message, err), classify(resp, err))
 }
 
 // NewGitHubAPIStatusErrorResponse handles cases where the API call succeeds (err == nil)
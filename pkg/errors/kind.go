@@ -0,0 +1,124 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// ErrorKind is a machine-readable classification of a failed GitHub call. It
+// lets callers tell, for example, a missing resource from a rate limit when
+// deciding whether to retry.
+type ErrorKind string
+
+const (
+	ErrorKindNotFound    ErrorKind = "not_found"
+	ErrorKindForbidden   ErrorKind = "forbidden"
+	ErrorKindRateLimited ErrorKind = "rate_limited"
+	ErrorKindValidation  ErrorKind = "validation"
+	ErrorKindConflict    ErrorKind = "conflict"
+	ErrorKindTransient   ErrorKind = "transient"
+	ErrorKindUnknown     ErrorKind = "unknown"
+)
+
+// Retryable reports whether the same call may succeed if retried later.
+func (k ErrorKind) Retryable() bool {
+	return k == ErrorKindRateLimited || k == ErrorKindTransient
+}
+
+// ClassifyError derives an ErrorKind from an error returned by the go-github or
+// githubv4 clients.
+func ClassifyError(err error) ErrorKind {
+	if err == nil {
+		return ErrorKindUnknown
+	}
+
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return ErrorKindRateLimited
+	}
+
+	var acceptedErr *github.AcceptedError
+	if errors.As(err, &acceptedErr) {
+		// The request was accepted but the result is still being computed.
+		return ErrorKindTransient
+	}
+
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return ClassifyResponse(errResp.Response)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorKindTransient
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrorKindTransient
+	}
+
+	// GraphQL errors carry no status code, only the message from the API.
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "rate limit"):
+		return ErrorKindRateLimited
+	case strings.Contains(msg, "could not resolve to"):
+		return ErrorKindNotFound
+	}
+
+	return ErrorKindUnknown
+}
+
+// ClassifyResponse derives an ErrorKind from the status code and rate limit
+// headers of an unsuccessful HTTP response.
+func ClassifyResponse(resp *http.Response) ErrorKind {
+	if resp == nil {
+		return ErrorKindUnknown
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case resp.StatusCode == http.StatusForbidden:
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != "" {
+			return ErrorKindRateLimited
+		}
+		return ErrorKindForbidden
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrorKindForbidden
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrorKindNotFound
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity:
+		return ErrorKindValidation
+	case resp.StatusCode == http.StatusConflict:
+		return ErrorKindConflict
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return ErrorKindTransient
+	}
+
+	return ErrorKindUnknown
+}
+
+// classify picks the most specific ErrorKind available from err and resp.
+func classify(resp *http.Response, err error) ErrorKind {
+	if kind := ClassifyError(err); kind != ErrorKindUnknown {
+		return kind
+	}
+	return ClassifyResponse(resp)
+}
+
+// withErrorKind attaches kind to an error result as structured content so that
+// clients can act on it without parsing the message text.
+func withErrorKind(result *mcp.CallToolResult, kind ErrorKind) *mcp.CallToolResult {
+	result.StructuredContent = map[string]any{
+		"error_kind": kind,
+		"retryable":  kind.Retryable(),
+	}
+	return result
+}
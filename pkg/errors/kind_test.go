@@ -0,0 +1,117 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newErrorResponse(statusCode int, header http.Header) *github.ErrorResponse {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &github.ErrorResponse{
+		Response: &http.Response{StatusCode: statusCode, Header: header},
+		Message:  http.StatusText(statusCode),
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected ErrorKind
+	}{
+		{
+			name:     "404 is not found",
+			err:      newErrorResponse(http.StatusNotFound, nil),
+			expected: ErrorKindNotFound,
+		},
+		{
+			name:     "403 with exhausted rate limit is rate limited",
+			err:      newErrorResponse(http.StatusForbidden, http.Header{"X-Ratelimit-Remaining": []string{"0"}}),
+			expected: ErrorKindRateLimited,
+		},
+		{
+			name:     "go-github rate limit error is rate limited",
+			err:      &github.RateLimitError{Response: &http.Response{StatusCode: http.StatusForbidden}},
+			expected: ErrorKindRateLimited,
+		},
+		{
+			name:     "403 without rate limit headers is forbidden",
+			err:      newErrorResponse(http.StatusForbidden, nil),
+			expected: ErrorKindForbidden,
+		},
+		{
+			name:     "422 is validation",
+			err:      newErrorResponse(http.StatusUnprocessableEntity, nil),
+			expected: ErrorKindValidation,
+		},
+		{
+			name:     "409 is conflict",
+			err:      newErrorResponse(http.StatusConflict, nil),
+			expected: ErrorKindConflict,
+		},
+		{
+			name:     "502 is transient",
+			err:      newErrorResponse(http.StatusBadGateway, nil),
+			expected: ErrorKindTransient,
+		},
+		{
+			name:     "wrapped error is unwrapped",
+			err:      fmt.Errorf("failed to get issue: %w", newErrorResponse(http.StatusNotFound, nil)),
+			expected: ErrorKindNotFound,
+		},
+		{
+			name:     "deadline exceeded is transient",
+			err:      context.DeadlineExceeded,
+			expected: ErrorKindTransient,
+		},
+		{
+			name:     "GraphQL unresolvable node is not found",
+			err:      fmt.Errorf("Could not resolve to a Repository with the name 'owner/missing'."),
+			expected: ErrorKindNotFound,
+		},
+		{
+			name:     "anything else is unknown",
+			err:      fmt.Errorf("boom"),
+			expected: ErrorKindUnknown,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ClassifyError(tc.err))
+		})
+	}
+}
+
+func TestErrorResponsesIncludeErrorKind(t *testing.T) {
+	t.Run("API error response", func(t *testing.T) {
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		result := NewGitHubAPIErrorResponse(context.Background(), "failed to get issue", resp, newErrorResponse(http.StatusNotFound, nil))
+
+		require.True(t, result.IsError)
+		assert.Equal(t, map[string]any{"error_kind": ErrorKindNotFound, "retryable": false}, result.StructuredContent)
+	})
+
+	t.Run("status error response is classified from the response", func(t *testing.T) {
+		resp := &github.Response{Response: &http.Response{StatusCode: http.StatusUnprocessableEntity}}
+		result := NewGitHubAPIStatusErrorResponse(context.Background(), "failed to create issue", resp, []byte(`{"message":"Validation Failed"}`))
+
+		require.True(t, result.IsError)
+		assert.Equal(t, map[string]any{"error_kind": ErrorKindValidation, "retryable": false}, result.StructuredContent)
+	})
+
+	t.Run("GraphQL error response", func(t *testing.T) {
+		result := NewGitHubGraphQLErrorResponse(context.Background(), "failed to query", fmt.Errorf("API rate limit exceeded"))
+
+		require.True(t, result.IsError)
+		assert.Equal(t, map[string]any{"error_kind": ErrorKindRateLimited, "retryable": true}, result.StructuredContent)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -24,6 +25,13 @@ import (
 const (
 	DescriptionRepositoryOwner = "Repository owner"
 	DescriptionRepositoryName  = "Repository name"
+
+	// maxWorkflowFileContentBytes caps the workflow file contents returned by get_workflow.
+	maxWorkflowFileContentBytes = 64 * 1024
+
+	// defaultCancelRunsLimit and maxCancelRunsLimit bound how many runs cancel_workflow_runs cancels.
+	defaultCancelRunsLimit = 20
+	maxCancelRunsLimit     = 100
 )
 
 // Method constants for consolidated actions tools
@@ -38,15 +46,17 @@ const (
 	actionsMethodGetWorkflowRunUsage      = "get_workflow_run_usage"
 	actionsMethodGetWorkflowRunLogsURL    = "get_workflow_run_logs_url"
 	actionsMethodDownloadWorkflowArtifact = "download_workflow_run_artifact"
+	actionsMethodGetWorkflowArtifact      = "get_workflow_run_artifact"
 	actionsMethodRunWorkflow              = "run_workflow"
 	actionsMethodRerunWorkflowRun         = "rerun_workflow_run"
 	actionsMethodRerunFailedJobs          = "rerun_failed_jobs"
 	actionsMethodCancelWorkflowRun        = "cancel_workflow_run"
+	actionsMethodCancelWorkflowRuns       = "cancel_workflow_runs"
 	actionsMethodDeleteWorkflowRunLogs    = "delete_workflow_run_logs"
 )
 
 // handleFailedJobLogs gets logs for all failed jobs in a workflow run
-func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64, returnContent bool, chunked bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
 	// First, get all jobs for the workflow run
 	jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, runID, &github.ListWorkflowJobsOptions{
 		Filter: "latest",
@@ -78,7 +88,7 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 	// Collect logs for all failed jobs
 	var logResults []map[string]any
 	for _, job := range failedJobs {
-		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, tailLines, contentWindowSize)
+		jobResult, resp, err := getJobLogData(ctx, client, owner, repo, job.GetID(), job.GetName(), returnContent, chunked, tailLines, contentWindowSize)
 		if err != nil {
 			// Continue with other jobs even if one fails
 			jobResult = map[string]any{
@@ -111,8 +121,8 @@ func handleFailedJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // handleSingleJobLogs gets logs for a single job
-func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, returnContent bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
-	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", returnContent, tailLines, contentWindowSize)
+func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo string, jobID int64, returnContent bool, chunked bool, tailLines int, contentWindowSize int) (*mcp.CallToolResult, any, error) {
+	jobResult, resp, err := getJobLogData(ctx, client, owner, repo, jobID, "", returnContent, chunked, tailLines, contentWindowSize)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get job logs", resp, err), nil, nil
 	}
@@ -126,7 +136,7 @@ func handleSingleJobLogs(ctx context.Context, client *github.Client, owner, repo
 }
 
 // getJobLogData retrieves log data for a single job, either as URL or content
-func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, tailLines int, contentWindowSize int) (map[string]any, *github.Response, error) {
+func getJobLogData(ctx context.Context, client *github.Client, owner, repo string, jobID int64, jobName string, returnContent bool, chunked bool, tailLines int, contentWindowSize int) (map[string]any, *github.Response, error) {
 	// Get the download URL for the job logs
 	url, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
 	if err != nil {
@@ -154,6 +164,18 @@ func getJobLogData(ctx context.Context, client *github.Client, owner, repo strin
 		result["logs_content"] = content
 		result["message"] = "Job logs content retrieved successfully"
 		result["original_length"] = originalLength
+		if chunked {
+			chunk, err := resultChunkStoreFromContext(ctx).chunk(content)
+			if err != nil {
+				return nil, resp, err
+			}
+			if chunk.NextCursor != "" {
+				result["logs_content"] = chunk.Content
+				result["total_chunks"] = chunk.TotalChunks
+				result["next_cursor"] = chunk.NextCursor
+				result["message"] = "Job logs content retrieved successfully. Use get_result_chunk with next_cursor to read the remaining chunks"
+			}
+		}
 	} else {
 		// Return just the URL
 		result["logs_url"] = url.String()
@@ -318,6 +340,11 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 						Minimum:     jsonschema.Ptr(1.0),
 						Maximum:     jsonschema.Ptr(100.0),
 					},
+					"refresh": {
+						Type:        "boolean",
+						Description: "Bypass the session cache and fetch fresh results. Only used for 'list_workflows' method.",
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"method", "owner", "repo"},
 			},
@@ -349,6 +376,11 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			refresh, err := OptionalBoolParamWithDefault(args, "refresh", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
@@ -376,7 +408,7 @@ Use this tool to list workflows in a repository, or list workflow runs, jobs, an
 
 			switch method {
 			case actionsMethodListWorkflows:
-				return listWorkflows(ctx, client, owner, repo, pagination)
+				return listWorkflows(ctx, client, owner, repo, pagination, refresh)
 			case actionsMethodListWorkflowRuns:
 				return listWorkflowRuns(ctx, client, args, owner, repo, resourceID, pagination)
 			case actionsMethodListWorkflowJobs:
@@ -414,6 +446,7 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 							actionsMethodGetWorkflow,
 							actionsMethodGetWorkflowRun,
 							actionsMethodGetWorkflowJob,
+							actionsMethodGetWorkflowArtifact,
 							actionsMethodDownloadWorkflowArtifact,
 							actionsMethodGetWorkflowRunUsage,
 							actionsMethodGetWorkflowRunLogsURL,
@@ -432,10 +465,15 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 						Description: `The unique identifier of the resource. This will vary based on the "method" provided, so ensure you provide the correct ID:
 - Provide a workflow ID or workflow file name (e.g. ci.yaml) for 'get_workflow' method.
 - Provide a workflow run ID for 'get_workflow_run', 'get_workflow_run_usage', and 'get_workflow_run_logs_url' methods.
-- Provide an artifact ID for 'download_workflow_run_artifact' method.
+- Provide an artifact ID for 'get_workflow_run_artifact' and 'download_workflow_run_artifact' methods.
 - Provide a job ID for 'get_workflow_job' method.
 `,
 					},
+					"include_content": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Include the workflow file's YAML from the default branch, truncated to %d bytes. Only used for 'get_workflow' method.", maxWorkflowFileContentBytes),
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"method", "owner", "repo", "resource_id"},
 			},
@@ -459,6 +497,10 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			includeContent, err := OptionalBoolParamWithDefault(args, "include_content", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -480,11 +522,13 @@ Use this tool to get details about individual workflows, workflow runs, jobs, an
 
 			switch method {
 			case actionsMethodGetWorkflow:
-				return getWorkflow(ctx, client, owner, repo, resourceID)
+				return getWorkflow(ctx, client, owner, repo, resourceID, includeContent)
 			case actionsMethodGetWorkflowRun:
 				return getWorkflowRun(ctx, client, owner, repo, resourceIDInt)
 			case actionsMethodGetWorkflowJob:
 				return getWorkflowJob(ctx, client, owner, repo, resourceIDInt)
+			case actionsMethodGetWorkflowArtifact:
+				return getWorkflowArtifact(ctx, client, owner, repo, resourceIDInt)
 			case actionsMethodDownloadWorkflowArtifact:
 				return downloadWorkflowArtifact(ctx, client, owner, repo, resourceIDInt)
 			case actionsMethodGetWorkflowRunUsage:
@@ -505,7 +549,7 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 		ToolsetMetadataActions,
 		mcp.Tool{
 			Name:        "actions_run_trigger",
-			Description: t("TOOL_ACTIONS_RUN_TRIGGER_DESCRIPTION", "Trigger GitHub Actions workflow operations, including running, re-running, cancelling workflow runs, and deleting workflow run logs."),
+			Description: t("TOOL_ACTIONS_RUN_TRIGGER_DESCRIPTION", "Trigger GitHub Actions workflow operations, including running, re-running, cancelling workflow runs (individually or all active runs on a branch or workflow), and deleting workflow run logs."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_ACTIONS_RUN_TRIGGER_USER_TITLE", "Trigger GitHub Actions workflow actions"),
 				ReadOnlyHint:    false,
@@ -522,6 +566,7 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 							actionsMethodRerunWorkflowRun,
 							actionsMethodRerunFailedJobs,
 							actionsMethodCancelWorkflowRun,
+							actionsMethodCancelWorkflowRuns,
 							actionsMethodDeleteWorkflowRunLogs,
 						},
 					},
@@ -535,19 +580,34 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 					},
 					"workflow_id": {
 						Type:        "string",
-						Description: "The workflow ID (numeric) or workflow file name (e.g., main.yml, ci.yaml). Required for 'run_workflow' method.",
+						Description: "The workflow ID (numeric) or workflow file name (e.g., main.yml, ci.yaml). Required for 'run_workflow' method. For 'cancel_workflow_runs', limits cancellation to runs of this workflow.",
 					},
 					"ref": {
 						Type:        "string",
 						Description: "The git reference for the workflow. The reference can be a branch or tag name. Required for 'run_workflow' method.",
 					},
+					"branch": {
+						Type:        "string",
+						Description: "Cancel only runs on this branch. For 'cancel_workflow_runs' method, at least one of branch or workflow_id is required.",
+					},
+					"max_runs": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of runs to cancel (default %d, max %d). Only used for 'cancel_workflow_runs' method.", defaultCancelRunsLimit, maxCancelRunsLimit),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxCancelRunsLimit)),
+					},
 					"inputs": {
 						Type:        "object",
 						Description: "Inputs the workflow accepts. Only used for 'run_workflow' method.",
 					},
+					"validate_inputs": {
+						Type:        "boolean",
+						Description: "Check inputs against the workflow file's required workflow_dispatch inputs before dispatching. Only used for 'run_workflow' method.",
+						Default:     json.RawMessage(`true`),
+					},
 					"run_id": {
 						Type:        "number",
-						Description: "The ID of the workflow run. Required for all methods except 'run_workflow'.",
+						Description: "The ID of the workflow run. Required for all methods except 'run_workflow' and 'cancel_workflow_runs'.",
 					},
 				},
 				Required: []string{"method", "owner", "repo"},
@@ -572,6 +632,10 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 			workflowID, _ := OptionalParam[string](args, "workflow_id")
 			ref, _ := OptionalParam[string](args, "ref")
 			runID, _ := OptionalIntParam(args, "run_id")
+			validateInputs, err := OptionalBoolParamWithDefault(args, "validate_inputs", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			// Get optional inputs parameter
 			var inputs map[string]any
@@ -581,16 +645,35 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 				}
 			}
 
+			branch, err := OptionalParam[string](args, "branch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxRuns, err := OptionalIntParamWithDefault(args, "max_runs", defaultCancelRunsLimit)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			// Validate required parameters based on action type
-			if method == actionsMethodRunWorkflow {
+			switch method {
+			case actionsMethodRunWorkflow:
 				if workflowID == "" {
 					return utils.NewToolResultError("workflow_id is required for run_workflow action"), nil, nil
 				}
 				if ref == "" {
 					return utils.NewToolResultError("ref is required for run_workflow action"), nil, nil
 				}
-			} else if runID == 0 {
-				return utils.NewToolResultError("missing required parameter: run_id"), nil, nil
+			case actionsMethodCancelWorkflowRuns:
+				if branch == "" && workflowID == "" {
+					return utils.NewToolResultError("branch or workflow_id is required for cancel_workflow_runs action"), nil, nil
+				}
+				if maxRuns < 1 || maxRuns > maxCancelRunsLimit {
+					return utils.NewToolResultError(fmt.Sprintf("max_runs must be between 1 and %d", maxCancelRunsLimit)), nil, nil
+				}
+			default:
+				if runID == 0 {
+					return utils.NewToolResultError("missing required parameter: run_id"), nil, nil
+				}
 			}
 
 			client, err := deps.GetClient(ctx)
@@ -600,13 +683,15 @@ func ActionsRunTrigger(t translations.TranslationHelperFunc) inventory.ServerToo
 
 			switch method {
 			case actionsMethodRunWorkflow:
-				return runWorkflow(ctx, client, owner, repo, workflowID, ref, inputs)
+				return runWorkflow(ctx, client, owner, repo, workflowID, ref, inputs, validateInputs)
 			case actionsMethodRerunWorkflowRun:
 				return rerunWorkflowRun(ctx, client, owner, repo, int64(runID))
 			case actionsMethodRerunFailedJobs:
 				return rerunFailedJobs(ctx, client, owner, repo, int64(runID))
 			case actionsMethodCancelWorkflowRun:
 				return cancelWorkflowRun(ctx, client, owner, repo, int64(runID))
+			case actionsMethodCancelWorkflowRuns:
+				return cancelWorkflowRuns(ctx, client, owner, repo, branch, workflowID, maxRuns)
 			case actionsMethodDeleteWorkflowRunLogs:
 				return deleteWorkflowRunLogs(ctx, client, owner, repo, int64(runID))
 			default:
@@ -663,6 +748,10 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 						Description: "Number of lines to return from the end of the log",
 						Default:     json.RawMessage(`500`),
 					},
+					"chunked": {
+						Type:        "boolean",
+						Description: "When returning content, split large logs into chunks and return only the first. Use get_result_chunk with next_cursor to read the rest",
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -698,6 +787,11 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			chunked, err := OptionalParam[bool](args, "chunked")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
 			tailLines, err := OptionalIntParam(args, "tail_lines")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -722,21 +816,31 @@ For single job logs, provide job_id. For all failed jobs in a run, provide run_i
 
 			if failedOnly && runID > 0 {
 				// Handle failed-only mode: get logs for all failed jobs in the workflow run
-				return handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, tailLines, deps.GetContentWindowSize())
+				return handleFailedJobLogs(ctx, client, owner, repo, int64(runID), returnContent, chunked, tailLines, deps.GetContentWindowSize())
 			} else if jobID > 0 {
 				// Handle single job mode
-				return handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), returnContent, tailLines, deps.GetContentWindowSize())
+				return handleSingleJobLogs(ctx, client, owner, repo, int64(jobID), returnContent, chunked, tailLines, deps.GetContentWindowSize())
 			}
 
 			return utils.NewToolResultError("Either job_id must be provided for single job logs, or run_id with failed_only=true for failed job logs"), nil, nil
 		},
 	)
+	// Fetching logs for all failed jobs makes a request per job
+	tool.Cost = inventory.ToolCostLarge
 	return tool
 }
 
 // Helper functions for consolidated actions tools
 
-func getWorkflow(ctx context.Context, client *github.Client, owner, repo, resourceID string) (*mcp.CallToolResult, any, error) {
+// WorkflowWithContent is a workflow together with its file contents on the default branch.
+type WorkflowWithContent struct {
+	*github.Workflow
+	Content          string `json:"content"`
+	ContentSize      int    `json:"content_size"`
+	ContentTruncated bool   `json:"content_truncated,omitempty"`
+}
+
+func getWorkflow(ctx context.Context, client *github.Client, owner, repo, resourceID string, includeContent bool) (*mcp.CallToolResult, any, error) {
 	var workflow *github.Workflow
 	var resp *github.Response
 	var err error
@@ -752,7 +856,26 @@ func getWorkflow(ctx context.Context, client *github.Client, owner, repo, resour
 	}
 
 	defer func() { _ = resp.Body.Close() }()
-	r, err := json.Marshal(workflow)
+
+	var result any = workflow
+	if includeContent {
+		content, contentResp, err := getWorkflowFileContent(ctx, client, owner, repo, workflow.GetPath(), "")
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get workflow file contents", contentResp, err), nil, nil
+		}
+		withContent := WorkflowWithContent{
+			Workflow:    workflow,
+			Content:     content,
+			ContentSize: len(content),
+		}
+		if len(content) > maxWorkflowFileContentBytes {
+			withContent.Content = strings.ToValidUTF8(content[:maxWorkflowFileContentBytes], "")
+			withContent.ContentTruncated = true
+		}
+		result = withContent
+	}
+
+	r, err := json.Marshal(result)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal workflow: %w", err)
 	}
@@ -786,17 +909,19 @@ func getWorkflowJob(ctx context.Context, client *github.Client, owner, repo stri
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func listWorkflows(ctx context.Context, client *github.Client, owner, repo string, pagination PaginationParams) (*mcp.CallToolResult, any, error) {
+func listWorkflows(ctx context.Context, client *github.Client, owner, repo string, pagination PaginationParams, refresh bool) (*mcp.CallToolResult, any, error) {
 	opts := &github.ListOptions{
 		PerPage: pagination.PerPage,
 		Page:    pagination.Page,
 	}
 
-	workflows, resp, err := client.Actions.ListWorkflows(ctx, owner, repo, opts)
+	workflows, resp, err := listWorkflowsCached(ctx, client, owner, repo, opts, refresh)
 	if err != nil {
 		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflows", resp, err), nil, nil
 	}
-	defer func() { _ = resp.Body.Close() }()
+	if resp != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
 
 	r, err := json.Marshal(workflows)
 	if err != nil {
@@ -915,6 +1040,16 @@ func listWorkflowArtifacts(ctx context.Context, client *github.Client, owner, re
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+func getWorkflowArtifact(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
+	artifact, resp, err := client.Actions.GetArtifact(ctx, owner, repo, resourceID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get artifact", resp, err), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return MarshalledTextResult(convertToMinimalArtifact(artifact)), nil, nil
+}
+
 func downloadWorkflowArtifact(ctx context.Context, client *github.Client, owner, repo string, resourceID int64) (*mcp.CallToolResult, any, error) {
 	// Get the download URL for the artifact
 	url, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, resourceID, 1)
@@ -979,7 +1114,18 @@ func getWorkflowRunUsage(ctx context.Context, client *github.Client, owner, repo
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
-func runWorkflow(ctx context.Context, client *github.Client, owner, repo, workflowID, ref string, inputs map[string]any) (*mcp.CallToolResult, any, error) {
+func runWorkflow(ctx context.Context, client *github.Client, owner, repo, workflowID, ref string, inputs map[string]any, validateInputs bool) (*mcp.CallToolResult, any, error) {
+	if validateInputs {
+		// Validation is best effort: if the workflow file cannot be read or parsed,
+		// dispatch anyway and let the API report any problem.
+		declared, err := getWorkflowDispatchInputs(ctx, client, owner, repo, workflowID, ref)
+		if err == nil {
+			if missing := missingWorkflowInputs(declared, inputs); len(missing) > 0 {
+				return utils.NewToolResultError(fmt.Sprintf("workflow %s is missing required inputs: %s", workflowID, strings.Join(missing, ", "))), nil, nil
+			}
+		}
+	}
+
 	event := github.CreateWorkflowDispatchEventRequest{
 		Ref:    ref,
 		Inputs: inputs,
@@ -1089,6 +1235,93 @@ func cancelWorkflowRun(ctx context.Context, client *github.Client, owner, repo s
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// cancellableRunStatuses are the workflow run statuses that can still be cancelled.
+var cancellableRunStatuses = []string{"queued", "in_progress", "waiting", "requested", "pending"}
+
+// CancelledWorkflowRun is the outcome of cancelling one run in cancel_workflow_runs.
+type CancelledWorkflowRun struct {
+	RunID     int64  `json:"run_id"`
+	Name      string `json:"name"`
+	Branch    string `json:"branch"`
+	Status    string `json:"status"`
+	Cancelled bool   `json:"cancelled"`
+	Error     string `json:"error,omitempty"`
+}
+
+// cancelWorkflowRuns cancels up to maxRuns queued or in-progress runs on branch
+// and/or of workflowID, reporting the outcome for each run.
+func cancelWorkflowRuns(ctx context.Context, client *github.Client, owner, repo, branch, workflowID string, maxRuns int) (*mcp.CallToolResult, any, error) {
+	opts := &github.ListWorkflowRunsOptions{
+		Branch:      branch,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var workflowRuns *github.WorkflowRuns
+	var resp *github.Response
+	var err error
+
+	if workflowID == "" {
+		workflowRuns, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+	} else if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+		workflowRuns, resp, err = client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowIDInt, opts)
+	} else {
+		workflowRuns, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+	}
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list workflow runs", resp, err), nil, nil
+	}
+	_ = resp.Body.Close()
+
+	results := make([]CancelledWorkflowRun, 0)
+	skipped := 0
+	for _, run := range workflowRuns.WorkflowRuns {
+		if !slices.Contains(cancellableRunStatuses, run.GetStatus()) {
+			skipped++
+			continue
+		}
+		if len(results) >= maxRuns {
+			break
+		}
+
+		result := CancelledWorkflowRun{
+			RunID:  run.GetID(),
+			Name:   run.GetName(),
+			Branch: run.GetHeadBranch(),
+			Status: run.GetStatus(),
+		}
+		cancelResp, err := client.Actions.CancelWorkflowRunByID(ctx, owner, repo, run.GetID())
+		var acceptedErr *github.AcceptedError
+		if err != nil && !errors.As(err, &acceptedErr) {
+			result.Error = err.Error()
+		} else {
+			result.Cancelled = true
+		}
+		if cancelResp != nil {
+			_ = cancelResp.Body.Close()
+		}
+		results = append(results, result)
+	}
+
+	cancelled := 0
+	for _, result := range results {
+		if result.Cancelled {
+			cancelled++
+		}
+	}
+
+	r, err := json.Marshal(map[string]any{
+		"cancelled": cancelled,
+		"failed":    len(results) - cancelled,
+		"skipped":   skipped,
+		"runs":      results,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
 func deleteWorkflowRunLogs(ctx context.Context, client *github.Client, owner, repo string, runID int64) (*mcp.CallToolResult, any, error) {
 	resp, err := client.Actions.DeleteWorkflowRunLogs(ctx, owner, repo, runID)
 	if err != nil {
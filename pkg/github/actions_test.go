@@ -2,9 +2,11 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -265,6 +267,99 @@ func Test_ActionsGet_GetWorkflow(t *testing.T) {
 		assert.NotNil(t, response.ID)
 		assert.Equal(t, "CI", *response.Name)
 	})
+
+	t.Run("workflow with file contents", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsWorkflowsByOwnerByRepoByWorkflowID: mockResponse(t, http.StatusOK, &github.Workflow{
+				ID:    github.Ptr(int64(1)),
+				Name:  github.Ptr("Deploy"),
+				Path:  github.Ptr(".github/workflows/deploy.yml"),
+				State: github.Ptr("active"),
+			}),
+			GetReposContentsByOwnerByRepoByNestedPath: expectPath(t, "/repos/owner/repo/contents/.github/workflows/deploy.yml").
+				andThen(mockResponse(t, http.StatusOK, &github.RepositoryContent{
+					Type:     github.Ptr("file"),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(deployWorkflowYAML))),
+				})),
+		})
+
+		deps := BaseDeps{
+			Client: github.NewClient(mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":          "get_workflow",
+			"owner":           "owner",
+			"repo":            "repo",
+			"resource_id":     "deploy.yml",
+			"include_content": true,
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response WorkflowWithContent
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, "Deploy", response.GetName())
+		assert.Equal(t, "active", response.GetState())
+		assert.Equal(t, ".github/workflows/deploy.yml", response.GetPath())
+		assert.Equal(t, deployWorkflowYAML, response.Content)
+		assert.Equal(t, len(deployWorkflowYAML), response.ContentSize)
+		assert.False(t, response.ContentTruncated)
+	})
+}
+
+func Test_ActionsGet_GetWorkflowArtifact(t *testing.T) {
+	toolDef := ActionsGet(translations.NullTranslationHelper)
+
+	t.Run("maps artifact metadata", func(t *testing.T) {
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsArtifactsByOwnerByRepoByArtifactID: expectPath(t, "/repos/owner/repo/actions/artifacts/42").
+				andThen(mockResponse(t, http.StatusOK, &github.Artifact{
+					ID:                 github.Ptr(int64(42)),
+					Name:               github.Ptr("coverage-report"),
+					SizeInBytes:        github.Ptr(int64(524288)),
+					Expired:            github.Ptr(false),
+					ArchiveDownloadURL: github.Ptr("https://api.github.com/repos/owner/repo/actions/artifacts/42/zip"),
+					CreatedAt:          &github.Timestamp{Time: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+					ExpiresAt:          &github.Timestamp{Time: time.Date(2026, 5, 30, 12, 0, 0, 0, time.UTC)},
+					WorkflowRun:        &github.ArtifactWorkflowRun{ID: github.Ptr(int64(12345))},
+				})),
+		})
+
+		deps := BaseDeps{
+			Client: github.NewClient(mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method":      "get_workflow_run_artifact",
+			"owner":       "owner",
+			"repo":        "repo",
+			"resource_id": "42",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response MinimalArtifact
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, MinimalArtifact{
+			ID:          42,
+			Name:        "coverage-report",
+			SizeInBytes: 524288,
+			Expired:     false,
+			CreatedAt:   "2026-03-01T12:00:00Z",
+			ExpiresAt:   "2026-05-30T12:00:00Z",
+			RunID:       12345,
+		}, response)
+	})
 }
 
 func Test_ActionsGet_GetWorkflowRun(t *testing.T) {
@@ -327,6 +422,26 @@ func Test_ActionsRunTrigger(t *testing.T) {
 	assert.ElementsMatch(t, inputSchema.Required, []string{"method", "owner", "repo"})
 }
 
+// deployWorkflowYAML declares one required input without a default, one with
+// a default and one optional input.
+const deployWorkflowYAML = `name: Deploy
+on:
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: Target environment
+        required: true
+        type: choice
+        options: [staging, production]
+      version:
+        required: true
+        default: latest
+      dry_run:
+        type: boolean
+`
+
 func Test_ActionsRunTrigger_RunWorkflow(t *testing.T) {
 	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
 
@@ -377,6 +492,74 @@ func Test_ActionsRunTrigger_RunWorkflow(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "ref is required for run_workflow action",
 		},
+		{
+			name: "missing required workflow_dispatch input",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposContentsByOwnerByRepoByNestedPath: expectPath(t, "/repos/owner/repo/contents/.github/workflows/deploy.yml").
+					andThen(mockResponse(t, http.StatusOK, &github.RepositoryContent{
+						Type:     github.Ptr("file"),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(deployWorkflowYAML))),
+					})),
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+					t.Fatal("workflow should not be dispatched when required inputs are missing")
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "deploy.yml",
+				"ref":         "main",
+				"inputs":      map[string]any{"version": "1.2.3"},
+			},
+			expectError:    true,
+			expectedErrMsg: "workflow deploy.yml is missing required inputs: environment (choice: staging, production)",
+		},
+		{
+			name: "valid dispatch with required inputs",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposActionsWorkflowsByOwnerByRepoByWorkflowID: mockResponse(t, http.StatusOK, &github.Workflow{
+					ID:   github.Ptr(int64(12345)),
+					Path: github.Ptr(".github/workflows/deploy.yml"),
+				}),
+				GetReposContentsByOwnerByRepoByNestedPath: expectQueryParams(t, map[string]string{"ref": "main"}).
+					andThen(mockResponse(t, http.StatusOK, &github.RepositoryContent{
+						Type:     github.Ptr("file"),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(deployWorkflowYAML))),
+					})),
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":      "run_workflow",
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "12345",
+				"ref":         "main",
+				"inputs":      map[string]any{"environment": "staging"},
+			},
+			expectError: false,
+		},
+		{
+			name: "validation disabled",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposActionsWorkflowsDispatchesByOwnerByRepoByWorkflowID: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNoContent)
+				}),
+			}),
+			requestArgs: map[string]any{
+				"method":          "run_workflow",
+				"owner":           "owner",
+				"repo":            "repo",
+				"workflow_id":     "deploy.yml",
+				"ref":             "main",
+				"validate_inputs": false,
+			},
+			expectError: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -408,6 +591,87 @@ func Test_ActionsRunTrigger_RunWorkflow(t *testing.T) {
 	}
 }
 
+func Test_ActionsRunTrigger_CancelWorkflowRuns(t *testing.T) {
+	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
+
+	t.Run("cancels active runs on a branch and skips completed ones", func(t *testing.T) {
+		runs := &github.WorkflowRuns{
+			TotalCount: github.Ptr(3),
+			WorkflowRuns: []*github.WorkflowRun{
+				{ID: github.Ptr(int64(101)), Name: github.Ptr("CI"), HeadBranch: github.Ptr("hotfix"), Status: github.Ptr("in_progress")},
+				{ID: github.Ptr(int64(102)), Name: github.Ptr("Deploy"), HeadBranch: github.Ptr("hotfix"), Status: github.Ptr("queued")},
+				{ID: github.Ptr(int64(103)), Name: github.Ptr("CI"), HeadBranch: github.Ptr("hotfix"), Status: github.Ptr("completed")},
+			},
+		}
+		var cancelledPaths []string
+		mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposActionsRunsByOwnerByRepo: expectQueryParams(t, map[string]string{
+				"branch":   "hotfix",
+				"per_page": "100",
+			}).andThen(mockResponse(t, http.StatusOK, runs)),
+			PostReposActionsRunsCancelByOwnerByRepoByRunID: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				cancelledPaths = append(cancelledPaths, r.URL.Path)
+				w.WriteHeader(http.StatusAccepted)
+			}),
+		})
+
+		deps := BaseDeps{
+			Client: github.NewClient(mockedClient),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_workflow_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+			"branch": "hotfix",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response struct {
+			Cancelled int                    `json:"cancelled"`
+			Failed    int                    `json:"failed"`
+			Skipped   int                    `json:"skipped"`
+			Runs      []CancelledWorkflowRun `json:"runs"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, 2, response.Cancelled)
+		assert.Equal(t, 0, response.Failed)
+		assert.Equal(t, 1, response.Skipped)
+		require.Len(t, response.Runs, 2)
+		assert.Equal(t, int64(101), response.Runs[0].RunID)
+		assert.Equal(t, int64(102), response.Runs[1].RunID)
+		assert.True(t, response.Runs[0].Cancelled)
+		assert.True(t, response.Runs[1].Cancelled)
+		assert.Equal(t, []string{
+			"/repos/owner/repo/actions/runs/101/cancel",
+			"/repos/owner/repo/actions/runs/102/cancel",
+		}, cancelledPaths)
+	})
+
+	t.Run("requires branch or workflow_id", func(t *testing.T) {
+		deps := BaseDeps{
+			Client: github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{})),
+		}
+		handler := toolDef.Handler(deps)
+
+		request := createMCPRequest(map[string]any{
+			"method": "cancel_workflow_runs",
+			"owner":  "owner",
+			"repo":   "repo",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Equal(t, "branch or workflow_id is required for cancel_workflow_runs action", errorContent.Text)
+	})
+}
+
 func Test_ActionsRunTrigger_CancelWorkflowRun(t *testing.T) {
 	toolDef := ActionsRunTrigger(translations.NullTranslationHelper)
 
@@ -511,6 +775,7 @@ func Test_ActionsGetJobLogs(t *testing.T) {
 	assert.Contains(t, inputSchema.Properties, "run_id")
 	assert.Contains(t, inputSchema.Properties, "failed_only")
 	assert.Contains(t, inputSchema.Properties, "return_content")
+	assert.Contains(t, inputSchema.Properties, "chunked")
 	assert.ElementsMatch(t, inputSchema.Required, []string{"owner", "repo"})
 }
 
@@ -0,0 +1,154 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxGitHubGetResponseBytes is the largest response body github_api_get will return.
+const maxGitHubGetResponseBytes = 256 * 1024
+
+// validateAPIPath checks that path is a relative GitHub API path and returns it
+// without its leading slash. Absolute URLs, query strings and path traversal are rejected.
+func validateAPIPath(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", errors.New("path must not be empty")
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	if u.Scheme != "" || u.Host != "" || strings.HasPrefix(path, "//") {
+		return "", errors.New("path must be relative to the API base URL, not an absolute URL")
+	}
+	if u.RawQuery != "" || u.Fragment != "" || strings.ContainsAny(path, "?#") {
+		return "", errors.New("path must not contain a query string or fragment; use the query argument instead")
+	}
+
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == "." || segment == ".." {
+			return "", errors.New("path must not contain '.' or '..' segments")
+		}
+	}
+
+	return strings.TrimPrefix(u.Path, "/"), nil
+}
+
+// GitHubGet creates a tool that performs an authenticated GET against an arbitrary GitHub REST API path.
+func GitHubGet(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataExperiments,
+		mcp.Tool{
+			Name:        "github_api_get",
+			Description: t("TOOL_GITHUB_API_GET_DESCRIPTION", "Perform an authenticated GET request against a GitHub REST API path that is not covered by another tool, and return the raw JSON response. Prefer dedicated tools when they exist."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GITHUB_API_GET_USER_TITLE", "GitHub API GET request"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"path": {
+						Type:        "string",
+						Description: "API path relative to the API base URL, e.g. '/repos/{owner}/{repo}/topics'. Absolute URLs, query strings and '..' segments are not allowed.",
+					},
+					"query": {
+						Type:        "object",
+						Description: "Query parameters to append to the request, e.g. {\"per_page\": 10}",
+						AdditionalProperties: &jsonschema.Schema{
+							Types: []string{"string", "number", "boolean"},
+						},
+					},
+					"maxBytes": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of response bytes to return. Defaults to and is capped at %d", maxGitHubGetResponseBytes),
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			rawPath, err := RequiredParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			path, err := validateAPIPath(rawPath)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			query, err := OptionalParam[map[string]any](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxBytes, err := OptionalIntParamWithDefault(args, "maxBytes", maxGitHubGetResponseBytes)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxBytes < 1 || maxBytes > maxGitHubGetResponseBytes {
+				maxBytes = maxGitHubGetResponseBytes
+			}
+
+			values := url.Values{}
+			for k, v := range query {
+				switch v.(type) {
+				case string, float64, bool:
+					values.Set(k, fmt.Sprint(v))
+				default:
+					return utils.NewToolResultError(fmt.Sprintf("query parameter %q must be a string, number or boolean", k)), nil, nil
+				}
+			}
+			if len(values) > 0 {
+				path += "?" + values.Encode()
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			req, err := client.NewRequest(http.MethodGet, path, nil)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to create request: %v", err)), nil, nil
+			}
+
+			resp, err := client.BareDo(ctx, req)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to GET %s", rawPath),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			body, err := io.ReadAll(io.LimitReader(resp.Body, int64(maxBytes)+1))
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+
+			if len(body) > maxBytes {
+				return utils.NewToolResultText(fmt.Sprintf("%s\n\n[response truncated after %d bytes]", body[:maxBytes], maxBytes)), nil, nil
+			}
+
+			return utils.NewToolResultText(string(body)), nil, nil
+		},
+	)
+	tool.APIPathArgument = "path"
+	return tool
+}
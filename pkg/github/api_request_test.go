@@ -0,0 +1,152 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GitHubGet(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GitHubGet(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "github_api_get", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataExperiments.ID, serverTool.Toolset.ID)
+	assert.Contains(t, schema.Properties, "path")
+	assert.Contains(t, schema.Properties, "query")
+	assert.Contains(t, schema.Properties, "maxBytes")
+	assert.ElementsMatch(t, schema.Required, []string{"path"})
+
+	topicsBody := `{"names":["go","mcp","github"]}`
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "valid relative path with query",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTopicsByOwnerByRepo: expect(t, expectations{
+					path: "/repos/owner/repo/topics",
+					queryParams: map[string]string{
+						"per_page": "10",
+						"page":     "2",
+					},
+				}).andThen(
+					mockResponse(t, http.StatusOK, topicsBody),
+				),
+			}),
+			requestArgs: map[string]any{
+				"path": "/repos/owner/repo/topics",
+				"query": map[string]any{
+					"per_page": float64(10),
+					"page":     "2",
+				},
+			},
+			expectedText: topicsBody,
+		},
+		{
+			name:         "absolute URL rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"path": "https://evil.example.com/repos/owner/repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "not an absolute URL",
+		},
+		{
+			name:         "protocol-relative URL rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"path": "//evil.example.com/repos/owner/repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "not an absolute URL",
+		},
+		{
+			name:         "path traversal rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"path": "/repos/owner/repo/../../user",
+			},
+			expectError:    true,
+			expectedErrMsg: "must not contain '.' or '..' segments",
+		},
+		{
+			name:         "inline query string rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"path": "/repos/owner/repo/topics?per_page=10",
+			},
+			expectError:    true,
+			expectedErrMsg: "use the query argument instead",
+		},
+		{
+			name: "response capped at maxBytes",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTopicsByOwnerByRepo: mockResponse(t, http.StatusOK, topicsBody),
+			}),
+			requestArgs: map[string]any{
+				"path":     "repos/owner/repo/topics",
+				"maxBytes": float64(10),
+			},
+			expectedText: topicsBody[:10] + "\n\n[response truncated after 10 bytes]",
+		},
+		{
+			name: "API error",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTopicsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"path": "/repos/owner/missing/topics",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to GET /repos/owner/missing/topics",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, strings.TrimSpace(textContent.Text))
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxBatchCalls bounds how many sub-calls a single batch_call may contain.
+	maxBatchCalls = 20
+	// maxBatchConcurrency bounds how many sub-calls batch_call runs at once.
+	maxBatchConcurrency = 5
+)
+
+// batchCallEntry is a single sub-call requested through batch_call.
+type batchCallEntry struct {
+	Tool      string
+	Arguments json.RawMessage
+}
+
+// BatchCallResult is the outcome of one sub-call of batch_call, in request order.
+type BatchCallResult struct {
+	Tool    string          `json:"tool"`
+	Success bool            `json:"success"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// parseBatchCalls validates the calls parameter of batch_call.
+func parseBatchCalls(args map[string]any) ([]batchCallEntry, error) {
+	raw, ok := args["calls"]
+	if !ok {
+		return nil, fmt.Errorf("missing required parameter: calls")
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("parameter calls must be an array")
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("calls must contain at least one entry")
+	}
+	if len(items) > maxBatchCalls {
+		return nil, fmt.Errorf("calls may contain at most %d entries, got %d", maxBatchCalls, len(items))
+	}
+
+	entries := make([]batchCallEntry, 0, len(items))
+	for i, item := range items {
+		call, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("calls[%d] must be an object", i)
+		}
+		name, err := RequiredParam[string](call, "tool")
+		if err != nil {
+			return nil, fmt.Errorf("calls[%d]: %w", i, err)
+		}
+		arguments, ok := call["arguments"]
+		if !ok || arguments == nil {
+			arguments = map[string]any{}
+		}
+		if _, ok := arguments.(map[string]any); !ok {
+			return nil, fmt.Errorf("calls[%d]: arguments must be an object", i)
+		}
+		encoded, err := json.Marshal(arguments)
+		if err != nil {
+			return nil, fmt.Errorf("calls[%d]: failed to encode arguments: %w", i, err)
+		}
+		entries = append(entries, batchCallEntry{Tool: name, Arguments: encoded})
+	}
+	return entries, nil
+}
+
+// batchCallOutput converts a sub-call result into its batch_call entry. Text content
+// that is itself JSON is embedded as-is so callers do not have to decode it twice.
+func batchCallOutput(name string, result *mcp.CallToolResult, err error) BatchCallResult {
+	if err != nil {
+		return BatchCallResult{Tool: name, Error: err.Error()}
+	}
+
+	var texts []string
+	if result != nil {
+		for _, content := range result.Content {
+			if text, ok := content.(*mcp.TextContent); ok {
+				texts = append(texts, text.Text)
+			}
+		}
+	}
+	text := strings.Join(texts, "\n")
+
+	if result != nil && result.IsError {
+		return BatchCallResult{Tool: name, Error: text}
+	}
+	if json.Valid([]byte(text)) {
+		return BatchCallResult{Tool: name, Success: true, Result: json.RawMessage(text)}
+	}
+	encoded, _ := json.Marshal(text)
+	return BatchCallResult{Tool: name, Success: true, Result: encoded}
+}
+
+// BatchCall creates a meta-tool that runs several tool calls in one round-trip.
+func BatchCall(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataExperiments,
+		mcp.Tool{
+			Name:        "batch_call",
+			Description: t("TOOL_BATCH_CALL_DESCRIPTION", "Call several tools in one request and get their results back in the same order. Use this for independent reads, e.g. fetching a few files or issues at once. Only read-only tools are allowed unless allowWrites is set; each call succeeds or fails on its own"),
+			// Not read-only: with allowWrites a batch may call any write tool, which
+			// a static annotation cannot rule out
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_BATCH_CALL_USER_TITLE", "Batch tool calls"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"calls": {
+						Type:        "array",
+						Description: "Tool calls to make, in order",
+						MinItems:    jsonschema.Ptr(1),
+						MaxItems:    jsonschema.Ptr(maxBatchCalls),
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"tool": {
+									Type:        "string",
+									Description: "Name of the tool to call",
+								},
+								"arguments": {
+									Type:        "object",
+									Description: "Arguments for the tool",
+								},
+							},
+							Required: []string{"tool"},
+						},
+					},
+					"concurrency": {
+						Type:        "number",
+						Description: "Maximum number of calls to run at the same time",
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxBatchConcurrency)),
+						Default:     json.RawMessage(`1`),
+					},
+					"allowWrites": {
+						Type:        "boolean",
+						Description: "Allow calls to tools that are not read-only",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"calls"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			calls, err := parseBatchCalls(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			concurrency, err := OptionalIntParamWithDefault(args, "concurrency", 1)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if concurrency < 1 || concurrency > maxBatchConcurrency {
+				return utils.NewToolResultError(fmt.Sprintf("concurrency must be between 1 and %d", maxBatchConcurrency)), nil, nil
+			}
+			allowWrites, err := OptionalBoolParamWithDefault(args, "allowWrites", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			inv, ok := InventoryFromContext(ctx)
+			if !ok {
+				return utils.NewToolResultError("tool information is not available"), nil, nil
+			}
+
+			// Only tools this session could call directly may be batched
+			available := make(map[string]inventory.ServerTool)
+			for _, st := range inv.AvailableTools(ctx) {
+				available[st.Tool.Name] = st
+			}
+
+			// Sub-calls go through the same handler wrappers and tool call middleware
+			// as direct calls, so batching cannot bypass server policy
+			middleware := ToolCallMiddlewareFromContext(ctx)
+			callTool := func(ctx context.Context, st inventory.ServerTool, subReq *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				st = inv.WrappedTool(st)
+				handler := mcp.MethodHandler(func(ctx context.Context, _ string, req mcp.Request) (mcp.Result, error) {
+					return st.Handler(deps)(ctx, req.(*mcp.CallToolRequest))
+				})
+				for i := len(middleware) - 1; i >= 0; i-- {
+					handler = middleware[i](handler)
+				}
+				result, err := handler(ctx, "tools/call", subReq)
+				if err != nil {
+					return nil, err
+				}
+				callResult, _ := result.(*mcp.CallToolResult)
+				return callResult, nil
+			}
+
+			results := make([]BatchCallResult, len(calls))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, call := range calls {
+				st, ok := available[call.Tool]
+				if !ok {
+					if resolved, _, err := inv.ToolByCanonicalOrAlias(call.Tool); err == nil {
+						st, ok = available[resolved.Tool.Name]
+					}
+				}
+				switch {
+				case !ok:
+					results[i] = BatchCallResult{Tool: call.Tool, Error: fmt.Sprintf("tool %q is not available", call.Tool)}
+					continue
+				case st.Tool.Name == "batch_call":
+					results[i] = BatchCallResult{Tool: call.Tool, Error: "batch_call cannot be nested"}
+					continue
+				case !st.IsReadOnly() && !allowWrites:
+					results[i] = BatchCallResult{Tool: call.Tool, Error: fmt.Sprintf("tool %q is not read-only; set allowWrites to call it", call.Tool)}
+					continue
+				}
+
+				wg.Add(1)
+				go func(i int, call batchCallEntry, st inventory.ServerTool) {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+
+					subReq := &mcp.CallToolRequest{
+						Session: req.Session,
+						Params: &mcp.CallToolParamsRaw{
+							Name:      st.Tool.Name,
+							Arguments: call.Arguments,
+						},
+					}
+					result, err := callTool(ctx, st, subReq)
+					results[i] = batchCallOutput(call.Tool, result, err)
+				}(i, call, st)
+			}
+			wg.Wait()
+
+			return MarshalledTextResult(results), nil, nil
+		},
+	)
+	tool.Cost = inventory.ToolCostLarge
+	return tool
+}
@@ -0,0 +1,277 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BatchCall(t *testing.T) {
+	// Verify tool definition once
+	serverTool := BatchCall(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "batch_call", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	// allowWrites lets a batch call write tools, so it must not be auto-approved as read-only
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.False(t, serverTool.IsReadOnly())
+	assert.Equal(t, ToolsetMetadataExperiments.ID, serverTool.Toolset.ID)
+	assert.Contains(t, schema.Properties, "calls")
+	assert.Contains(t, schema.Properties, "concurrency")
+	assert.Contains(t, schema.Properties, "allowWrites")
+	assert.ElementsMatch(t, schema.Required, []string{"calls"})
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"repos", "experiments"}).
+		WithIncludeExperimental(true).
+		Build()
+	require.NoError(t, err)
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposTagsByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.RepositoryTag{
+			{Name: github.Ptr("v1.0.0"), Commit: &github.Commit{SHA: github.Ptr("abc123")}},
+		}),
+		GetReposReleasesLatestByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+	})
+
+	tests := []struct {
+		name            string
+		requestArgs     map[string]any
+		expectError     bool
+		expectedErrMsg  string
+		expectedResults []BatchCallResult
+	}{
+		{
+			name: "reads run in order",
+			requestArgs: map[string]any{
+				"calls": []any{
+					map[string]any{"tool": "list_tags", "arguments": map[string]any{"owner": "owner", "repo": "repo"}},
+					map[string]any{"tool": "get_latest_release", "arguments": map[string]any{"owner": "owner", "repo": "repo"}},
+				},
+				"concurrency": float64(2),
+			},
+			expectedResults: []BatchCallResult{
+				{Tool: "list_tags", Success: true, Result: json.RawMessage(`[{"name":"v1.0.0","commit":{"sha":"abc123"}}]`)},
+				{Tool: "get_latest_release", Error: "failed to get latest release"},
+			},
+		},
+		{
+			name: "writes and unknown tools are rejected",
+			requestArgs: map[string]any{
+				"calls": []any{
+					map[string]any{"tool": "create_branch", "arguments": map[string]any{"owner": "owner", "repo": "repo", "branch": "b"}},
+					map[string]any{"tool": "list_issues", "arguments": map[string]any{}},
+					map[string]any{"tool": "batch_call", "arguments": map[string]any{}},
+				},
+			},
+			expectedResults: []BatchCallResult{
+				{Tool: "create_branch", Error: `tool "create_branch" is not read-only; set allowWrites to call it`},
+				{Tool: "list_issues", Error: `tool "list_issues" is not available`},
+				{Tool: "batch_call", Error: "batch_call cannot be nested"},
+			},
+		},
+		{
+			name: "empty batch",
+			requestArgs: map[string]any{
+				"calls": []any{},
+			},
+			expectError:    true,
+			expectedErrMsg: "calls must contain at least one entry",
+		},
+		{
+			name: "concurrency out of range",
+			requestArgs: map[string]any{
+				"calls":       []any{map[string]any{"tool": "list_tags"}},
+				"concurrency": float64(10),
+			},
+			expectError:    true,
+			expectedErrMsg: "concurrency must be between 1 and 5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client: github.NewClient(mockedClient),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			ctx := ContextWithInventory(ContextWithDeps(context.Background(), deps), inv)
+			result, err := handler(ctx, &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []BatchCallResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned, len(tc.expectedResults))
+			for i, expected := range tc.expectedResults {
+				assert.Equal(t, expected.Tool, returned[i].Tool)
+				assert.Equal(t, expected.Success, returned[i].Success)
+				if expected.Result != nil {
+					assert.JSONEq(t, string(expected.Result), string(returned[i].Result))
+				}
+				assert.Contains(t, returned[i].Error, expected.Error)
+			}
+		})
+	}
+}
+
+func Test_BatchCall_ConcurrentFailures(t *testing.T) {
+	// Failing sub-calls record their errors on the shared request context; run
+	// them concurrently so `go test -race` catches unguarded access
+	serverTool := BatchCall(translations.NullTranslationHelper)
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"repos", "experiments"}).
+		WithIncludeExperimental(true).
+		Build()
+	require.NoError(t, err)
+
+	// Hold every request until all of them are in flight, so the failures overlap
+	var arrived sync.WaitGroup
+	arrived.Add(maxBatchConcurrency)
+	failing := mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`)
+	deps := BaseDeps{
+		Client: github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposTagsByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+				arrived.Done()
+				arrived.Wait()
+				failing(w, r)
+			},
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	calls := make([]any, maxBatchConcurrency)
+	for i := range calls {
+		calls[i] = map[string]any{"tool": "list_tags", "arguments": map[string]any{"owner": "owner", "repo": "repo"}}
+	}
+	request := createMCPRequest(map[string]any{
+		"calls":       calls,
+		"concurrency": float64(maxBatchConcurrency),
+	})
+
+	ctx := ghErrors.ContextWithGitHubErrors(ContextWithInventory(ContextWithDeps(context.Background(), deps), inv))
+	result, err := handler(ctx, &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []BatchCallResult
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, maxBatchConcurrency)
+	for _, r := range returned {
+		assert.False(t, r.Success)
+		assert.Contains(t, r.Error, "failed to list tags")
+	}
+
+	apiErrors, err := ghErrors.GetGitHubAPIErrors(ctx)
+	require.NoError(t, err)
+	assert.Len(t, apiErrors, maxBatchConcurrency)
+}
+
+func Test_BatchCall_AppliesServerPolicy(t *testing.T) {
+	// Sub-calls must go through the same handler wrappers and tool call middleware
+	// as direct calls
+	serverTool := BatchCall(translations.NullTranslationHelper)
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"repos", "orgs", "experiments"}).
+		WithIncludeExperimental(true).
+		WithRepoAllowlist([]string{"owner/*"}).
+		WithDefaultPageSize(7).
+		WithResultPostProcessor(func(toolName string, res *mcp.CallToolResult) *mcp.CallToolResult {
+			if toolName != "list_tags" {
+				return res
+			}
+			for _, content := range res.Content {
+				if text, ok := content.(*mcp.TextContent); ok {
+					text.Text = strings.ReplaceAll(text.Text, "abc123", "processed")
+				}
+			}
+			return res
+		}).
+		Build()
+	require.NoError(t, err)
+
+	deps := BaseDeps{
+		Client: github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposTagsByOwnerByRepo: expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "7",
+			}).andThen(mockResponse(t, http.StatusOK, []*github.RepositoryTag{
+				{Name: github.Ptr("v1.0.0"), Commit: &github.Commit{SHA: github.Ptr("abc123")}},
+			})),
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"calls": []any{
+			map[string]any{"tool": "list_tags", "arguments": map[string]any{"owner": "owner", "repo": "repo"}},
+			map[string]any{"tool": "list_tags", "arguments": map[string]any{"owner": "other", "repo": "repo"}},
+		},
+	})
+
+	ctx := ContextWithInventory(ContextWithDeps(context.Background(), deps), inv)
+	ctx = ContextWithToolCallMiddleware(ctx, applyDefaultPageSize(inv), scopePreflight(inv, nil))
+
+	t.Run("wrappers and middleware apply", func(t *testing.T) {
+		result, err := handler(ctx, &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned []BatchCallResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned, 2)
+		assert.True(t, returned[0].Success)
+		assert.JSONEq(t, `[{"name":"v1.0.0","commit":{"sha":"processed"}}]`, string(returned[0].Result))
+		assert.False(t, returned[1].Success)
+		assert.Equal(t, "repository other/repo is not permitted by server policy", returned[1].Error)
+	})
+
+	t.Run("scope preflight applies", func(t *testing.T) {
+		request := createMCPRequest(map[string]any{
+			"calls": []any{
+				map[string]any{"tool": "list_organization_members", "arguments": map[string]any{"org": "owner"}},
+			},
+		})
+		result, err := handler(ghcontext.WithTokenScopes(ctx, []string{"repo"}), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var returned []BatchCallResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+		require.Len(t, returned, 1)
+		assert.False(t, returned[0].Success)
+		assert.Contains(t, returned[0].Error, "requires 'read:org' scope")
+	})
+}
@@ -0,0 +1,253 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// commitCommentPayload is the commit comment as returned by the REST API. go-github's
+// RepositoryComment lacks the line field, so it is decoded alongside it.
+type commitCommentPayload struct {
+	github.RepositoryComment
+	Line *int `json:"line,omitempty"`
+}
+
+// createCommitCommentRequest is the request body for creating a commit comment.
+type createCommitCommentRequest struct {
+	Body string `json:"body"`
+	Path string `json:"path,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// CommitComment is the trimmed output type for a comment on a commit.
+type CommitComment struct {
+	ID        int64        `json:"id"`
+	CommitID  string       `json:"commit_id"`
+	Body      string       `json:"body"`
+	Path      string       `json:"path,omitempty"`
+	Line      int          `json:"line,omitempty"`
+	User      *MinimalUser `json:"user,omitempty"`
+	HTMLURL   string       `json:"html_url"`
+	CreatedAt string       `json:"created_at,omitempty"`
+}
+
+func convertToCommitComment(c *commitCommentPayload) CommitComment {
+	comment := CommitComment{
+		ID:       c.GetID(),
+		CommitID: c.GetCommitID(),
+		Body:     c.GetBody(),
+		Path:     c.GetPath(),
+		HTMLURL:  c.GetHTMLURL(),
+	}
+	if c.Line != nil {
+		comment.Line = *c.Line
+	}
+	if user := c.GetUser(); user != nil {
+		comment.User = &MinimalUser{
+			Login:      user.GetLogin(),
+			ID:         user.GetID(),
+			ProfileURL: user.GetHTMLURL(),
+			AvatarURL:  user.GetAvatarURL(),
+		}
+	}
+	if c.CreatedAt != nil {
+		comment.CreatedAt = c.CreatedAt.Format(time.RFC3339)
+	}
+	return comment
+}
+
+// ListCommitComments creates a tool to list the comments on a commit.
+func ListCommitComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_commit_comments",
+			Description: t("TOOL_LIST_COMMIT_COMMENTS_DESCRIPTION", "List comments on a commit in a GitHub repository, including comments attached to a specific file and line"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_COMMIT_COMMENTS_USER_TITLE", "List commit comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Commit SHA",
+					},
+				},
+				Required: []string{"owner", "repo", "sha"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Request the endpoint directly so the line of positioned comments is kept
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/commits/%s/comments?page=%d&per_page=%d", owner, repo, sha, pagination.Page, pagination.PerPage), nil)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var payload []*commitCommentPayload
+			resp, err := client.Do(ctx, req, &payload)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list comments for commit %s", sha),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			comments := make([]CommitComment, 0, len(payload))
+			for _, c := range payload {
+				comments = append(comments, convertToCommitComment(c))
+			}
+
+			return MarshalledTextResult(comments), nil, nil
+		},
+	)
+}
+
+// AddCommitComment creates a tool to comment on a commit, optionally on a specific file and line.
+func AddCommitComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "add_commit_comment",
+			Description: t("TOOL_ADD_COMMIT_COMMENT_DESCRIPTION", "Add a comment to a commit in a GitHub repository. Provide path and line to attach the comment to a line of a file changed in the commit."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ADD_COMMIT_COMMENT_USER_TITLE", "Add commit comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "Commit SHA",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Comment content",
+					},
+					"path": {
+						Type:        "string",
+						Description: "Relative path of the file to comment on. Required when line is set",
+					},
+					"line": {
+						Type:        "number",
+						Description: "Line number in the file to comment on",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo", "sha", "body"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			body, err := RequiredParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			path, err := OptionalParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			line, err := OptionalIntParam(args, "line")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if line != 0 && path == "" {
+				return utils.NewToolResultError("path is required when line is set"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// go-github does not support the line field, so build the request directly
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/commits/%s/comments", owner, repo, sha), &createCommitCommentRequest{
+				Body: body,
+				Path: path,
+				Line: line,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var created commitCommentPayload
+			resp, err := client.Do(ctx, req, &created)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to comment on commit %s", sha),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToCommitComment(&created)), nil, nil
+		},
+	)
+}
@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCommitComments(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListCommitComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_commit_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "sha")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha"})
+
+	mockComments := `[
+		{
+			"id": 1,
+			"commit_id": "abc123",
+			"body": "Nice change",
+			"html_url": "https://github.com/owner/repo/commit/abc123#commitcomment-1",
+			"user": {"login": "octocat", "id": 42},
+			"created_at": "2024-05-01T12:00:00Z"
+		},
+		{
+			"id": 2,
+			"commit_id": "abc123",
+			"body": "Off by one?",
+			"path": "main.go",
+			"line": 14,
+			"html_url": "https://github.com/owner/repo/commit/abc123#r2"
+		}
+	]`
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedComments []CommitComment
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful comments listing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsCommentsByOwnerByRepoByCommitSHA: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockComments),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"sha":     "abc123",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedComments: []CommitComment{
+				{
+					ID:        1,
+					CommitID:  "abc123",
+					Body:      "Nice change",
+					HTMLURL:   "https://github.com/owner/repo/commit/abc123#commitcomment-1",
+					User:      &MinimalUser{Login: "octocat", ID: 42},
+					CreatedAt: "2024-05-01T12:00:00Z",
+				},
+				{
+					ID:       2,
+					CommitID: "abc123",
+					Body:     "Off by one?",
+					Path:     "main.go",
+					Line:     14,
+					HTMLURL:  "https://github.com/owner/repo/commit/abc123#r2",
+				},
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsCommentsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list comments for commit missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []CommitComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedComments, returned)
+		})
+	}
+}
+
+func Test_AddCommitComment(t *testing.T) {
+	// Verify tool definition once
+	serverTool := AddCommitComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "add_commit_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "sha")
+	assert.Contains(t, schema.Properties, "body")
+	assert.Contains(t, schema.Properties, "path")
+	assert.Contains(t, schema.Properties, "line")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha", "body"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		expectError     bool
+		expectedComment CommitComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "comment on commit",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCommitsCommentsByOwnerByRepoByCommitSHA: expectRequestBody(t, map[string]any{
+					"body": "Looks good",
+				}).andThen(
+					mockResponse(t, http.StatusCreated, `{"id": 10, "commit_id": "abc123", "body": "Looks good", "html_url": "https://github.com/owner/repo/commit/abc123#commitcomment-10"}`),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Looks good",
+			},
+			expectedComment: CommitComment{
+				ID:       10,
+				CommitID: "abc123",
+				Body:     "Looks good",
+				HTMLURL:  "https://github.com/owner/repo/commit/abc123#commitcomment-10",
+			},
+		},
+		{
+			name: "positioned comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCommitsCommentsByOwnerByRepoByCommitSHA: expectPath(t, "/repos/owner/repo/commits/abc123/comments").andThen(
+					expectRequestBody(t, map[string]any{
+						"body": "Off by one?",
+						"path": "main.go",
+						"line": float64(14),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, `{"id": 11, "commit_id": "abc123", "body": "Off by one?", "path": "main.go", "line": 14, "html_url": "https://github.com/owner/repo/commit/abc123#r11"}`),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Off by one?",
+				"path":  "main.go",
+				"line":  float64(14),
+			},
+			expectedComment: CommitComment{
+				ID:       11,
+				CommitID: "abc123",
+				Body:     "Off by one?",
+				Path:     "main.go",
+				Line:     14,
+				HTMLURL:  "https://github.com/owner/repo/commit/abc123#r11",
+			},
+		},
+		{
+			name: "line without path",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Off by one?",
+				"line":  float64(14),
+			},
+			expectError:    true,
+			expectedErrMsg: "path is required when line is set",
+		},
+		{
+			name: "comment fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposCommitsCommentsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123",
+				"body":  "Looks good",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to comment on commit abc123",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned CommitComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedComment, returned)
+		})
+	}
+}
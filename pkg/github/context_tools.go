@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -38,6 +39,7 @@ type UserDetails struct {
 	PrivateGists      int       `json:"private_gists,omitempty"`
 	TotalPrivateRepos int64     `json:"total_private_repos,omitempty"`
 	OwnedPrivateRepos int64     `json:"owned_private_repos,omitempty"`
+	Plan              string    `json:"plan,omitempty"`
 }
 
 // GetMe creates a tool to get details of the authenticated user.
@@ -46,14 +48,22 @@ func GetMe(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataContext,
 		mcp.Tool{
 			Name:        "get_me",
-			Description: t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user. Use this when a request is about the user's own profile for GitHub. Or when information is missing to build other tool calls."),
+			Description: t("TOOL_GET_ME_DESCRIPTION", "Get details of the authenticated GitHub user. Use this when a request is about the user's own profile for GitHub. Or when information is missing to build other tool calls. Returns just the login, name and ID by default; request 'full' detail for the complete profile."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_GET_ME_USER_TITLE", "Get my user profile"),
 				ReadOnlyHint: true,
 			},
-			// Use json.RawMessage to ensure "properties" is included even when empty.
-			// OpenAI strict mode requires the properties field to be present.
-			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"detail": {
+						Type:        "string",
+						Description: "Level of detail to return: 'minimal' (login, name, ID) or 'full' (adds profile details, plan, email and counts)",
+						Enum:        []any{"minimal", "full"},
+						Default:     json.RawMessage(`"minimal"`),
+					},
+				},
+			},
 			Meta: mcp.Meta{
 				"ui": map[string]any{
 					"resourceUri": GetMeUIResourceURI,
@@ -61,13 +71,24 @@ func GetMe(t translations.TranslationHelperFunc) inventory.ServerTool {
 			},
 		},
 		nil,
-		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			detail, err := OptionalParam[string](args, "detail")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if detail == "" {
+				detail = "minimal"
+			}
+			if detail != "minimal" && detail != "full" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid detail %q: must be 'minimal' or 'full'", detail)), nil, nil
+			}
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 			}
 
-			user, res, err := client.Users.Get(ctx, "")
+			user, res, err := getAuthenticatedUserCached(ctx, client)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to get user",
@@ -80,9 +101,12 @@ func GetMe(t translations.TranslationHelperFunc) inventory.ServerTool {
 			minimalUser := MinimalUser{
 				Login:      user.GetLogin(),
 				ID:         user.GetID(),
+				Name:       user.GetName(),
 				ProfileURL: user.GetHTMLURL(),
 				AvatarURL:  user.GetAvatarURL(),
-				Details: &UserDetails{
+			}
+			if detail == "full" {
+				minimalUser.Details = &UserDetails{
 					Name:              user.GetName(),
 					Company:           user.GetCompany(),
 					Blog:              user.GetBlog(),
@@ -100,7 +124,8 @@ func GetMe(t translations.TranslationHelperFunc) inventory.ServerTool {
 					PrivateGists:      user.GetPrivateGists(),
 					TotalPrivateRepos: user.GetTotalPrivateRepos(),
 					OwnedPrivateRepos: user.GetOwnedPrivateRepos(),
-				},
+					Plan:              user.GetPlan().GetName(),
+				}
 			}
 
 			return MarshalledTextResult(minimalUser), nil, nil
@@ -155,7 +180,7 @@ func GetTeams(t translations.TranslationHelperFunc) inventory.ServerTool {
 					return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
 				}
 
-				userResp, res, err := client.Users.Get(ctx, "")
+				userResp, res, err := getAuthenticatedUserCached(ctx, client)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx,
 						"failed to get user",
@@ -287,3 +312,134 @@ func GetTeamMembers(t translations.TranslationHelperFunc) inventory.ServerTool {
 		},
 	)
 }
+
+// ToolsetInfo describes a toolset and its state in the current server.
+type ToolsetInfo struct {
+	ID          string   `json:"id"`
+	Description string   `json:"description"`
+	Icons       []string `json:"icons,omitempty"`
+	Default     bool     `json:"default"`
+	Enabled     bool     `json:"enabled"`
+	ToolCount   int      `json:"tool_count"`
+}
+
+// ListToolsets creates a tool that describes the toolsets known to the server.
+// It exposes the data GenerateToolsetsHelp formats for humans as structured output.
+func ListToolsets(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "list_toolsets",
+			Description: t("TOOL_LIST_TOOLSETS_DESCRIPTION", "List the toolsets this server provides, with whether each is enabled by default, whether it is currently enabled, and how many tools it contains"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_TOOLSETS_USER_TITLE", "List toolsets"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{},
+			},
+		},
+		nil,
+		func(ctx context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+			inv, ok := InventoryFromContext(ctx)
+			if !ok {
+				return utils.NewToolResultError("toolset information is not available"), nil, nil
+			}
+
+			defaults := make(map[inventory.ToolsetID]bool)
+			for _, id := range inv.DefaultToolsetIDs() {
+				defaults[id] = true
+			}
+
+			toolsets := make([]ToolsetInfo, 0, len(inv.ToolsetIDs()))
+			for _, ts := range inv.AvailableToolsets() {
+				info := ToolsetInfo{
+					ID:          string(ts.ID),
+					Description: ts.Description,
+					Default:     defaults[ts.ID],
+					Enabled:     inv.IsToolsetEnabled(ts.ID),
+					ToolCount:   len(inv.ToolsForToolset(ts.ID)),
+				}
+				for _, icon := range ts.Icons() {
+					info.Icons = append(info.Icons, icon.Source)
+				}
+				toolsets = append(toolsets, info)
+			}
+
+			return MarshalledTextResult(toolsets), nil, nil
+		},
+	)
+}
+
+// ToolSchema describes a tool so that valid arguments can be constructed before calling it.
+type ToolSchema struct {
+	Name        string                  `json:"name"`
+	Alias       string                  `json:"alias,omitempty"`
+	Description string                  `json:"description"`
+	InputSchema any                     `json:"input_schema"`
+	ReadOnly    bool                    `json:"read_only"`
+	Toolset     string                  `json:"toolset"`
+	Deprecation string                  `json:"deprecation,omitempty"`
+	Cost        string                  `json:"cost"`
+	Examples    []inventory.ToolExample `json:"examples,omitempty"`
+}
+
+// GetToolSchema creates a tool that returns the definition of another tool.
+func GetToolSchema(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "get_tool_schema",
+			Description: t("TOOL_GET_TOOL_SCHEMA_DESCRIPTION", "Get the description, input schema, read-only hint, toolset, approximate API cost (small, medium or large), example calls and any deprecation notice of a tool. Use this to construct valid arguments before calling a tool you are unsure about"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_TOOL_SCHEMA_USER_TITLE", "Get tool schema"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"tool": {
+						Type:        "string",
+						Description: "Name of the tool, or a deprecated alias of it",
+					},
+				},
+				Required: []string{"tool"},
+			},
+		},
+		nil,
+		func(ctx context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			name, err := RequiredParam[string](args, "tool")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			inv, ok := InventoryFromContext(ctx)
+			if !ok {
+				return utils.NewToolResultError("tool information is not available"), nil, nil
+			}
+
+			tool, alias, err := inv.ToolByCanonicalOrAlias(name)
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("tool %q not found", name)), nil, nil
+			}
+
+			deprecation := tool.DeprecationNotice()
+			if alias != "" && deprecation == "" {
+				deprecation = fmt.Sprintf("%s is a deprecated alias, use %s instead", alias, tool.Tool.Name)
+			}
+
+			return MarshalledTextResult(ToolSchema{
+				Name:        tool.Tool.Name,
+				Alias:       alias,
+				Description: tool.Tool.Description,
+				InputSchema: tool.Tool.InputSchema,
+				ReadOnly:    tool.IsReadOnly(),
+				Toolset:     string(tool.Toolset.ID),
+				Deprecation: deprecation,
+				Cost:        string(tool.EstimatedCost()),
+				Examples:    tool.Examples,
+			}), nil, nil
+		},
+	)
+}
@@ -52,21 +52,40 @@ func Test_GetMe(t *testing.T) {
 		requestArgs        map[string]any
 		expectToolError    bool
 		expectedUser       *github.User
+		expectFullDetails  bool
 		expectedToolErrMsg string
 	}{
 		{
 			name: "successful get user",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				GetUser: mockResponse(t, http.StatusOK, mockUser),
+				GetAuthenticatedUser: mockResponse(t, http.StatusOK, mockUser),
 			}),
 			requestArgs:     map[string]any{},
 			expectToolError: false,
 			expectedUser:    mockUser,
 		},
+		{
+			name: "successful get user with full detail",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetAuthenticatedUser: mockResponse(t, http.StatusOK, mockUser),
+			}),
+			requestArgs: map[string]any{
+				"detail": "full",
+			},
+			expectToolError:   false,
+			expectedUser:      mockUser,
+			expectFullDetails: true,
+		},
+		{
+			name:               "invalid detail",
+			requestArgs:        map[string]any{"detail": "everything"},
+			expectToolError:    true,
+			expectedToolErrMsg: "invalid detail \"everything\": must be 'minimal' or 'full'",
+		},
 		{
 			name: "successful get user with reason",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				GetUser: mockResponse(t, http.StatusOK, mockUser),
+				GetAuthenticatedUser: mockResponse(t, http.StatusOK, mockUser),
 			}),
 			requestArgs: map[string]any{
 				"reason": "Testing API",
@@ -84,7 +103,7 @@ func Test_GetMe(t *testing.T) {
 		{
 			name: "get user fails",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				GetUser: badRequestHandler("expected test failure"),
+				GetAuthenticatedUser: badRequestHandler("expected test failure"),
 			}),
 			requestArgs:        map[string]any{},
 			expectToolError:    true,
@@ -123,8 +142,17 @@ func Test_GetMe(t *testing.T) {
 
 			// Verify minimal user details
 			assert.Equal(t, *tc.expectedUser.Login, returnedUser.Login)
+			assert.Equal(t, *tc.expectedUser.Name, returnedUser.Name)
 			assert.Equal(t, *tc.expectedUser.HTMLURL, returnedUser.ProfileURL)
 
+			if !tc.expectFullDetails {
+				// Minimal detail omits the heavy profile fields entirely
+				assert.Nil(t, returnedUser.Details)
+				assert.NotContains(t, textContent.Text, "email")
+				assert.NotContains(t, textContent.Text, "plan")
+				return
+			}
+
 			// Verify user details
 			require.NotNil(t, returnedUser.Details)
 			assert.Equal(t, *tc.expectedUser.Name, returnedUser.Details.Name)
@@ -134,6 +162,7 @@ func Test_GetMe(t *testing.T) {
 			assert.Equal(t, *tc.expectedUser.Location, returnedUser.Details.Location)
 			assert.Equal(t, *tc.expectedUser.Hireable, returnedUser.Details.Hireable)
 			assert.Equal(t, *tc.expectedUser.TwitterUsername, returnedUser.Details.TwitterUsername)
+			assert.Equal(t, "pro", returnedUser.Details.Plan)
 		})
 	}
 }
@@ -246,13 +275,13 @@ func Test_GetTeams(t *testing.T) {
 	// Factory function for mock HTTP clients with user response
 	httpClientWithUser := func() *http.Client {
 		return MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			GetUser: mockResponse(t, http.StatusOK, mockUser),
+			GetAuthenticatedUser: mockResponse(t, http.StatusOK, mockUser),
 		})
 	}
 
 	httpClientUserFails := func() *http.Client {
 		return MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-			GetUser: badRequestHandler("expected test failure"),
+			GetAuthenticatedUser: badRequestHandler("expected test failure"),
 		})
 	}
 
@@ -513,3 +542,145 @@ func Test_GetTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func Test_ListToolsets(t *testing.T) {
+	t.Parallel()
+
+	serverTool := ListToolsets(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_toolsets", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "list_toolsets tool should be read-only")
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"context", "actions"}).
+		Build()
+	require.NoError(t, err)
+
+	deps := BaseDeps{}
+	handler := serverTool.Handler(deps)
+	request := createMCPRequest(map[string]any{})
+
+	t.Run("reports toolset state", func(t *testing.T) {
+		ctx := ContextWithInventory(ContextWithDeps(context.Background(), deps), inv)
+		result, err := handler(ctx, &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var toolsets []ToolsetInfo
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &toolsets))
+		require.Len(t, toolsets, len(inv.AvailableToolsets()))
+
+		byID := make(map[string]ToolsetInfo, len(toolsets))
+		for _, ts := range toolsets {
+			byID[ts.ID] = ts
+		}
+
+		assert.True(t, byID["context"].Enabled)
+		assert.True(t, byID["context"].Default)
+		assert.True(t, byID["actions"].Enabled)
+		assert.False(t, byID["actions"].Default)
+		assert.False(t, byID["repos"].Enabled)
+		assert.True(t, byID["repos"].Default)
+		assert.Equal(t, len(inv.ToolsForToolset("actions")), byID["actions"].ToolCount)
+		assert.Len(t, byID["repos"].Icons, 2)
+		assert.Contains(t, byID["repos"].Icons[0], "data:image/png;base64,")
+	})
+
+	t.Run("inventory missing from context", func(t *testing.T) {
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, getErrorResult(t, result).Text, "toolset information is not available")
+	})
+}
+
+func Test_GetToolSchema(t *testing.T) {
+	t.Parallel()
+
+	serverTool := GetToolSchema(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_tool_schema", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_tool_schema tool should be read-only")
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithDeprecatedAliases(map[string]string{"get_issue": "issue_read"}).
+		Build()
+	require.NoError(t, err)
+
+	deps := BaseDeps{}
+	handler := serverTool.Handler(deps)
+	ctx := ContextWithInventory(ContextWithDeps(context.Background(), deps), inv)
+
+	tests := []struct {
+		name                string
+		tool                string
+		expectError         bool
+		expectedErrMsg      string
+		expectedAlias       string
+		expectedDeprecation string
+	}{
+		{
+			name: "canonical name",
+			tool: "issue_read",
+		},
+		{
+			name:                "deprecated alias",
+			tool:                "get_issue",
+			expectedAlias:       "get_issue",
+			expectedDeprecation: "get_issue is a deprecated alias, use issue_read instead",
+		},
+		{
+			name:           "unknown tool",
+			tool:           "does_not_exist",
+			expectError:    true,
+			expectedErrMsg: `tool "does_not_exist" not found`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(map[string]any{"tool": tc.tool})
+			result, err := handler(ctx, &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				assert.Contains(t, getErrorResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var schema struct {
+				ToolSchema
+				InputSchema map[string]any `json:"input_schema"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &schema))
+
+			assert.Equal(t, "issue_read", schema.Name)
+			assert.Equal(t, tc.expectedAlias, schema.Alias)
+			assert.Equal(t, "issues", schema.Toolset)
+			assert.True(t, schema.ReadOnly)
+			assert.NotEmpty(t, schema.Description)
+			assert.Equal(t, tc.expectedDeprecation, schema.Deprecation)
+			assert.Equal(t, "object", schema.InputSchema["type"])
+			assert.Contains(t, schema.InputSchema["properties"], "issue_number")
+			assert.Equal(t, "small", schema.Cost)
+			require.NotEmpty(t, schema.Examples)
+			assert.Equal(t, "get", schema.Examples[0].Arguments["method"])
+			assert.Contains(t, schema.Examples[0].Arguments, "issue_number")
+		})
+	}
+
+	// Tools that paginate advertise a higher cost
+	request := createMCPRequest(map[string]any{"tool": "get_latest_version_tag"})
+	result, err := handler(ctx, &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	var schema ToolSchema
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &schema))
+	assert.Equal(t, "large", schema.Cost)
+}
@@ -65,7 +65,8 @@ type linkedPullRequest struct {
 // pollConfigKey is a context key for polling configuration.
 type pollConfigKey struct{}
 
-// PollConfig configures the PR polling behavior.
+// PollConfig configures how tools poll for the outcome of asynchronous
+// operations, such as Copilot opening a PR or a fork becoming ready.
 type PollConfig struct {
 	MaxAttempts int
 	Delay       time.Duration
@@ -126,6 +126,29 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 						Description: "Filter dependabot alerts by severity",
 						Enum:        []any{"low", "medium", "high", "critical"},
 					},
+					"ecosystem": {
+						Type:        "string",
+						Description: "Filter dependabot alerts by package ecosystem. Can be a comma-separated list, e.g. 'npm,pip'. Supported values: composer, go, maven, npm, nuget, pip, pub, rubygems, rust",
+					},
+					"package": {
+						Type:        "string",
+						Description: "Filter dependabot alerts by package name. Can be a comma-separated list",
+					},
+					"scope": {
+						Type:        "string",
+						Description: "Filter dependabot alerts by the scope of the vulnerable dependency",
+						Enum:        []any{"runtime", "development"},
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Property to sort dependabot alerts by. Defaults to created",
+						Enum:        []any{"created", "updated", "epss_percentage"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "Sort direction. Defaults to desc",
+						Enum:        []any{"asc", "desc"},
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -148,6 +171,26 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			ecosystem, err := OptionalParam[string](args, "ecosystem")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pkg, err := OptionalParam[string](args, "package")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			scope, err := OptionalParam[string](args, "scope")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			client, err := deps.GetClient(ctx)
 			if err != nil {
@@ -155,8 +198,13 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 			}
 
 			alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repo, &github.ListAlertsOptions{
-				State:    ToStringPtr(state),
-				Severity: ToStringPtr(severity),
+				State:     ToStringPtr(state),
+				Severity:  ToStringPtr(severity),
+				Ecosystem: ToStringPtr(ecosystem),
+				Package:   ToStringPtr(pkg),
+				Scope:     ToStringPtr(scope),
+				Sort:      ToStringPtr(sort),
+				Direction: ToStringPtr(direction),
 			})
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -184,3 +232,253 @@ func ListDependabotAlerts(t translations.TranslationHelperFunc) inventory.Server
 		},
 	)
 }
+
+// validDependabotDismissedReasons lists the reasons accepted when dismissing a Dependabot alert.
+var validDependabotDismissedReasons = map[string]bool{
+	"fix_started":    true,
+	"inaccurate":     true,
+	"no_bandwidth":   true,
+	"not_used":       true,
+	"tolerable_risk": true,
+}
+
+// UpdateDependabotAlert creates a tool to dismiss or reopen a Dependabot alert.
+func UpdateDependabotAlert(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDependabot,
+		mcp.Tool{
+			Name:        "update_dependabot_alert",
+			Description: t("TOOL_UPDATE_DEPENDABOT_ALERT_DESCRIPTION", "Dismiss or reopen a dependabot alert in a GitHub repository. A dismissedReason is required when dismissing."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_DEPENDABOT_ALERT_USER_TITLE", "Update dependabot alert"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"alertNumber": {
+						Type:        "number",
+						Description: "The number of the alert.",
+					},
+					"state": {
+						Type:        "string",
+						Description: "The new state of the alert.",
+						Enum:        []any{"dismissed", "open"},
+					},
+					"dismissedReason": {
+						Type:        "string",
+						Description: "The reason for dismissing the alert. Required when state is dismissed.",
+						Enum:        []any{"fix_started", "inaccurate", "no_bandwidth", "not_used", "tolerable_risk"},
+					},
+					"dismissedComment": {
+						Type:        "string",
+						Description: "An optional comment explaining why the alert was dismissed.",
+					},
+				},
+				Required: []string{"owner", "repo", "alertNumber", "state"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			alertNumber, err := RequiredInt(args, "alertNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			state, err := RequiredParam[string](args, "state")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dismissedReason, err := OptionalParam[string](args, "dismissedReason")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			dismissedComment, err := OptionalParam[string](args, "dismissedComment")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			stateInfo := &github.DependabotAlertState{State: state}
+			switch state {
+			case "dismissed":
+				if dismissedReason == "" {
+					return utils.NewToolResultError("dismissedReason is required when state is dismissed"), nil, nil
+				}
+				if !validDependabotDismissedReasons[dismissedReason] {
+					return utils.NewToolResultError(fmt.Sprintf("invalid dismissedReason %q: must be one of fix_started, inaccurate, no_bandwidth, not_used, tolerable_risk", dismissedReason)), nil, nil
+				}
+				stateInfo.DismissedReason = github.Ptr(dismissedReason)
+				stateInfo.DismissedComment = ToStringPtr(dismissedComment)
+			case "open":
+				if dismissedReason != "" || dismissedComment != "" {
+					return utils.NewToolResultError("dismissedReason and dismissedComment can only be set when state is dismissed"), nil, nil
+				}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid state %q: must be \"dismissed\" or \"open\"", state)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, err
+			}
+
+			alert, resp, err := client.Dependabot.UpdateAlert(ctx, owner, repo, alertNumber, stateInfo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update alert with number '%d'", alertNumber),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return utils.NewToolResultErrorFromErr("failed to read response body", err), nil, err
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update alert", resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(alert)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal alert", err), nil, err
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// defaultSBOMMaxPackages caps the number of packages returned by get_repository_sbom so
+// that repositories with very large dependency sets do not overflow the context window.
+const defaultSBOMMaxPackages = 500
+
+// RepositorySBOM wraps an SPDX SBOM document with truncation details.
+type RepositorySBOM struct {
+	SBOM          *github.SBOMInfo `json:"sbom"`
+	Truncated     bool             `json:"truncated"`
+	TotalPackages int              `json:"total_packages"`
+}
+
+// truncateSBOM limits the SBOM to maxPackages packages, dropping relationships that
+// reference packages which were removed.
+func truncateSBOM(sbom *github.SBOMInfo, maxPackages int) RepositorySBOM {
+	result := RepositorySBOM{SBOM: sbom, TotalPackages: len(sbom.Packages)}
+	if len(sbom.Packages) <= maxPackages {
+		return result
+	}
+
+	kept := make(map[string]bool, maxPackages+1)
+	kept[sbom.GetSPDXID()] = true
+	for _, pkg := range sbom.Packages[:maxPackages] {
+		kept[pkg.GetSPDXID()] = true
+	}
+
+	relationships := make([]*github.SBOMRelationship, 0, len(sbom.Relationships))
+	for _, rel := range sbom.Relationships {
+		if kept[rel.SPDXElementID] && kept[rel.RelatedSPDXElement] {
+			relationships = append(relationships, rel)
+		}
+	}
+
+	truncated := *sbom
+	truncated.Packages = sbom.Packages[:maxPackages]
+	truncated.Relationships = relationships
+	result.SBOM = &truncated
+	result.Truncated = true
+	return result
+}
+
+// GetRepositorySBOM creates a tool to export the dependency graph SBOM of a repository.
+func GetRepositorySBOM(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataDependabot,
+		mcp.Tool{
+			Name:        "get_repository_sbom",
+			Description: t("TOOL_GET_REPOSITORY_SBOM_DESCRIPTION", "Export the software bill of materials (SBOM) for a GitHub repository as an SPDX document built from its dependency graph. Large SBOMs are truncated to maxPackages packages; check the truncated and total_packages fields."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_SBOM_USER_TITLE", "Get repository SBOM"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"maxPackages": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of packages to return. Defaults to %d", defaultSBOMMaxPackages),
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxPackages, err := OptionalIntParamWithDefault(args, "maxPackages", defaultSBOMMaxPackages)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxPackages < 1 {
+				return utils.NewToolResultError("maxPackages must be at least 1"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, err
+			}
+
+			sbom, resp, err := client.DependencyGraph.GetSBOM(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get SBOM for repository '%s/%s'", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if sbom.GetSBOM() == nil {
+				return utils.NewToolResultError(fmt.Sprintf("no SBOM returned for repository '%s/%s'", owner, repo)), nil, nil
+			}
+
+			r, err := json.Marshal(truncateSBOM(sbom.GetSBOM(), maxPackages))
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to marshal SBOM", err), nil, err
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
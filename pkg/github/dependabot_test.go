@@ -179,6 +179,35 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			expectError:    false,
 			expectedAlerts: []*github.DependabotAlert{&highSeverityAlert},
 		},
+		{
+			name: "successful listing with combined filters",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependabotAlertsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"state":     "open",
+					"severity":  "critical",
+					"ecosystem": "npm",
+					"package":   "lodash",
+					"scope":     "runtime",
+					"sort":      "updated",
+					"direction": "asc",
+				}).andThen(
+					mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "open",
+				"severity":  "critical",
+				"ecosystem": "npm",
+				"package":   "lodash",
+				"scope":     "runtime",
+				"sort":      "updated",
+				"direction": "asc",
+			},
+			expectError:    false,
+			expectedAlerts: []*github.DependabotAlert{&criticalAlert},
+		},
 		{
 			name: "successful all alerts listing",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -250,3 +279,290 @@ func Test_ListDependabotAlerts(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateDependabotAlert(t *testing.T) {
+	// Verify tool definition once
+	toolDef := UpdateDependabotAlert(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_dependabot_alert", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint, "update_dependabot_alert tool should not be read-only")
+
+	dismissedAlert := github.DependabotAlert{
+		Number:           github.Ptr(42),
+		HTMLURL:          github.Ptr("https://github.com/owner/repo/security/dependabot/42"),
+		State:            github.Ptr("dismissed"),
+		DismissedReason:  github.Ptr("tolerable_risk"),
+		DismissedComment: github.Ptr("Only used in tests"),
+	}
+	openAlert := github.DependabotAlert{
+		Number:  github.Ptr(42),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/security/dependabot/42"),
+		State:   github.Ptr("open"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedAlert  *github.DependabotAlert
+		expectedErrMsg string
+	}{
+		{
+			name: "successful dismissal with reason",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state":             "dismissed",
+					"dismissed_reason":  "tolerable_risk",
+					"dismissed_comment": "Only used in tests",
+				}).andThen(
+					mockResponse(t, http.StatusOK, dismissedAlert),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":            "owner",
+				"repo":             "repo",
+				"alertNumber":      float64(42),
+				"state":            "dismissed",
+				"dismissedReason":  "tolerable_risk",
+				"dismissedComment": "Only used in tests",
+			},
+			expectedAlert: &dismissedAlert,
+		},
+		{
+			name: "successful reopen",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(
+					mockResponse(t, http.StatusOK, openAlert),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "open",
+			},
+			expectedAlert: &openAlert,
+		},
+		{
+			name:         "dismissal without reason",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "dismissed",
+			},
+			expectError:    true,
+			expectedErrMsg: "dismissedReason is required when state is dismissed",
+		},
+		{
+			name:         "invalid dismissed reason",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"alertNumber":     float64(42),
+				"state":           "dismissed",
+				"dismissedReason": "wont_fix",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid dismissedReason "wont_fix"`,
+		},
+		{
+			name:         "invalid state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(42),
+				"state":       "fixed",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid state "fixed"`,
+		},
+		{
+			name:         "reason supplied when reopening",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"alertNumber":     float64(42),
+				"state":           "open",
+				"dismissedReason": "inaccurate",
+			},
+			expectError:    true,
+			expectedErrMsg: "can only be set when state is dismissed",
+		},
+		{
+			name: "alert update fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposDependabotAlertsByOwnerByRepoByAlertNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"alertNumber": float64(9999),
+				"state":       "open",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update alert with number '9999'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returnedAlert github.DependabotAlert
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAlert)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedAlert.Number, *returnedAlert.Number)
+			assert.Equal(t, *tc.expectedAlert.State, *returnedAlert.State)
+			assert.Equal(t, tc.expectedAlert.GetDismissedReason(), returnedAlert.GetDismissedReason())
+			assert.Equal(t, tc.expectedAlert.GetDismissedComment(), returnedAlert.GetDismissedComment())
+		})
+	}
+}
+
+func Test_GetRepositorySBOM(t *testing.T) {
+	// Verify tool definition once
+	toolDef := GetRepositorySBOM(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_repository_sbom", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint, "get_repository_sbom tool should be read-only")
+
+	mockSBOM := &github.SBOM{
+		SBOM: &github.SBOMInfo{
+			SPDXID:      github.Ptr("SPDXRef-DOCUMENT"),
+			SPDXVersion: github.Ptr("SPDX-2.3"),
+			Name:        github.Ptr("com.github.owner/repo"),
+			Packages: []*github.RepoDependencies{
+				{SPDXID: github.Ptr("SPDXRef-repo"), Name: github.Ptr("com.github.owner/repo")},
+				{SPDXID: github.Ptr("SPDXRef-npm-lodash"), Name: github.Ptr("npm:lodash"), VersionInfo: github.Ptr("4.17.21")},
+				{SPDXID: github.Ptr("SPDXRef-npm-express"), Name: github.Ptr("npm:express"), VersionInfo: github.Ptr("4.19.2")},
+			},
+			Relationships: []*github.SBOMRelationship{
+				{SPDXElementID: "SPDXRef-DOCUMENT", RelatedSPDXElement: "SPDXRef-repo", RelationshipType: "DESCRIBES"},
+				{SPDXElementID: "SPDXRef-repo", RelatedSPDXElement: "SPDXRef-npm-lodash", RelationshipType: "DEPENDS_ON"},
+				{SPDXElementID: "SPDXRef-repo", RelatedSPDXElement: "SPDXRef-npm-express", RelationshipType: "DEPENDS_ON"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		mockedClient          *http.Client
+		requestArgs           map[string]any
+		expectError           bool
+		expectedTruncated     bool
+		expectedPackages      []string
+		expectedRelationships int
+		expectedErrMsg        string
+	}{
+		{
+			name: "successful SBOM export",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphSbomByOwnerByRepo: mockResponse(t, http.StatusOK, mockSBOM),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedPackages:      []string{"com.github.owner/repo", "npm:lodash", "npm:express"},
+			expectedRelationships: 3,
+		},
+		{
+			name: "SBOM truncated past the package cap",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphSbomByOwnerByRepo: mockResponse(t, http.StatusOK, mockSBOM),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"maxPackages": float64(2),
+			},
+			expectedTruncated:     true,
+			expectedPackages:      []string{"com.github.owner/repo", "npm:lodash"},
+			expectedRelationships: 2,
+		},
+		{
+			name: "SBOM export fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposDependencyGraphSbomByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get SBOM for repository 'owner/repo'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returned RepositorySBOM
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTruncated, returned.Truncated)
+			assert.Equal(t, len(mockSBOM.SBOM.Packages), returned.TotalPackages)
+			assert.Equal(t, "SPDX-2.3", returned.SBOM.GetSPDXVersion())
+
+			names := make([]string, 0, len(returned.SBOM.Packages))
+			for _, pkg := range returned.SBOM.Packages {
+				names = append(names, pkg.GetName())
+			}
+			assert.Equal(t, tc.expectedPackages, names)
+			assert.Len(t, returned.SBOM.Relationships, tc.expectedRelationships)
+		})
+	}
+}
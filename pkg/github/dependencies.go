@@ -64,6 +64,74 @@ func MustDepsFromContext(ctx context.Context) ToolDependencies {
 	return deps
 }
 
+// toolsetContextKey is the context key for the ID of the toolset whose tool is being called.
+type toolsetContextKey struct{}
+
+// ContextWithToolset returns a new context recording that a tool from the given toolset is being called.
+// NewTool and NewToolFromHandler do this before invoking a handler.
+func ContextWithToolset(ctx context.Context, id inventory.ToolsetID) context.Context {
+	return context.WithValue(ctx, toolsetContextKey{}, id)
+}
+
+// ToolsetFromContext returns the ID of the toolset whose tool is being called.
+// Returns false if the context was not set up by ContextWithToolset.
+func ToolsetFromContext(ctx context.Context) (inventory.ToolsetID, bool) {
+	id, ok := ctx.Value(toolsetContextKey{}).(inventory.ToolsetID)
+	return id, ok
+}
+
+// inventoryContextKey is the context key for the server's Inventory.
+type inventoryContextKey struct{}
+
+// InjectInventoryMiddleware makes the server's Inventory available to tool handlers
+// that describe the toolset selection, such as list_toolsets.
+func InjectInventoryMiddleware(inv *inventory.Inventory) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+			return next(ContextWithInventory(ctx, inv), method, req)
+		}
+	}
+}
+
+// ContextWithInventory returns a new context with the Inventory stored in it.
+func ContextWithInventory(ctx context.Context, inv *inventory.Inventory) context.Context {
+	return context.WithValue(ctx, inventoryContextKey{}, inv)
+}
+
+// InventoryFromContext retrieves the Inventory from the context.
+// Returns the inventory and true if found, or nil and false if not present.
+func InventoryFromContext(ctx context.Context) (*inventory.Inventory, bool) {
+	inv, ok := ctx.Value(inventoryContextKey{}).(*inventory.Inventory)
+	return inv, ok && inv != nil
+}
+
+// toolCallMiddlewareContextKey is the context key for the middleware the server
+// applies to tool calls.
+type toolCallMiddlewareContextKey struct{}
+
+// injectToolCallMiddleware makes the middleware the server applies to tool calls
+// available to tool handlers that call other tools, such as batch_call.
+func injectToolCallMiddleware(middleware ...mcp.Middleware) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+			return next(ContextWithToolCallMiddleware(ctx, middleware...), method, req)
+		}
+	}
+}
+
+// ContextWithToolCallMiddleware returns a new context with the tool call middleware
+// stored in it, outermost first.
+func ContextWithToolCallMiddleware(ctx context.Context, middleware ...mcp.Middleware) context.Context {
+	return context.WithValue(ctx, toolCallMiddlewareContextKey{}, middleware)
+}
+
+// ToolCallMiddlewareFromContext retrieves the tool call middleware from the context,
+// outermost first. Returns nil if none was stored.
+func ToolCallMiddlewareFromContext(ctx context.Context) []mcp.Middleware {
+	middleware, _ := ctx.Value(toolCallMiddlewareContextKey{}).([]mcp.Middleware)
+	return middleware
+}
+
 // ToolDependencies defines the interface for dependencies that tool handlers need.
 // This is an interface to allow different implementations:
 //   - Local server: stores closures that create clients on demand
@@ -83,6 +151,9 @@ type ToolDependencies interface {
 	// GetRepoAccessCache returns the lockdown mode repo access cache
 	GetRepoAccessCache(ctx context.Context) (*lockdown.RepoAccessCache, error)
 
+	// GetRepoMetadataCache returns the session's repository metadata cache, or nil if caching is disabled
+	GetRepoMetadataCache(ctx context.Context) *RepoMetadataCache
+
 	// GetT returns the translation helper function
 	GetT() translations.TranslationHelperFunc
 
@@ -107,12 +178,19 @@ type BaseDeps struct {
 
 	// Static dependencies
 	RepoAccessCache   *lockdown.RepoAccessCache
+	RepoMetadataCache *RepoMetadataCache
 	T                 translations.TranslationHelperFunc
 	Flags             FeatureFlags
 	ContentWindowSize int
 
 	// Feature flag checker for runtime checks
 	featureChecker inventory.FeatureFlagChecker
+
+	// Per-toolset REST client factories that take precedence over Client
+	toolsetClients map[inventory.ToolsetID]GetClientFn
+
+	// API host used to build GraphQL and raw clients for a token in the context
+	apiHost utils.APIHostResolver
 }
 
 // Compile-time assertion to verify that BaseDeps implements the ToolDependencies interface.
@@ -141,19 +219,110 @@ func NewBaseDeps(
 	}
 }
 
+// WithClientForToolset registers a REST client factory used by tools in the given toolset
+// instead of the default client, for example to give write or security tools a separate token.
+// Tools in toolsets without a registered factory keep using the default client.
+func (d *BaseDeps) WithClientForToolset(id inventory.ToolsetID, fn GetClientFn) *BaseDeps {
+	if d.toolsetClients == nil {
+		d.toolsetClients = make(map[inventory.ToolsetID]GetClientFn)
+	}
+	d.toolsetClients[id] = fn
+	return d
+}
+
+// WithAPIHost sets the API host that GetGQLClient and GetRawClient build clients
+// for when the context carries a token (see GetClient). Without it, calls with a
+// token in the context cannot get GraphQL or raw clients.
+func (d *BaseDeps) WithAPIHost(apiHost utils.APIHostResolver) *BaseDeps {
+	d.apiHost = apiHost
+	return d
+}
+
 // GetClient implements ToolDependencies.
-func (d BaseDeps) GetClient(_ context.Context) (*gogithub.Client, error) {
+//
+// Clients are resolved in order: a client registered for the calling tool's toolset
+// with WithClientForToolset; a new client authenticated with the token stored in ctx by
+// ghcontext.WithTokenInfo, so that multi-tenant embedders can authenticate each request
+// separately; and finally the default Client. Per-request clients are never cached.
+func (d BaseDeps) GetClient(ctx context.Context) (*gogithub.Client, error) {
+	if id, ok := ToolsetFromContext(ctx); ok {
+		if fn, ok := d.toolsetClients[id]; ok {
+			return fn(ctx)
+		}
+	}
+	if tokenInfo, ok := ghcontext.GetTokenInfo(ctx); ok && tokenInfo.Token != "" {
+		return restClientForToken(d.Client, tokenInfo.Token), nil
+	}
 	return d.Client, nil
 }
 
+// restClientForToken returns a new REST client authenticated with token that targets
+// the same API host as base.
+func restClientForToken(base *gogithub.Client, token string) *gogithub.Client {
+	client := gogithub.NewClient(nil).WithAuthToken(token)
+	if base != nil {
+		client.BaseURL = base.BaseURL
+		client.UploadURL = base.UploadURL
+		client.UserAgent = base.UserAgent
+	}
+	return client
+}
+
 // GetGQLClient implements ToolDependencies.
-func (d BaseDeps) GetGQLClient(_ context.Context) (*githubv4.Client, error) {
-	return d.GQLClient, nil
+//
+// Like GetClient, it builds a new client for the token stored in ctx by
+// ghcontext.WithTokenInfo, for the GraphQL URL of the API host set with
+// WithAPIHost, so that a tool call never mixes the request's identity with the
+// server's. Otherwise it returns the default GQLClient.
+func (d BaseDeps) GetGQLClient(ctx context.Context) (*githubv4.Client, error) {
+	tokenInfo, ok := ghcontext.GetTokenInfo(ctx)
+	if !ok || tokenInfo.Token == "" {
+		return d.GQLClient, nil
+	}
+	if d.apiHost == nil {
+		return nil, fmt.Errorf("no API host configured to build a GraphQL client for the request token")
+	}
+	graphqlURL, err := d.apiHost.GraphqlURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GraphQL URL: %w", err)
+	}
+	return gqlClientForToken(graphqlURL.String(), tokenInfo.Token), nil
 }
 
 // GetRawClient implements ToolDependencies.
-func (d BaseDeps) GetRawClient(_ context.Context) (*raw.Client, error) {
-	return d.RawClient, nil
+//
+// With a token in ctx it builds a new client on the request's REST client (see
+// GetClient), for the raw URL of the API host set with WithAPIHost. Otherwise it
+// returns the default RawClient.
+func (d BaseDeps) GetRawClient(ctx context.Context) (*raw.Client, error) {
+	tokenInfo, ok := ghcontext.GetTokenInfo(ctx)
+	if !ok || tokenInfo.Token == "" {
+		return d.RawClient, nil
+	}
+	if d.apiHost == nil {
+		return nil, fmt.Errorf("no API host configured to build a raw client for the request token")
+	}
+	rawURL, err := d.apiHost.RawURL(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Raw URL: %w", err)
+	}
+	return raw.NewClient(restClientForToken(d.Client, tokenInfo.Token), rawURL), nil
+}
+
+// gqlClientForToken returns a new GraphQL client for graphqlURL authenticated with
+// token. Its transport injects GraphQL feature flags from the request context, as
+// the remote server does.
+func gqlClientForToken(graphqlURL, token string) *githubv4.Client {
+	// We use NewEnterpriseClient unconditionally since the API host is already parsed
+	gqlHTTPClient := &http.Client{
+		Transport: &transport.BearerAuthTransport{
+			Transport: &transport.GraphQLFeaturesTransport{
+				Transport: http.DefaultTransport,
+			},
+			Token: token,
+		},
+	}
+	return githubv4.NewEnterpriseClient(graphqlURL, gqlHTTPClient)
 }
 
 // GetRepoAccessCache implements ToolDependencies.
@@ -161,6 +330,11 @@ func (d BaseDeps) GetRepoAccessCache(_ context.Context) (*lockdown.RepoAccessCac
 	return d.RepoAccessCache, nil
 }
 
+// GetRepoMetadataCache implements ToolDependencies.
+func (d BaseDeps) GetRepoMetadataCache(_ context.Context) *RepoMetadataCache {
+	return d.RepoMetadataCache
+}
+
 // GetT implements ToolDependencies.
 func (d BaseDeps) GetT() translations.TranslationHelperFunc { return d.T }
 
@@ -206,7 +380,9 @@ func NewTool[In, Out any](
 ) inventory.ServerTool {
 	st := inventory.NewServerToolWithContextHandler(tool, toolset, func(ctx context.Context, req *mcp.CallToolRequest, args In) (*mcp.CallToolResult, Out, error) {
 		deps := MustDepsFromContext(ctx)
-		return handler(ctx, deps, req, args)
+		result, out, err := handler(ContextWithToolset(ctx, toolset.ID), deps, req, args)
+		dropAuthenticatedUserOnAuthError(ctx, result)
+		return result, out, err
 	})
 	st.RequiredScopes = scopes.ToStringSlice(requiredScopes...)
 	st.AcceptedScopes = scopes.ExpandScopes(requiredScopes...)
@@ -229,7 +405,9 @@ func NewToolFromHandler(
 ) inventory.ServerTool {
 	st := inventory.NewServerToolWithRawContextHandler(tool, toolset, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		deps := MustDepsFromContext(ctx)
-		return handler(ctx, deps, req)
+		result, err := handler(ContextWithToolset(ctx, toolset.ID), deps, req)
+		dropAuthenticatedUserOnAuthError(ctx, result)
+		return result, err
 	})
 	st.RequiredScopes = scopes.ToStringSlice(requiredScopes...)
 	st.AcceptedScopes = scopes.ExpandScopes(requiredScopes...)
@@ -303,28 +481,13 @@ func (d *RequestDeps) GetGQLClient(ctx context.Context) (*githubv4.Client, error
 	if !ok {
 		return nil, fmt.Errorf("no token info in context")
 	}
-	token := tokenInfo.Token
-
-	// Construct GraphQL client
-	// We use NewEnterpriseClient unconditionally since we already parsed the API host
-	// Wrap transport with GraphQLFeaturesTransport to inject feature flags from context,
-	// matching the transport chain used by the remote server.
-	gqlHTTPClient := &http.Client{
-		Transport: &transport.BearerAuthTransport{
-			Transport: &transport.GraphQLFeaturesTransport{
-				Transport: http.DefaultTransport,
-			},
-			Token: token,
-		},
-	}
 
 	graphqlURL, err := d.apiHosts.GraphqlURL(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get GraphQL URL: %w", err)
 	}
 
-	gqlClient := githubv4.NewEnterpriseClient(graphqlURL.String(), gqlHTTPClient)
-	return gqlClient, nil
+	return gqlClientForToken(graphqlURL.String(), tokenInfo.Token), nil
 }
 
 // GetRawClient implements ToolDependencies.
@@ -360,6 +523,13 @@ func (d *RequestDeps) GetRepoAccessCache(ctx context.Context) (*lockdown.RepoAcc
 	return instance, nil
 }
 
+// GetRepoMetadataCache implements ToolDependencies.
+// Request-scoped deps serve many users without a session to scope entries to,
+// so repository metadata is not cached.
+func (d *RequestDeps) GetRepoMetadataCache(_ context.Context) *RepoMetadataCache {
+	return nil
+}
+
 // GetT implements ToolDependencies.
 func (d *RequestDeps) GetT() translations.TranslationHelperFunc { return d.T }
 
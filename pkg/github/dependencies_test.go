@@ -2,12 +2,23 @@ package github_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/translations"
+	gogithub "github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsFeatureEnabled_WithEnabledFlag(t *testing.T) {
@@ -106,3 +117,171 @@ func TestIsFeatureEnabled_CheckerError(t *testing.T) {
 	result := deps.IsFeatureEnabled(context.Background(), "error_flag")
 	assert.False(t, result, "Expected false when checker returns error")
 }
+
+func TestWithClientForToolset(t *testing.T) {
+	t.Parallel()
+
+	defaultClient := gogithub.NewClient(nil)
+	securityClient := gogithub.NewClient(nil).WithAuthToken("security-token")
+
+	deps := github.NewBaseDeps(defaultClient, nil, nil, nil, translations.NullTranslationHelper, github.FeatureFlags{}, 0, nil).
+		WithClientForToolset(github.ToolsetMetadataCodeSecurity.ID, func(_ context.Context) (*gogithub.Client, error) {
+			return securityClient, nil
+		})
+
+	// clientTool returns a tool in toolset that records the client its handler receives.
+	clientTool := func(toolset inventory.ToolsetMetadata, got **gogithub.Client) inventory.ServerTool {
+		return github.NewTool(toolset, mcp.Tool{Name: "client_probe_" + string(toolset.ID)}, nil,
+			func(ctx context.Context, deps github.ToolDependencies, _ *mcp.CallToolRequest, _ map[string]any) (*mcp.CallToolResult, any, error) {
+				client, err := deps.GetClient(ctx)
+				*got = client
+				return nil, nil, err
+			})
+	}
+
+	var securityGot, issuesGot *gogithub.Client
+	ctx := github.ContextWithDeps(context.Background(), deps)
+	for _, tool := range []inventory.ServerTool{
+		clientTool(github.ToolsetMetadataCodeSecurity, &securityGot),
+		clientTool(github.ToolsetMetadataIssues, &issuesGot),
+	} {
+		_, err := tool.Handler(deps)(ctx, &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Arguments: json.RawMessage(`{}`)}})
+		require.NoError(t, err)
+	}
+
+	assert.Same(t, securityClient, securityGot, "tool in configured toolset should get the toolset client")
+	assert.Same(t, defaultClient, issuesGot, "tool in other toolset should fall back to the default client")
+
+	// Outside of a tool call there is no toolset, so the default client is used
+	client, err := deps.GetClient(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, defaultClient, client)
+}
+
+func TestGetClient_RequestScopedToken(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	defer server.Close()
+
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	defaultClient := gogithub.NewClient(nil).WithAuthToken("default-token")
+	defaultClient.BaseURL = baseURL
+
+	deps := github.NewBaseDeps(defaultClient, nil, nil, nil, translations.NullTranslationHelper, github.FeatureFlags{}, 0, nil)
+
+	ctxA := ghcontext.WithTokenInfo(context.Background(), &ghcontext.TokenInfo{Token: "token-a"})
+	ctxB := ghcontext.WithTokenInfo(context.Background(), &ghcontext.TokenInfo{Token: "token-b"})
+
+	clientA, err := deps.GetClient(ctxA)
+	require.NoError(t, err)
+	clientB, err := deps.GetClient(ctxB)
+	require.NoError(t, err)
+	clientAAgain, err := deps.GetClient(ctxA)
+	require.NoError(t, err)
+
+	assert.NotSame(t, clientA, clientB)
+	assert.NotSame(t, defaultClient, clientA)
+	assert.NotSame(t, clientA, clientAAgain, "per-request clients must not be cached")
+
+	for _, call := range []struct {
+		ctx    context.Context
+		client *gogithub.Client
+	}{
+		{ctxA, clientA},
+		{ctxB, clientB},
+		{context.Background(), defaultClient},
+	} {
+		_, _, err := call.client.Users.Get(call.ctx, "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"Bearer token-a", "Bearer token-b", "Bearer default-token"}, gotAuth)
+
+	// Without a token in the context the default client is used
+	client, err := deps.GetClient(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, defaultClient, client)
+}
+
+// testAPIHost resolves every API URL to a test server.
+type testAPIHost struct {
+	baseURL *url.URL
+}
+
+func (h testAPIHost) BaseRESTURL(_ context.Context) (*url.URL, error) {
+	return h.baseURL, nil
+}
+
+func (h testAPIHost) UploadURL(_ context.Context) (*url.URL, error) {
+	return h.baseURL, nil
+}
+
+func (h testAPIHost) GraphqlURL(_ context.Context) (*url.URL, error) {
+	return h.baseURL.JoinPath("graphql"), nil
+}
+
+func (h testAPIHost) RawURL(_ context.Context) (*url.URL, error) {
+	return h.baseURL.JoinPath("raw/"), nil
+}
+
+func TestGetGQLAndRawClient_RequestScopedToken(t *testing.T) {
+	t.Parallel()
+
+	var gotAuth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.URL.Path+" "+r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"data": {"viewer": {"login": "octocat"}}}`))
+	}))
+	defer server.Close()
+
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	defaultClient := gogithub.NewClient(nil).WithAuthToken("default-token")
+	defaultClient.BaseURL = baseURL
+	defaultGQLClient := githubv4.NewClient(nil)
+	defaultRawClient := raw.NewClient(defaultClient, baseURL)
+
+	deps := github.NewBaseDeps(defaultClient, defaultGQLClient, defaultRawClient, nil, translations.NullTranslationHelper, github.FeatureFlags{}, 0, nil)
+	ctx := ghcontext.WithTokenInfo(context.Background(), &ghcontext.TokenInfo{Token: "token-a"})
+
+	// Without an API host a request token cannot fall back to the server's clients
+	_, err = deps.GetGQLClient(ctx)
+	assert.ErrorContains(t, err, "no API host configured")
+	_, err = deps.GetRawClient(ctx)
+	assert.ErrorContains(t, err, "no API host configured")
+
+	deps.WithAPIHost(testAPIHost{baseURL: baseURL})
+
+	gqlClient, err := deps.GetGQLClient(ctx)
+	require.NoError(t, err)
+	assert.NotSame(t, defaultGQLClient, gqlClient)
+	var query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	require.NoError(t, gqlClient.Query(ctx, &query, nil))
+	assert.Equal(t, "octocat", string(query.Viewer.Login))
+
+	rawClient, err := deps.GetRawClient(ctx)
+	require.NoError(t, err)
+	assert.NotSame(t, defaultRawClient, rawClient)
+	resp, err := rawClient.GetRawContent(ctx, "owner", "repo", "README.md", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, []string{"/graphql Bearer token-a", "/raw/owner/repo/HEAD/README.md Bearer token-a"}, gotAuth)
+
+	// Without a token in the context the default clients are used
+	gqlClient, err = deps.GetGQLClient(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, defaultGQLClient, gqlClient)
+	rawClient, err = deps.GetRawClient(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, defaultRawClient, rawClient)
+}
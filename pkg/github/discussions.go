@@ -517,7 +517,7 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 				Title:        t("TOOL_LIST_DISCUSSION_CATEGORIES_USER_TITLE", "List discussion categories"),
 				ReadOnlyHint: true,
 			},
-			InputSchema: &jsonschema.Schema{
+			InputSchema: WithCursorPagination(&jsonschema.Schema{
 				Type: "object",
 				Properties: map[string]*jsonschema.Schema{
 					"owner": {
@@ -530,7 +530,7 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 					},
 				},
 				Required: []string{"owner"},
-			},
+			}),
 		},
 		[]scopes.Scope{scopes.Repo},
 		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
@@ -548,6 +548,16 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 				repo = ".github"
 			}
 
+			// Get pagination parameters and convert to GraphQL format
+			pagination, err := OptionalCursorPaginationParams(args)
+			if err != nil {
+				return nil, nil, err
+			}
+			paginationParams, err := pagination.ToGraphQLParams()
+			if err != nil {
+				return nil, nil, err
+			}
+
 			client, err := deps.GetGQLClient(ctx)
 			if err != nil {
 				return utils.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil, nil
@@ -567,13 +577,18 @@ func ListDiscussionCategories(t translations.TranslationHelperFunc) inventory.Se
 							EndCursor       githubv4.String
 						}
 						TotalCount int
-					} `graphql:"discussionCategories(first: $first)"`
+					} `graphql:"discussionCategories(first: $first, after: $after)"`
 				} `graphql:"repository(owner: $owner, name: $repo)"`
 			}
 			vars := map[string]any{
 				"owner": githubv4.String(owner),
 				"repo":  githubv4.String(repo),
-				"first": githubv4.Int(25),
+				"first": githubv4.Int(*paginationParams.First),
+			}
+			if paginationParams.After != nil {
+				vars["after"] = githubv4.String(*paginationParams.After)
+			} else {
+				vars["after"] = (*githubv4.String)(nil)
 			}
 			if err := client.Query(ctx, &q, vars); err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -285,6 +285,28 @@ func Test_ListDiscussions(t *testing.T) {
 		"after": (*string)(nil),
 	}
 
+	varsNextPage := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"first": float64(2),
+		"after": "Y3Vyc29yOjI=",
+	}
+
+	mockResponseNextPage := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"discussions": map[string]any{
+				"nodes": discussionsAll[1:],
+				"pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": true,
+					"startCursor":     "Y3Vyc29yOjM=",
+					"endCursor":       "Y3Vyc29yOjQ=",
+				},
+				"totalCount": 6,
+			},
+		},
+	})
+
 	tests := []struct {
 		name          string
 		reqParams     map[string]any
@@ -292,7 +314,20 @@ func Test_ListDiscussions(t *testing.T) {
 		errContains   string
 		expectedCount int
 		verifyOrder   func(t *testing.T, discussions []*github.Discussion)
+		// expectedEndCursor, when set, is the cursor the response must hand back for the next page
+		expectedEndCursor string
 	}{
+		{
+			name: "next page via after cursor",
+			reqParams: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(2),
+				"after":   "Y3Vyc29yOjI=",
+			},
+			expectedCount:     2,
+			expectedEndCursor: "Y3Vyc29yOjQ=",
+		},
 		{
 			name: "list all discussions without category filter",
 			reqParams: map[string]any{
@@ -410,6 +445,7 @@ func Test_ListDiscussions(t *testing.T) {
 	qBasicNoOrder := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qWithCategoryNoOrder := "query($after:String$categoryId:ID!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qBasicWithOrder := "query($after:String$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qBasicNoOrderAfter := "query($after:String!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 	qWithCategoryAndOrder := "query($after:String$categoryId:ID!$first:Int!$orderByDirection:OrderDirection!$orderByField:DiscussionOrderField!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussions(first: $first, after: $after, categoryId: $categoryId, orderBy: { field: $orderByField, direction: $orderByDirection }){nodes{number,title,createdAt,updatedAt,closed,isAnswered,answerChosenAt,author{login},category{name},url},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	for _, tc := range tests {
@@ -441,6 +477,9 @@ func Test_ListDiscussions(t *testing.T) {
 			case "repository not found error":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoOrder, varsRepoNotFound, mockErrorRepoNotFound)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
+			case "next page via after cursor":
+				matcher := githubv4mock.NewQueryMatcher(qBasicNoOrderAfter, varsNextPage, mockResponseNextPage)
+				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
 			case "list org-level discussions (no repo provided)":
 				matcher := githubv4mock.NewQueryMatcher(qBasicNoOrder, varsOrgLevel, mockResponseOrgLevel)
 				httpClient = githubv4mock.NewMockedHTTPClient(matcher)
@@ -477,6 +516,11 @@ func Test_ListDiscussions(t *testing.T) {
 
 			assert.Len(t, response.Discussions, tc.expectedCount, "Expected %d discussions, got %d", tc.expectedCount, len(response.Discussions))
 
+			if tc.expectedEndCursor != "" {
+				assert.True(t, response.PageInfo.HasNextPage)
+				assert.Equal(t, tc.expectedEndCursor, response.PageInfo.EndCursor)
+			}
+
 			// Verify order if verifyOrder function is provided
 			if tc.verifyOrder != nil {
 				tc.verifyOrder(t, response.Discussions)
@@ -690,20 +734,32 @@ func Test_ListDiscussionCategories(t *testing.T) {
 	assert.ElementsMatch(t, schema.Required, []string{"owner"})
 
 	// Use exact string query that matches implementation output
-	qListCategories := "query($first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussionCategories(first: $first){nodes{id,name},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	qListCategories := "query($after:String$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussionCategories(first: $first, after: $after){nodes{id,name},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
+	// A non-nil cursor is declared as a required variable
+	qListCategoriesAfter := "query($after:String!$first:Int!$owner:String!$repo:String!){repository(owner: $owner, name: $repo){discussionCategories(first: $first, after: $after){nodes{id,name},pageInfo{hasNextPage,hasPreviousPage,startCursor,endCursor},totalCount}}}"
 
 	// Variables for repository-level categories
 	varsRepo := map[string]any{
 		"owner": "owner",
 		"repo":  "repo",
-		"first": float64(25),
+		"first": float64(30),
+		"after": (*string)(nil),
 	}
 
 	// Variables for organization-level categories (using .github repo)
 	varsOrg := map[string]any{
 		"owner": "owner",
 		"repo":  ".github",
-		"first": float64(25),
+		"first": float64(30),
+		"after": (*string)(nil),
+	}
+
+	// Variables for fetching the page after a cursor
+	varsNextPage := map[string]any{
+		"owner": "owner",
+		"repo":  "repo",
+		"first": float64(2),
+		"after": "Y3Vyc29yOjI=",
 	}
 
 	mockRespRepo := githubv4mock.DataResponse(map[string]any{
@@ -724,6 +780,24 @@ func Test_ListDiscussionCategories(t *testing.T) {
 		},
 	})
 
+	mockRespNextPage := githubv4mock.DataResponse(map[string]any{
+		"repository": map[string]any{
+			"discussionCategories": map[string]any{
+				"nodes": []map[string]any{
+					{"id": "131", "name": "Q&A"},
+					{"id": "415", "name": "Show and tell"},
+				},
+				"pageInfo": map[string]any{
+					"hasNextPage":     true,
+					"hasPreviousPage": true,
+					"startCursor":     "Y3Vyc29yOjM=",
+					"endCursor":       "Y3Vyc29yOjQ=",
+				},
+				"totalCount": 6,
+			},
+		},
+	})
+
 	mockRespOrg := githubv4mock.DataResponse(map[string]any{
 		"repository": map[string]any{
 			"discussionCategories": map[string]any{
@@ -751,7 +825,27 @@ func Test_ListDiscussionCategories(t *testing.T) {
 		expectError        bool
 		expectedCount      int
 		expectedCategories []map[string]string
+		expectedEndCursor  string
+		expectedHasNext    bool
 	}{
+		{
+			name: "forwards the after cursor and returns the next cursor",
+			reqParams: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"perPage": float64(2),
+				"after":   "Y3Vyc29yOjI=",
+			},
+			vars:          varsNextPage,
+			mockResponse:  mockRespNextPage,
+			expectedCount: 2,
+			expectedCategories: []map[string]string{
+				{"id": "131", "name": "Q&A"},
+				{"id": "415", "name": "Show and tell"},
+			},
+			expectedEndCursor: "Y3Vyc29yOjQ=",
+			expectedHasNext:   true,
+		},
 		{
 			name: "list repository-level discussion categories",
 			reqParams: map[string]any{
@@ -787,7 +881,11 @@ func Test_ListDiscussionCategories(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			matcher := githubv4mock.NewQueryMatcher(qListCategories, tc.vars, tc.mockResponse)
+			query := qListCategories
+			if _, ok := tc.vars["after"].(string); ok {
+				query = qListCategoriesAfter
+			}
+			matcher := githubv4mock.NewQueryMatcher(query, tc.vars, tc.mockResponse)
 			httpClient := githubv4mock.NewMockedHTTPClient(matcher)
 			gqlClient := githubv4.NewClient(httpClient)
 
@@ -816,6 +914,8 @@ func Test_ListDiscussionCategories(t *testing.T) {
 			}
 			require.NoError(t, json.Unmarshal([]byte(text), &response))
 			assert.Equal(t, tc.expectedCategories, response.Categories)
+			assert.Equal(t, tc.expectedEndCursor, response.PageInfo.EndCursor)
+			assert.Equal(t, tc.expectedHasNext, response.PageInfo.HasNextPage)
 		})
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -54,16 +55,33 @@ func DynamicTools(r *inventory.Inventory) []inventory.ServerTool {
 		ListAvailableToolsets(),
 		GetToolsetsTools(r),
 		EnableToolset(r),
+		DisableToolset(r),
 	}
 }
 
+// EnableToolsetResult is the output type of enable_toolset.
+type EnableToolsetResult struct {
+	Toolset  string   `json:"toolset"`
+	Message  string   `json:"message"`
+	NewTools []string `json:"new_tools"`
+}
+
+// unknownToolsetError returns the error result for a toolset ID the inventory does not know.
+func unknownToolsetError(r *inventory.Inventory, toolsetName string) *mcp.CallToolResult {
+	valid := make([]string, 0, len(r.ToolsetIDs()))
+	for _, id := range r.ToolsetIDs() {
+		valid = append(valid, string(id))
+	}
+	return utils.NewToolResultError(fmt.Sprintf("Toolset %s not found. Valid toolsets are: %s", toolsetName, strings.Join(valid, ", ")))
+}
+
 // EnableToolset creates a tool that enables a toolset at runtime.
 func EnableToolset(r *inventory.Inventory) inventory.ServerTool {
 	return NewDynamicTool(
 		ToolsetMetadataDynamic,
 		mcp.Tool{
 			Name:        "enable_toolset",
-			Description: "Enable one of the sets of tools the GitHub MCP server provides, use get_toolset_tools and list_available_toolsets first to see what this will enable",
+			Description: "Enable one of the sets of tools the GitHub MCP server provides, use get_toolset_tools and list_available_toolsets first to see what this will enable. Returns the names of the tools that became available",
 			Annotations: &mcp.ToolAnnotations{
 				Title:        "Enable a toolset",
 				ReadOnlyHint: true,
@@ -81,7 +99,7 @@ func EnableToolset(r *inventory.Inventory) inventory.ServerTool {
 			},
 		},
 		func(deps DynamicToolDependencies) mcp.ToolHandlerFor[map[string]any, any] {
-			return func(_ context.Context, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			return func(ctx context.Context, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
 				toolsetName, err := RequiredParam[string](args, "toolset")
 				if err != nil {
 					return utils.NewToolResultError(err.Error()), nil, nil
@@ -90,23 +108,142 @@ func EnableToolset(r *inventory.Inventory) inventory.ServerTool {
 				toolsetID := inventory.ToolsetID(toolsetName)
 
 				if !deps.Inventory.HasToolset(toolsetID) {
-					return utils.NewToolResultError(fmt.Sprintf("Toolset %s not found", toolsetName)), nil, nil
+					return unknownToolsetError(deps.Inventory, toolsetName), nil, nil
 				}
 
 				if deps.Inventory.IsToolsetEnabled(toolsetID) {
-					return utils.NewToolResultText(fmt.Sprintf("Toolset %s is already enabled", toolsetName)), nil, nil
+					return MarshalledTextResult(EnableToolsetResult{
+						Toolset:  toolsetName,
+						Message:  fmt.Sprintf("Toolset %s is already enabled", toolsetName),
+						NewTools: []string{},
+					}), nil, nil
+				}
+
+				// Tools enabled individually (e.g. via --tools) are already registered
+				alreadyAvailable := make(map[string]bool)
+				for _, st := range deps.Inventory.AvailableTools(ctx) {
+					alreadyAvailable[st.Tool.Name] = true
+				}
+
+				// Dependencies that are not yet enabled are enabled along with the toolset
+				var dependencies []inventory.ToolsetID
+				for _, dep := range deps.Inventory.ToolsetDependencies(toolsetID) {
+					if !deps.Inventory.IsToolsetEnabled(dep) {
+						dependencies = append(dependencies, dep)
+					}
 				}
 
 				// Mark the toolset as enabled so IsToolsetEnabled returns true
 				deps.Inventory.EnableToolset(toolsetID)
 
-				// Get tools for this toolset and register them with the managed deps
+				// Get tools for this toolset and its dependencies and register them with the managed deps
 				toolsForToolset := deps.Inventory.ToolsForToolset(toolsetID)
-				for _, st := range toolsForToolset {
-					st.RegisterFunc(deps.Server, deps.ToolDeps)
+				newTools := make([]string, 0, len(toolsForToolset))
+				for _, id := range append(dependencies, toolsetID) {
+					for _, st := range deps.Inventory.ToolsForToolset(id) {
+						deps.Inventory.RegisterTool(deps.Server, st, deps.ToolDeps)
+						if !alreadyAvailable[st.Tool.Name] {
+							newTools = append(newTools, st.Tool.Name)
+						}
+					}
+				}
+
+				message := fmt.Sprintf("Toolset %s enabled with %d tools", toolsetName, len(toolsForToolset))
+				if len(dependencies) > 0 {
+					names := make([]string, len(dependencies))
+					for i, dep := range dependencies {
+						names[i] = string(dep)
+					}
+					message += fmt.Sprintf(", along with the toolsets it depends on: %s", strings.Join(names, ", "))
+				}
+
+				return MarshalledTextResult(EnableToolsetResult{
+					Toolset:  toolsetName,
+					Message:  message,
+					NewTools: newTools,
+				}), nil, nil
+			}
+		},
+	)
+}
+
+// DisableToolsetResult is the output type of disable_toolset.
+type DisableToolsetResult struct {
+	Toolset      string   `json:"toolset"`
+	Message      string   `json:"message"`
+	RemovedTools []string `json:"removed_tools"`
+}
+
+// DisableToolset creates a tool that disables a toolset at runtime, so agents can
+// shrink their context once they no longer need a set of tools.
+func DisableToolset(r *inventory.Inventory) inventory.ServerTool {
+	return NewDynamicTool(
+		ToolsetMetadataDynamic,
+		mcp.Tool{
+			Name:        "disable_toolset",
+			Description: "Disable a toolset that is no longer needed, removing its tools from this session. Returns the names of the tools that were removed",
+			Annotations: &mcp.ToolAnnotations{
+				Title:        "Disable a toolset",
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"toolset": {
+						Type:        "string",
+						Description: "The name of the toolset to disable",
+						Enum:        toolsetIDsEnum(r),
+					},
+				},
+				Required: []string{"toolset"},
+			},
+		},
+		func(deps DynamicToolDependencies) mcp.ToolHandlerFor[map[string]any, any] {
+			return func(ctx context.Context, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+				toolsetName, err := RequiredParam[string](args, "toolset")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+
+				toolsetID := inventory.ToolsetID(toolsetName)
+
+				if !deps.Inventory.HasToolset(toolsetID) {
+					return unknownToolsetError(deps.Inventory, toolsetName), nil, nil
+				}
+
+				if deps.Inventory.IsToolsetAlwaysOn(toolsetID) {
+					return utils.NewToolResultError(fmt.Sprintf("Toolset %s is always enabled and cannot be disabled", toolsetName)), nil, nil
+				}
+
+				if !deps.Inventory.IsToolsetEnabled(toolsetID) {
+					return MarshalledTextResult(DisableToolsetResult{
+						Toolset:      toolsetName,
+						Message:      fmt.Sprintf("Toolset %s is not enabled", toolsetName),
+						RemovedTools: []string{},
+					}), nil, nil
+				}
+
+				deps.Inventory.DisableToolset(toolsetID)
+
+				// Tools enabled individually (e.g. via --tools) stay available
+				stillAvailable := make(map[string]bool)
+				for _, st := range deps.Inventory.AvailableTools(ctx) {
+					stillAvailable[st.Tool.Name] = true
+				}
+
+				removedTools := make([]string, 0)
+				for _, st := range deps.Inventory.ToolsForToolset(toolsetID) {
+					if !stillAvailable[st.Tool.Name] {
+						removedTools = append(removedTools, st.Tool.Name)
+					}
 				}
+				deps.Server.RemoveTools(removedTools...)
 
-				return utils.NewToolResultText(fmt.Sprintf("Toolset %s enabled with %d tools", toolsetName, len(toolsForToolset))), nil, nil
+				return MarshalledTextResult(DisableToolsetResult{
+					Toolset:      toolsetName,
+					Message:      fmt.Sprintf("Toolset %s disabled, removing %d tools", toolsetName, len(removedTools)),
+					RemovedTools: removedTools,
+				}), nil, nil
 			}
 		},
 	)
@@ -188,7 +325,7 @@ func GetToolsetsTools(r *inventory.Inventory) inventory.ServerTool {
 				toolsetID := inventory.ToolsetID(toolsetName)
 
 				if !deps.Inventory.HasToolset(toolsetID) {
-					return utils.NewToolResultError(fmt.Sprintf("Toolset %s not found", toolsetName)), nil, nil
+					return unknownToolsetError(deps.Inventory, toolsetName), nil, nil
 				}
 
 				// Get all tools for this toolset (ignoring current filters for discovery)
@@ -158,17 +158,30 @@ func TestDynamicTools_EnableToolset(t *testing.T) {
 	// Verify the toolset is now enabled
 	assert.True(t, reg.IsToolsetEnabled(inventory.ToolsetID("repos")), "repos should be enabled after enable_toolset")
 
-	// Verify the success message
+	// Verify the newly available tools are reported
+	var enabled EnableToolsetResult
 	textContent := result.Content[0].(*mcp.TextContent)
-	assert.Contains(t, textContent.Text, "enabled")
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &enabled))
+	assert.Equal(t, "repos", enabled.Toolset)
+	assert.Contains(t, enabled.Message, "enabled")
+
+	expectedTools := make([]string, 0)
+	for _, st := range reg.ToolsForToolset(inventory.ToolsetID("repos")) {
+		expectedTools = append(expectedTools, st.Tool.Name)
+	}
+	assert.Equal(t, expectedTools, enabled.NewTools)
+	assert.Contains(t, enabled.NewTools, "get_commit")
 
 	// Try enabling again - should say already enabled
 	result2, err := handler(context.Background(), createDynamicRequest(map[string]any{
 		"toolset": "repos",
 	}))
 	require.NoError(t, err)
+	var enabledAgain EnableToolsetResult
 	textContent2 := result2.Content[0].(*mcp.TextContent)
-	assert.Contains(t, textContent2.Text, "already enabled")
+	require.NoError(t, json.Unmarshal([]byte(textContent2.Text), &enabledAgain))
+	assert.Contains(t, enabledAgain.Message, "already enabled")
+	assert.Empty(t, enabledAgain.NewTools)
 }
 
 func TestDynamicTools_EnableToolset_InvalidToolset(t *testing.T) {
@@ -200,9 +213,81 @@ func TestDynamicTools_EnableToolset_InvalidToolset(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
-	// Should be an error result
+	// Should be an error result listing the valid toolsets
+	require.True(t, result.IsError)
+	textContent := result.Content[0].(*mcp.TextContent)
+	assert.Contains(t, textContent.Text, "Toolset nonexistent not found")
+	assert.Contains(t, textContent.Text, "Valid toolsets are:")
+	assert.Contains(t, textContent.Text, "repos")
+}
+
+func TestDynamicTools_DisableToolset(t *testing.T) {
+	// Build a registry with repos selected and context always on
+	reg, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"repos"}).
+		WithAlwaysOnToolsets([]inventory.ToolsetID{"context"}).
+		Build()
+	require.NoError(t, err)
+
+	// Create a mock server with the enabled tools registered
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	toolDeps := NewBaseDeps(nil, nil, nil, nil, translations.NullTranslationHelper, FeatureFlags{}, 0, nil)
+	reg.RegisterTools(context.Background(), server, toolDeps)
+
+	// Create dynamic tool dependencies
+	deps := DynamicToolDependencies{
+		Server:    server,
+		Inventory: reg,
+		ToolDeps:  toolDeps,
+		T:         translations.NullTranslationHelper,
+	}
+
+	// Get the disable_toolset tool
+	tool := DisableToolset(reg)
+	handler := tool.Handler(deps)
+
+	// Disable the repos toolset
+	result, err := handler(context.Background(), createDynamicRequest(map[string]any{
+		"toolset": "repos",
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	// Verify the toolset is no longer enabled and its tools were reported
+	assert.False(t, reg.IsToolsetEnabled(inventory.ToolsetID("repos")), "repos should be disabled after disable_toolset")
+
+	var disabled DisableToolsetResult
 	textContent := result.Content[0].(*mcp.TextContent)
-	assert.Contains(t, textContent.Text, "not found")
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &disabled))
+	assert.Equal(t, "repos", disabled.Toolset)
+	expectedTools := make([]string, 0)
+	for _, st := range reg.ToolsForToolset(inventory.ToolsetID("repos")) {
+		expectedTools = append(expectedTools, st.Tool.Name)
+	}
+	assert.Equal(t, expectedTools, disabled.RemovedTools)
+	for _, st := range reg.AvailableTools(context.Background()) {
+		assert.NotEqual(t, inventory.ToolsetID("repos"), st.Toolset.ID)
+	}
+
+	// Disabling again reports nothing removed
+	result, err = handler(context.Background(), createDynamicRequest(map[string]any{
+		"toolset": "repos",
+	}))
+	require.NoError(t, err)
+	textContent = result.Content[0].(*mcp.TextContent)
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &disabled))
+	assert.Contains(t, disabled.Message, "is not enabled")
+	assert.Empty(t, disabled.RemovedTools)
+
+	// Always-on toolsets cannot be disabled
+	result, err = handler(context.Background(), createDynamicRequest(map[string]any{
+		"toolset": "context",
+	}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	textContent = result.Content[0].(*mcp.TextContent)
+	assert.Contains(t, textContent.Text, "Toolset context is always enabled and cannot be disabled")
+	assert.True(t, reg.IsToolsetEnabled(inventory.ToolsetID("context")))
 }
 
 func TestDynamicTools_ToolsetsEnum(t *testing.T) {
@@ -215,7 +300,7 @@ func TestDynamicTools_ToolsetsEnum(t *testing.T) {
 
 	// Find enable_toolset and get_toolset_tools
 	for _, tool := range tools {
-		if tool.Tool.Name == "enable_toolset" || tool.Tool.Name == "get_toolset_tools" {
+		if tool.Tool.Name == "enable_toolset" || tool.Tool.Name == "disable_toolset" || tool.Tool.Name == "get_toolset_tools" {
 			// Verify the toolset property has an enum
 			schema := tool.Tool.InputSchema.(*jsonschema.Schema)
 			toolsetProp := schema.Properties["toolset"]
@@ -1,5 +1,9 @@
 package github
 
+// FeatureFlagExperiments gates tools that are available only when the
+// "experiments" feature flag is enabled, e.g. via --features=experiments.
+const FeatureFlagExperiments = "experiments"
+
 // FeatureFlags defines runtime feature toggles that adjust tool behavior.
 type FeatureFlags struct {
 	LockdownMode bool
@@ -2,9 +2,13 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -60,6 +64,10 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 						Type:        "string",
 						Description: "Repository name",
 					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch name, tag name, or commit SHA to get the tree of. It is resolved to a commit SHA first. Takes precedence over tree_sha",
+					},
 					"tree_sha": {
 						Type:        "string",
 						Description: "The SHA1 value or ref (branch or tag) name of the tree. Defaults to the repository's default branch",
@@ -87,6 +95,10 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			treeSHA, err := OptionalParam[string](args, "tree_sha")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -105,9 +117,16 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 				return utils.NewToolResultError("failed to get GitHub client"), nil, nil
 			}
 
+			if ref != "" {
+				treeSHA, err = resolveRef(ctx, client, owner, repo, ref)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
 			// If no tree_sha is provided, use the repository's default branch
 			if treeSHA == "" {
-				repoInfo, repoResp, err := client.Repositories.Get(ctx, owner, repo)
+				repoInfo, repoResp, err := getRepositoryCached(ctx, client, owner, repo, false)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx,
 						"failed to get repository info",
@@ -175,3 +194,662 @@ func GetRepositoryTree(t translations.TranslationHelperFunc) inventory.ServerToo
 		},
 	)
 }
+
+// maxGitBlobContentBytes caps how much decoded blob content get_git_blob returns.
+const maxGitBlobContentBytes = 100 * 1024
+
+// GitBlobResponse represents a Git blob with its decoded content.
+type GitBlobResponse struct {
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	ContentType string `json:"content_type"`
+	Binary      bool   `json:"binary"`
+	Content     string `json:"content,omitempty"`
+	Truncated   bool   `json:"truncated,omitempty"`
+}
+
+// GitCommitResponse represents a Git commit object.
+type GitCommitResponse struct {
+	SHA       string               `json:"sha"`
+	TreeSHA   string               `json:"tree_sha"`
+	Parents   []string             `json:"parents"`
+	Author    *MinimalCommitAuthor `json:"author,omitempty"`
+	Committer *MinimalCommitAuthor `json:"committer,omitempty"`
+	Message   string               `json:"message"`
+	Verified  bool                 `json:"verified"`
+	HTMLURL   string               `json:"html_url,omitempty"`
+}
+
+// GetGitBlob creates a tool to get a Git blob by SHA.
+func GetGitBlob(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "get_git_blob",
+			Description: t("TOOL_GET_GIT_BLOB_DESCRIPTION", fmt.Sprintf("Get a Git blob by its SHA, e.g. from get_repository_tree. Text content is decoded and returned up to %d bytes; binary blobs return metadata only.", maxGitBlobContentBytes)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_GIT_BLOB_USER_TITLE", "Get Git blob"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "SHA of the blob",
+					},
+				},
+				Required: []string{"owner", "repo", "sha"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			blob, resp, err := client.Git.GetBlob(ctx, owner, repo, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get blob",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			content := []byte(blob.GetContent())
+			if blob.GetEncoding() == "base64" {
+				content, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(blob.GetContent(), "\n", ""))
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to decode blob content: %s", err)), nil, nil
+				}
+			}
+
+			contentType := http.DetectContentType(content)
+			response := GitBlobResponse{
+				SHA:         blob.GetSHA(),
+				Size:        blob.GetSize(),
+				ContentType: contentType,
+				Binary:      !isTextContentType(contentType),
+			}
+			if !response.Binary {
+				response.Content, response.Truncated = truncateText(string(content), maxGitBlobContentBytes)
+			}
+
+			return MarshalledTextResult(response), nil, nil
+		},
+	)
+}
+
+// GetGitCommit creates a tool to get a Git commit object by SHA.
+func GetGitCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "get_git_commit",
+			Description: t("TOOL_GET_GIT_COMMIT_DESCRIPTION", "Get a Git commit object by its SHA: tree SHA, parent SHAs, author, committer, message and signature verification. Use get_commit for diffs and stats."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_GIT_COMMIT_USER_TITLE", "Get Git commit"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "SHA of the commit",
+					},
+				},
+				Required: []string{"owner", "repo", "sha"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			commit, resp, err := client.Git.GetCommit(ctx, owner, repo, sha)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToGitCommitResponse(commit)), nil, nil
+		},
+	)
+}
+
+// convertToGitCommitResponse converts a Git Data API commit into a GitCommitResponse.
+func convertToGitCommitResponse(commit *github.Commit) GitCommitResponse {
+	response := GitCommitResponse{
+		SHA:       commit.GetSHA(),
+		TreeSHA:   commit.GetTree().GetSHA(),
+		Parents:   make([]string, 0, len(commit.Parents)),
+		Author:    convertToMinimalCommitAuthor(commit.Author),
+		Committer: convertToMinimalCommitAuthor(commit.Committer),
+		Message:   commit.GetMessage(),
+		Verified:  commit.GetVerification().GetVerified(),
+		HTMLURL:   commit.GetHTMLURL(),
+	}
+	for _, parent := range commit.Parents {
+		response.Parents = append(response.Parents, parent.GetSHA())
+	}
+	return response
+}
+
+// convertToMinimalCommitAuthor converts a commit author or committer, returning nil if unset.
+func convertToMinimalCommitAuthor(author *github.CommitAuthor) *MinimalCommitAuthor {
+	if author == nil {
+		return nil
+	}
+	result := &MinimalCommitAuthor{
+		Name:  author.GetName(),
+		Email: author.GetEmail(),
+	}
+	if author.Date != nil {
+		result.Date = author.Date.Format(time.RFC3339)
+	}
+	return result
+}
+
+// GitObjectResponse identifies a newly created Git object.
+type GitObjectResponse struct {
+	SHA string `json:"sha"`
+}
+
+// GitRefResponse represents a Git reference and the object it points to.
+type GitRefResponse struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+	URL string `json:"url,omitempty"`
+}
+
+// validGitTreeModes lists the file modes accepted for tree entries.
+var validGitTreeModes = map[string]bool{
+	"100644": true, // file
+	"100755": true, // executable
+	"040000": true, // subdirectory
+	"160000": true, // submodule
+	"120000": true, // symlink
+}
+
+// gitTreeEntriesFromArgs converts a "tree" argument into tree entries for Git.CreateTree.
+// Each entry has a path and either a sha or inline content; an entry with neither
+// deletes the path from the base tree.
+func gitTreeEntriesFromArgs(tree []any) ([]*github.TreeEntry, error) {
+	entries := make([]*github.TreeEntry, 0, len(tree))
+	for _, e := range tree {
+		entryMap, ok := e.(map[string]any)
+		if !ok {
+			return nil, errors.New("each tree entry must be an object with a path")
+		}
+
+		path, ok := entryMap["path"].(string)
+		if !ok || path == "" {
+			return nil, errors.New("each tree entry must have a path")
+		}
+		mode, _ := entryMap["mode"].(string)
+		if mode == "" {
+			mode = "100644"
+		}
+		if !validGitTreeModes[mode] {
+			return nil, fmt.Errorf("tree entry %s has invalid mode %q", path, mode)
+		}
+		entryType, _ := entryMap["type"].(string)
+		if entryType == "" {
+			entryType = "blob"
+		}
+
+		entry := &github.TreeEntry{
+			Path: github.Ptr(path),
+			Mode: github.Ptr(mode),
+			Type: github.Ptr(entryType),
+		}
+		sha, hasSHA := entryMap["sha"].(string)
+		content, hasContent := entryMap["content"].(string)
+		switch {
+		case hasSHA && hasContent:
+			return nil, fmt.Errorf("tree entry %s must have either sha or content, not both", path)
+		case hasSHA:
+			entry.SHA = github.Ptr(sha)
+		case hasContent:
+			entry.Content = github.Ptr(content)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// CreateGitTree creates a tool to create a Git tree object.
+func CreateGitTree(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "create_git_tree",
+			Description: t("TOOL_CREATE_GIT_TREE_DESCRIPTION", "Create a Git tree object, optionally on top of a base tree. Entries with inline content create blobs. Use with create_git_commit and update_git_ref to build commits directly."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_GIT_TREE_USER_TITLE", "Create Git tree"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"base_tree": {
+						Type:        "string",
+						Description: "SHA of the tree to build on. Entries not listed are kept from it. If omitted, the tree contains only the given entries",
+					},
+					"tree": {
+						Type:        "array",
+						Description: "Tree entries to add, replace or delete",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"path": {
+									Type:        "string",
+									Description: "Path of the entry relative to the tree root",
+								},
+								"mode": {
+									Type:        "string",
+									Description: "File mode: 100644 (file), 100755 (executable), 040000 (subdirectory), 160000 (submodule) or 120000 (symlink). Defaults to 100644",
+									Enum:        []any{"100644", "100755", "040000", "160000", "120000"},
+								},
+								"type": {
+									Type:        "string",
+									Description: "Object type. Defaults to blob",
+									Enum:        []any{"blob", "tree", "commit"},
+								},
+								"sha": {
+									Type:        "string",
+									Description: "SHA of an existing object. Mutually exclusive with content",
+								},
+								"content": {
+									Type:        "string",
+									Description: "Content for a new blob. Mutually exclusive with sha. Omit both sha and content to delete the path from base_tree",
+								},
+							},
+							Required: []string{"path"},
+						},
+					},
+				},
+				Required: []string{"owner", "repo", "tree"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			baseTree, err := OptionalParam[string](args, "base_tree")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			treeArg, ok := args["tree"].([]any)
+			if !ok || len(treeArg) == 0 {
+				return utils.NewToolResultError("tree parameter must be a non-empty array of tree entries"), nil, nil
+			}
+			entries, err := gitTreeEntriesFromArgs(treeArg)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			tree, resp, err := client.Git.CreateTree(ctx, owner, repo, baseTree, entries)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create tree",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(GitObjectResponse{SHA: tree.GetSHA()}), nil, nil
+		},
+	)
+}
+
+// CreateGitCommit creates a tool to create a Git commit object.
+func CreateGitCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "create_git_commit",
+			Description: t("TOOL_CREATE_GIT_COMMIT_DESCRIPTION", "Create a Git commit object from a tree and any number of parents (none for a root commit, several for a merge commit). The commit is not on any branch until a ref is moved to it with update_git_ref."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_GIT_COMMIT_USER_TITLE", "Create Git commit"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"message": {
+						Type:        "string",
+						Description: "Commit message",
+					},
+					"tree": {
+						Type:        "string",
+						Description: "SHA of the tree for this commit, e.g. from create_git_tree",
+					},
+					"parents": {
+						Type:        "array",
+						Description: "SHAs of the parent commits",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"author_name": {
+						Type:        "string",
+						Description: "Author name. Defaults to the authenticated user",
+					},
+					"author_email": {
+						Type:        "string",
+						Description: "Author email. Required if author_name is set",
+					},
+				},
+				Required: []string{"owner", "repo", "message", "tree"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			message, err := RequiredParam[string](args, "message")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			treeSHA, err := RequiredParam[string](args, "tree")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			parents, err := OptionalStringArrayParam(args, "parents")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			authorName, err := OptionalParam[string](args, "author_name")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			authorEmail, err := OptionalParam[string](args, "author_email")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if (authorName == "") != (authorEmail == "") {
+				return utils.NewToolResultError("author_name and author_email must be provided together"), nil, nil
+			}
+
+			commit := github.Commit{
+				Message: github.Ptr(message),
+				Tree:    &github.Tree{SHA: github.Ptr(treeSHA)},
+			}
+			for _, parent := range parents {
+				commit.Parents = append(commit.Parents, &github.Commit{SHA: github.Ptr(parent)})
+			}
+			if authorName != "" {
+				commit.Author = &github.CommitAuthor{
+					Name:  github.Ptr(authorName),
+					Email: github.Ptr(authorEmail),
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(convertToGitCommitResponse(newCommit)), nil, nil
+		},
+	)
+}
+
+// UpdateGitRef creates a tool to move a Git reference to a different commit.
+func UpdateGitRef(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "update_git_ref",
+			Description: t("TOOL_UPDATE_GIT_REF_DESCRIPTION", "Move a branch or tag reference to point at a commit, e.g. one created with create_git_commit. Only fast-forward updates are allowed unless force is true."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_UPDATE_GIT_REF_USER_TITLE", "Update Git reference"),
+				ReadOnlyHint:    false,
+				DestructiveHint: github.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Reference to update, e.g. 'heads/main', 'tags/v1.0.0' or 'refs/heads/main'. A bare name is treated as a branch",
+					},
+					"sha": {
+						Type:        "string",
+						Description: "SHA of the commit to point the reference at",
+					},
+					"force": {
+						Type:        "boolean",
+						Description: "Allow a non-fast-forward update, discarding commits no longer reachable from the reference. Default is false",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo", "ref", "sha"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := RequiredParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sha, err := RequiredParam[string](args, "sha")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			force, err := OptionalBoolParamWithDefault(args, "force", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			ref = strings.TrimPrefix(ref, "refs/")
+			if !strings.HasPrefix(ref, "heads/") && !strings.HasPrefix(ref, "tags/") {
+				ref = "heads/" + ref
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			updated, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, github.UpdateRef{
+				SHA:   sha,
+				Force: github.Ptr(force),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update ref %s", ref),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(GitRefResponse{
+				Ref: updated.GetRef(),
+				SHA: updated.GetObject().GetSHA(),
+				URL: updated.GetURL(),
+			}), nil, nil
+		},
+	)
+}
+
+// DeleteTag creates a tool to delete a tag reference from a GitHub repository.
+func DeleteTag(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataGit,
+		mcp.Tool{
+			Name:        "delete_tag",
+			Description: t("TOOL_DELETE_TAG_DESCRIPTION", "Delete a Git tag from a GitHub repository by removing its refs/tags reference. Releases pointing at the tag are not deleted."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_TAG_USER_TITLE", "Delete tag"),
+				ReadOnlyHint:    false,
+				DestructiveHint: github.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"tag": {
+						Type:        "string",
+						Description: "Tag name to delete (e.g., 'v1.0.0')",
+					},
+				},
+				Required: []string{"owner", "repo", "tag"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			tag, err := RequiredParam[string](args, "tag")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			tag = strings.TrimPrefix(tag, "refs/tags/")
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Git.DeleteRef(ctx, owner, repo, "refs/tags/"+tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete tag %s from %s/%s", tag, owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully deleted tag %s from %s/%s", tag, owner, repo)), nil, nil
+		},
+	)
+}
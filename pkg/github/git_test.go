@@ -2,10 +2,12 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -28,6 +30,7 @@ func Test_GetRepositoryTree(t *testing.T) {
 	require.True(t, ok, "expected InputSchema to be *jsonschema.Schema")
 	assert.Contains(t, inputSchema.Properties, "owner")
 	assert.Contains(t, inputSchema.Properties, "repo")
+	assert.Contains(t, inputSchema.Properties, "ref")
 	assert.Contains(t, inputSchema.Properties, "tree_sha")
 	assert.Contains(t, inputSchema.Properties, "recursive")
 	assert.Contains(t, inputSchema.Properties, "path_filter")
@@ -90,6 +93,20 @@ func Test_GetRepositoryTree(t *testing.T) {
 				"path_filter": "src/",
 			},
 		},
+		{
+			name: "successfully get repository tree at resolved ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: mockResponse(t, http.StatusOK, "0123456789abcdef0123456789abcdef01234567"),
+				GetReposGitTreesByOwnerByRepoByTree: expectPath(t, "/repos/owner/repo/git/trees/0123456789abcdef0123456789abcdef01234567").andThen(
+					mockResponse(t, http.StatusOK, mockTree),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "v1.0.0",
+			},
+		},
 		{
 			name: "repository not found",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -175,3 +192,689 @@ func Test_GetRepositoryTree(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetGitBlob(t *testing.T) {
+	serverTool := GetGitBlob(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_git_blob", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha"})
+
+	textContent := "package main\n\nfunc main() {}\n"
+	binaryContent := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00}
+	// Two bytes per rune after the leading "a", so the size cap falls mid-rune
+	largeContent := "a" + strings.Repeat("é", maxGitBlobContentBytes)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedBlob   GitBlobResponse
+		expectedErrMsg string
+	}{
+		{
+			name: "text blob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: expectPath(t, "/repos/owner/repo/git/blobs/abc123").andThen(
+					mockResponse(t, http.StatusOK, &github.Blob{
+						SHA:      github.Ptr("abc123"),
+						Size:     github.Ptr(len(textContent)),
+						Encoding: github.Ptr("base64"),
+						Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(textContent))),
+					}),
+				),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "sha": "abc123"},
+			expectedBlob: GitBlobResponse{
+				SHA:         "abc123",
+				Size:        len(textContent),
+				ContentType: "text/plain; charset=utf-8",
+				Content:     textContent,
+			},
+		},
+		{
+			name: "binary blob",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: mockResponse(t, http.StatusOK, &github.Blob{
+					SHA:      github.Ptr("def456"),
+					Size:     github.Ptr(len(binaryContent)),
+					Encoding: github.Ptr("base64"),
+					Content:  github.Ptr(base64.StdEncoding.EncodeToString(binaryContent)),
+				}),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "sha": "def456"},
+			expectedBlob: GitBlobResponse{
+				SHA:         "def456",
+				Size:        len(binaryContent),
+				ContentType: "image/png",
+				Binary:      true,
+			},
+		},
+		{
+			name: "large text blob is truncated on a rune boundary",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: mockResponse(t, http.StatusOK, &github.Blob{
+					SHA:      github.Ptr("fff999"),
+					Size:     github.Ptr(len(largeContent)),
+					Encoding: github.Ptr("utf-8"),
+					Content:  github.Ptr(largeContent),
+				}),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "sha": "fff999"},
+			expectedBlob: GitBlobResponse{
+				SHA:         "fff999",
+				Size:        len(largeContent),
+				ContentType: "text/plain; charset=utf-8",
+				Content:     largeContent[:maxGitBlobContentBytes-1],
+				Truncated:   true,
+			},
+		},
+		{
+			name: "blob not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitBlobsByOwnerByRepoByFileSHA: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "sha": "missing"},
+			expectError:    true,
+			expectedErrMsg: "failed to get blob",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var blob GitBlobResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &blob))
+			assert.Equal(t, tc.expectedBlob, blob)
+		})
+	}
+}
+
+func Test_GetGitCommit(t *testing.T) {
+	serverTool := GetGitCommit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_git_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "sha"})
+
+	commitDate := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockCommit := &github.Commit{
+		SHA:     github.Ptr("abc123"),
+		Message: github.Ptr("Merge branch 'feature'"),
+		Tree:    &github.Tree{SHA: github.Ptr("tree456")},
+		Parents: []*github.Commit{
+			{SHA: github.Ptr("parent1")},
+			{SHA: github.Ptr("parent2")},
+		},
+		Author: &github.CommitAuthor{
+			Name:  github.Ptr("Octo Cat"),
+			Email: github.Ptr("octocat@github.com"),
+			Date:  &github.Timestamp{Time: commitDate},
+		},
+		Committer: &github.CommitAuthor{
+			Name:  github.Ptr("GitHub"),
+			Email: github.Ptr("noreply@github.com"),
+			Date:  &github.Timestamp{Time: commitDate},
+		},
+		Verification: &github.SignatureVerification{Verified: github.Ptr(true)},
+		HTMLURL:      github.Ptr("https://github.com/owner/repo/commit/abc123"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedCommit GitCommitResponse
+		expectedErrMsg string
+	}{
+		{
+			name: "commit object",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: expectPath(t, "/repos/owner/repo/git/commits/abc123").andThen(
+					mockResponse(t, http.StatusOK, mockCommit),
+				),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "sha": "abc123"},
+			expectedCommit: GitCommitResponse{
+				SHA:     "abc123",
+				TreeSHA: "tree456",
+				Parents: []string{"parent1", "parent2"},
+				Author: &MinimalCommitAuthor{
+					Name:  "Octo Cat",
+					Email: "octocat@github.com",
+					Date:  "2024-05-01T12:00:00Z",
+				},
+				Committer: &MinimalCommitAuthor{
+					Name:  "GitHub",
+					Email: "noreply@github.com",
+					Date:  "2024-05-01T12:00:00Z",
+				},
+				Message:  "Merge branch 'feature'",
+				Verified: true,
+				HTMLURL:  "https://github.com/owner/repo/commit/abc123",
+			},
+		},
+		{
+			name: "commit not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo", "sha": "missing"},
+			expectError:    true,
+			expectedErrMsg: "failed to get commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var commit GitCommitResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &commit))
+			assert.Equal(t, tc.expectedCommit, commit)
+		})
+	}
+}
+
+func Test_CreateGitTree(t *testing.T) {
+	serverTool := CreateGitTree(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_git_tree", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "tree"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedSHA    string
+		expectedErrMsg string
+	}{
+		{
+			name: "create tree on base tree with new, existing and deleted entries",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitTreesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"base_tree": "base123",
+					"tree": []any{
+						map[string]any{"path": "README.md", "mode": "100644", "type": "blob", "content": "# Hello"},
+						map[string]any{"path": "run.sh", "mode": "100755", "type": "blob", "sha": "blob456"},
+						map[string]any{"path": "old.txt", "mode": "100644", "type": "blob", "sha": nil},
+					},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, &github.Tree{SHA: github.Ptr("tree789")}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"base_tree": "base123",
+				"tree": []any{
+					map[string]any{"path": "README.md", "content": "# Hello"},
+					map[string]any{"path": "run.sh", "mode": "100755", "sha": "blob456"},
+					map[string]any{"path": "old.txt"},
+				},
+			},
+			expectedSHA: "tree789",
+		},
+		{
+			name: "entry with both sha and content",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tree": []any{
+					map[string]any{"path": "README.md", "content": "# Hello", "sha": "blob456"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "tree entry README.md must have either sha or content, not both",
+		},
+		{
+			name: "invalid mode",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tree": []any{
+					map[string]any{"path": "README.md", "mode": "644", "content": "# Hello"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "tree entry README.md has invalid mode \"644\"",
+		},
+		{
+			name: "empty tree",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tree":  []any{},
+			},
+			expectError:    true,
+			expectedErrMsg: "tree parameter must be a non-empty array of tree entries",
+		},
+		{
+			name: "create tree fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitTreesByOwnerByRepo: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "BadObjectState"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tree": []any{
+					map[string]any{"path": "README.md", "sha": "missing"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create tree",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var created GitObjectResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &created))
+			assert.Equal(t, tc.expectedSHA, created.SHA)
+		})
+	}
+}
+
+func Test_CreateGitCommit(t *testing.T) {
+	serverTool := CreateGitCommit(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_git_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "message", "tree"})
+
+	mockCommit := &github.Commit{
+		SHA:     github.Ptr("commit123"),
+		Message: github.Ptr("Merge feature"),
+		Tree:    &github.Tree{SHA: github.Ptr("tree789")},
+		Parents: []*github.Commit{
+			{SHA: github.Ptr("parent1")},
+			{SHA: github.Ptr("parent2")},
+		},
+		Author: &github.CommitAuthor{
+			Name:  github.Ptr("Octo Cat"),
+			Email: github.Ptr("octocat@github.com"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create merge commit with author",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitCommitsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"message": "Merge feature",
+					"tree":    "tree789",
+					"parents": []any{"parent1", "parent2"},
+					"author": map[string]any{
+						"name":  "Octo Cat",
+						"email": "octocat@github.com",
+					},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, mockCommit),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"message":      "Merge feature",
+				"tree":         "tree789",
+				"parents":      []any{"parent1", "parent2"},
+				"author_name":  "Octo Cat",
+				"author_email": "octocat@github.com",
+			},
+		},
+		{
+			name: "author name without email",
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"message":     "Merge feature",
+				"tree":        "tree789",
+				"author_name": "Octo Cat",
+			},
+			expectError:    true,
+			expectedErrMsg: "author_name and author_email must be provided together",
+		},
+		{
+			name: "create commit fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposGitCommitsByOwnerByRepo: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Tree SHA does not exist"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"message": "Merge feature",
+				"tree":    "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create commit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var commit GitCommitResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &commit))
+			assert.Equal(t, "commit123", commit.SHA)
+			assert.Equal(t, "tree789", commit.TreeSHA)
+			assert.Equal(t, []string{"parent1", "parent2"}, commit.Parents)
+			assert.Equal(t, "Octo Cat", commit.Author.Name)
+		})
+	}
+}
+
+func Test_UpdateGitRef(t *testing.T) {
+	serverTool := UpdateGitRef(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "update_git_ref", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "ref", "sha"})
+
+	mockRef := &github.Reference{
+		Ref:    github.Ptr("refs/heads/main"),
+		URL:    github.Ptr("https://api.github.com/repos/owner/repo/git/refs/heads/main"),
+		Object: &github.GitObject{SHA: github.Ptr("commit123")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "fast-forward branch",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/heads/main").andThen(
+					expectRequestBody(t, map[string]any{"sha": "commit123", "force": false}).andThen(
+						mockResponse(t, http.StatusOK, mockRef),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "main",
+				"sha":   "commit123",
+			},
+		},
+		{
+			name: "force update fully qualified ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/heads/main").andThen(
+					expectRequestBody(t, map[string]any{"sha": "commit123", "force": true}).andThen(
+						mockResponse(t, http.StatusOK, mockRef),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "refs/heads/main",
+				"sha":   "commit123",
+				"force": true,
+			},
+		},
+		{
+			name: "non-fast-forward rejected",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposGitRefsByOwnerByRepoByRef: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Update is not a fast forward"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "heads/main",
+				"sha":   "commit123",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update ref heads/main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var ref GitRefResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &ref))
+			assert.Equal(t, "refs/heads/main", ref.Ref)
+			assert.Equal(t, "commit123", ref.SHA)
+		})
+	}
+}
+
+func Test_DeleteTag(t *testing.T) {
+	serverTool := DeleteTag(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "delete_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "tag")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "tag"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful tag deletion",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/tags/v1.0.0").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+			},
+			expectedText: "Successfully deleted tag v1.0.0 from owner/repo",
+		},
+		{
+			name: "fully qualified tag ref is accepted",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/tags/v1.0.0").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "refs/tags/v1.0.0",
+			},
+			expectedText: "Successfully deleted tag v1.0.0 from owner/repo",
+		},
+		{
+			name: "tag not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposGitRefsByOwnerByRepoByRef: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v9.9.9",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to delete tag v9.9.9 from owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				assert.Contains(t, errorContent.Text, "404")
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -20,31 +20,49 @@ import (
 // These constants define the URL patterns used in HTTP mocking for tests
 const (
 	// User endpoints
-	GetUser                        = "GET /user"
+	GetAuthenticatedUser           = "GET /user"
 	GetUserStarred                 = "GET /user/starred"
 	GetUsersGistsByUsername        = "GET /users/{username}/gists"
 	GetUsersStarredByUsername      = "GET /users/{username}/starred"
+	GetUserStarredByOwnerByRepo    = "GET /user/starred/{owner}/{repo}"
 	PutUserStarredByOwnerByRepo    = "PUT /user/starred/{owner}/{repo}"
 	DeleteUserStarredByOwnerByRepo = "DELETE /user/starred/{owner}/{repo}"
+	GetUsersByUsername             = "GET /users/{username}"
+	GetUsersFollowersByUsername    = "GET /users/{username}/followers"
+	GetUsersFollowingByUsername    = "GET /users/{username}/following"
+	PutUserFollowingByUsername     = "PUT /user/following/{username}"
+	DeleteUserFollowingByUsername  = "DELETE /user/following/{username}"
 
 	// Repository endpoints
-	GetReposByOwnerByRepo                = "GET /repos/{owner}/{repo}"
-	GetReposBranchesByOwnerByRepo        = "GET /repos/{owner}/{repo}/branches"
-	GetReposTagsByOwnerByRepo            = "GET /repos/{owner}/{repo}/tags"
-	GetReposCommitsByOwnerByRepo         = "GET /repos/{owner}/{repo}/commits"
-	GetReposCommitsByOwnerByRepoByRef    = "GET /repos/{owner}/{repo}/commits/{ref}"
-	GetReposContentsByOwnerByRepoByPath  = "GET /repos/{owner}/{repo}/contents/{path}"
-	PutReposContentsByOwnerByRepoByPath  = "PUT /repos/{owner}/{repo}/contents/{path}"
-	PostReposForksByOwnerByRepo          = "POST /repos/{owner}/{repo}/forks"
-	GetReposSubscriptionByOwnerByRepo    = "GET /repos/{owner}/{repo}/subscription"
-	PutReposSubscriptionByOwnerByRepo    = "PUT /repos/{owner}/{repo}/subscription"
-	DeleteReposSubscriptionByOwnerByRepo = "DELETE /repos/{owner}/{repo}/subscription"
+	GetReposByOwnerByRepo                     = "GET /repos/{owner}/{repo}"
+	PatchReposByOwnerByRepo                   = "PATCH /repos/{owner}/{repo}"
+	DeleteReposByOwnerByRepo                  = "DELETE /repos/{owner}/{repo}"
+	GetReposStargazersByOwnerByRepo           = "GET /repos/{owner}/{repo}/stargazers"
+	GetReposTopicsByOwnerByRepo               = "GET /repos/{owner}/{repo}/topics"
+	GetReposBranchesByOwnerByRepo             = "GET /repos/{owner}/{repo}/branches"
+	GetReposTagsByOwnerByRepo                 = "GET /repos/{owner}/{repo}/tags"
+	GetReposRulesetsByOwnerByRepo             = "GET /repos/{owner}/{repo}/rulesets"
+	GetReposRulesetsByOwnerByRepoByRulesetID  = "GET /repos/{owner}/{repo}/rulesets/{ruleset_id}"
+	GetReposCommitsByOwnerByRepo              = "GET /repos/{owner}/{repo}/commits"
+	GetReposCommitsByOwnerByRepoByRef         = "GET /repos/{owner}/{repo}/commits/{ref}"
+	GetReposCompareByOwnerByRepoByBasehead    = "GET /repos/{owner}/{repo}/compare/{basehead}"
+	GetReposContentsByOwnerByRepoByPath       = "GET /repos/{owner}/{repo}/contents/{path}"
+	GetReposContentsByOwnerByRepoByNestedPath = "GET /repos/{owner}/{repo}/contents/{path:.*}"
+	GetReposReadmeByOwnerByRepo               = "GET /repos/{owner}/{repo}/readme"
+	PutReposContentsByOwnerByRepoByPath       = "PUT /repos/{owner}/{repo}/contents/{path}"
+	GetReposForksByOwnerByRepo                = "GET /repos/{owner}/{repo}/forks"
+	PostReposForksByOwnerByRepo               = "POST /repos/{owner}/{repo}/forks"
+	GetReposSubscriptionByOwnerByRepo         = "GET /repos/{owner}/{repo}/subscription"
+	PutReposSubscriptionByOwnerByRepo         = "PUT /repos/{owner}/{repo}/subscription"
+	DeleteReposSubscriptionByOwnerByRepo      = "DELETE /repos/{owner}/{repo}/subscription"
 
 	// Git endpoints
 	GetReposGitTreesByOwnerByRepoByTree        = "GET /repos/{owner}/{repo}/git/trees/{tree}"
+	GetReposGitBlobsByOwnerByRepoByFileSHA     = "GET /repos/{owner}/{repo}/git/blobs/{file_sha}"
 	GetReposGitRefByOwnerByRepoByRef           = "GET /repos/{owner}/{repo}/git/ref/{ref:.*}"
 	PostReposGitRefsByOwnerByRepo              = "POST /repos/{owner}/{repo}/git/refs"
 	PatchReposGitRefsByOwnerByRepoByRef        = "PATCH /repos/{owner}/{repo}/git/refs/{ref:.*}"
+	DeleteReposGitRefsByOwnerByRepoByRef       = "DELETE /repos/{owner}/{repo}/git/refs/{ref:.*}"
 	GetReposGitCommitsByOwnerByRepoByCommitSHA = "GET /repos/{owner}/{repo}/git/commits/{commit_sha}"
 	PostReposGitCommitsByOwnerByRepo           = "POST /repos/{owner}/{repo}/git/commits"
 	GetReposGitTagsByOwnerByRepoByTagSHA       = "GET /repos/{owner}/{repo}/git/tags/{tag_sha}"
@@ -62,18 +80,27 @@ const (
 	PostReposIssuesSubIssuesByOwnerByRepoByIssueNumber          = "POST /repos/{owner}/{repo}/issues/{issue_number}/sub_issues"
 	DeleteReposIssuesSubIssueByOwnerByRepoByIssueNumber         = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/sub_issue"
 	PatchReposIssuesSubIssuesPriorityByOwnerByRepoByIssueNumber = "PATCH /repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority"
+	PutReposIssuesLockByOwnerByRepoByIssueNumber                = "PUT /repos/{owner}/{repo}/issues/{issue_number}/lock"
+	DeleteReposIssuesLockByOwnerByRepoByIssueNumber             = "DELETE /repos/{owner}/{repo}/issues/{issue_number}/lock"
+	PatchReposIssuesCommentsByOwnerByRepoByCommentID            = "PATCH /repos/{owner}/{repo}/issues/comments/{comment_id}"
+	DeleteReposIssuesCommentsByOwnerByRepoByCommentID           = "DELETE /repos/{owner}/{repo}/issues/comments/{comment_id}"
 
 	// Pull request endpoints
-	GetReposPullsByOwnerByRepo                                = "GET /repos/{owner}/{repo}/pulls"
-	GetReposPullsByOwnerByRepoByPullNumber                    = "GET /repos/{owner}/{repo}/pulls/{pull_number}"
-	GetReposPullsFilesByOwnerByRepoByPullNumber               = "GET /repos/{owner}/{repo}/pulls/{pull_number}/files"
-	GetReposPullsReviewsByOwnerByRepoByPullNumber             = "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
-	PostReposPullsByOwnerByRepo                               = "POST /repos/{owner}/{repo}/pulls"
-	PatchReposPullsByOwnerByRepoByPullNumber                  = "PATCH /repos/{owner}/{repo}/pulls/{pull_number}"
-	PutReposPullsMergeByOwnerByRepoByPullNumber               = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge"
-	PutReposPullsUpdateBranchByOwnerByRepoByPullNumber        = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/update-branch"
-	PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber = "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
-	PostReposPullsCommentsByOwnerByRepoByPullNumber           = "POST /repos/{owner}/{repo}/pulls/{pull_number}/comments"
+	GetReposPullsByOwnerByRepo                                     = "GET /repos/{owner}/{repo}/pulls"
+	GetReposPullsByOwnerByRepoByPullNumber                         = "GET /repos/{owner}/{repo}/pulls/{pull_number}"
+	GetReposPullsFilesByOwnerByRepoByPullNumber                    = "GET /repos/{owner}/{repo}/pulls/{pull_number}/files"
+	GetReposPullsCommitsByOwnerByRepoByPullNumber                  = "GET /repos/{owner}/{repo}/pulls/{pull_number}/commits"
+	GetReposPullsReviewsByOwnerByRepoByPullNumber                  = "GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews"
+	PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewID = "POST /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/events"
+	PostReposPullsByOwnerByRepo                                    = "POST /repos/{owner}/{repo}/pulls"
+	PatchReposPullsByOwnerByRepoByPullNumber                       = "PATCH /repos/{owner}/{repo}/pulls/{pull_number}"
+	PutReposPullsMergeByOwnerByRepoByPullNumber                    = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/merge"
+	PutReposPullsUpdateBranchByOwnerByRepoByPullNumber             = "PUT /repos/{owner}/{repo}/pulls/{pull_number}/update-branch"
+	PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber      = "POST /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber       = "GET /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber    = "DELETE /repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"
+	GetReposPullsCommentsByOwnerByRepoByPullNumber                 = "GET /repos/{owner}/{repo}/pulls/{pull_number}/comments"
+	PostReposPullsCommentsByOwnerByRepoByPullNumber                = "POST /repos/{owner}/{repo}/pulls/{pull_number}/comments"
 
 	// Notifications endpoints
 	GetNotifications                                 = "GET /notifications"
@@ -93,9 +120,11 @@ const (
 	PatchGistsByGistID = "PATCH /gists/{gist_id}"
 
 	// Releases endpoints
-	GetReposReleasesByOwnerByRepo          = "GET /repos/{owner}/{repo}/releases"
-	GetReposReleasesLatestByOwnerByRepo    = "GET /repos/{owner}/{repo}/releases/latest"
-	GetReposReleasesTagsByOwnerByRepoByTag = "GET /repos/{owner}/{repo}/releases/tags/{tag}"
+	GetReposReleasesByOwnerByRepo                  = "GET /repos/{owner}/{repo}/releases"
+	GetReposReleasesLatestByOwnerByRepo            = "GET /repos/{owner}/{repo}/releases/latest"
+	GetReposReleasesAssetsByOwnerByRepoByReleaseID = "GET /repos/{owner}/{repo}/releases/{release_id}/assets"
+	DeleteReposReleasesByOwnerByRepoByReleaseID    = "DELETE /repos/{owner}/{repo}/releases/{release_id}"
+	GetReposReleasesTagsByOwnerByRepoByTag         = "GET /repos/{owner}/{repo}/releases/tags/{tag}"
 
 	// Code scanning endpoints
 	GetReposCodeScanningAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/code-scanning/alerts"
@@ -106,14 +135,24 @@ const (
 	GetReposSecretScanningAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}" //nolint:gosec // False positive - this is an API endpoint pattern, not a credential
 
 	// Dependabot endpoints
-	GetReposDependabotAlertsByOwnerByRepo              = "GET /repos/{owner}/{repo}/dependabot/alerts"
-	GetReposDependabotAlertsByOwnerByRepoByAlertNumber = "GET /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+	GetReposDependabotAlertsByOwnerByRepo                = "GET /repos/{owner}/{repo}/dependabot/alerts"
+	GetReposDependabotAlertsByOwnerByRepoByAlertNumber   = "GET /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+	PatchReposDependabotAlertsByOwnerByRepoByAlertNumber = "PATCH /repos/{owner}/{repo}/dependabot/alerts/{alert_number}"
+	GetReposDependencyGraphSbomByOwnerByRepo             = "GET /repos/{owner}/{repo}/dependency-graph/sbom"
 
 	// Security advisories endpoints
-	GetAdvisories                           = "GET /advisories"
-	GetAdvisoriesByGhsaID                   = "GET /advisories/{ghsa_id}"
-	GetReposSecurityAdvisoriesByOwnerByRepo = "GET /repos/{owner}/{repo}/security-advisories"
-	GetOrgsSecurityAdvisoriesByOrg          = "GET /orgs/{org}/security-advisories"
+	GetAdvisories                            = "GET /advisories"
+	GetAdvisoriesByGhsaID                    = "GET /advisories/{ghsa_id}"
+	GetReposSecurityAdvisoriesByOwnerByRepo  = "GET /repos/{owner}/{repo}/security-advisories"
+	PostReposSecurityAdvisoriesByOwnerByRepo = "POST /repos/{owner}/{repo}/security-advisories"
+	GetOrgsSecurityAdvisoriesByOrg           = "GET /orgs/{org}/security-advisories"
+
+	// Organization endpoints
+	GetOrgsByOrg                      = "GET /orgs/{org}"
+	GetOrgsMembersByOrg               = "GET /orgs/{org}/members"
+	GetOrgsMembershipsByOrgByUsername = "GET /orgs/{org}/memberships/{username}"
+	GetOrgsReposByOrg                 = "GET /orgs/{org}/repos"
+	GetOrgsTeamsByOrg                 = "GET /orgs/{org}/teams"
 
 	// Actions endpoints
 	GetReposActionsWorkflowsByOwnerByRepo                        = "GET /repos/{owner}/{repo}/actions/workflows"
@@ -125,6 +164,7 @@ const (
 	GetReposActionsRunsLogsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs"
 	GetReposActionsRunsJobsByOwnerByRepoByRunID                  = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs"
 	GetReposActionsRunsArtifactsByOwnerByRepoByRunID             = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts"
+	GetReposActionsArtifactsByOwnerByRepoByArtifactID            = "GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}"
 	GetReposActionsRunsTimingByOwnerByRepoByRunID                = "GET /repos/{owner}/{repo}/actions/runs/{run_id}/timing"
 	PostReposActionsRunsRerunByOwnerByRepoByRunID                = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun"
 	PostReposActionsRunsRerunFailedJobsByOwnerByRepoByRunID      = "POST /repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs"
@@ -169,6 +209,21 @@ const (
 
 	// Organization issue types endpoints
 	GetOrgsIssueTypesByOrg = "GET /orgs/{org}/issue-types"
+
+	// Markdown endpoints
+	PostMarkdown = "POST /markdown"
+
+	// Repository invitation endpoints
+	GetUserRepositoryInvitations                  = "GET /user/repository_invitations"
+	PatchUserRepositoryInvitationsByInvitationID  = "PATCH /user/repository_invitations/{invitation_id}"
+	DeleteUserRepositoryInvitationsByInvitationID = "DELETE /user/repository_invitations/{invitation_id}"
+
+	// Branch endpoints
+	GetReposBranchesByOwnerByRepoByBranch = "GET /repos/{owner}/{repo}/branches/{branch}"
+
+	// Commit comment endpoints
+	GetReposCommitsCommentsByOwnerByRepoByCommitSHA  = "GET /repos/{owner}/{repo}/commits/{commit_sha}/comments"
+	PostReposCommitsCommentsByOwnerByRepoByCommitSHA = "POST /repos/{owner}/{repo}/commits/{commit_sha}/comments"
 )
 
 type expectations struct {
@@ -0,0 +1,237 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxSimilarityKeywords bounds the OR terms in the search query; GitHub
+	// rejects queries with more than five boolean operators.
+	maxSimilarityKeywords = 6
+	// similarityCandidates is how many search hits are re-ranked by title.
+	similarityCandidates = 30
+	defaultSimilarLimit  = 5
+	maxSimilarLimit      = 20
+)
+
+// similarityStopWords are words too common in issue titles to say anything
+// about whether two issues describe the same problem.
+var similarityStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "when": true,
+	"not": true, "are": true, "was": true, "can": true, "cannot": true,
+	"does": true, "doesn": true, "from": true, "into": true, "this": true,
+	"that": true, "should": true, "would": true, "have": true, "has": true,
+	"after": true, "before": true, "using": true, "use": true, "bug": true,
+	"issue": true, "error": true, "feature": true, "request": true,
+	"add": true, "support": true, "fix": true, "don": true, "its": true,
+}
+
+// issueKeywords returns the distinct, lower-cased words of text that are
+// useful for matching, in the order they first appear.
+func issueKeywords(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	keywords := make([]string, 0, len(words))
+	for _, w := range words {
+		if len([]rune(w)) < 3 || similarityStopWords[w] || slices.Contains(keywords, w) {
+			continue
+		}
+		keywords = append(keywords, w)
+	}
+	return keywords
+}
+
+// similarIssuesQuery builds an issues search query for open issues in owner/repo
+// matching any keyword, preferring words from the title over the body.
+func similarIssuesQuery(owner, repo, title, body string) string {
+	keywords := issueKeywords(title)
+	for _, w := range issueKeywords(body) {
+		if len(keywords) >= maxSimilarityKeywords {
+			break
+		}
+		if !slices.Contains(keywords, w) {
+			keywords = append(keywords, w)
+		}
+	}
+	if len(keywords) > maxSimilarityKeywords {
+		keywords = keywords[:maxSimilarityKeywords]
+	}
+	if len(keywords) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("repo:%s/%s is:issue is:open %s", owner, repo, strings.Join(keywords, " OR "))
+}
+
+// titleSimilarity is the Jaccard similarity of the keyword sets of two titles.
+func titleSimilarity(a, b string) float64 {
+	ka, kb := issueKeywords(a), issueKeywords(b)
+	if len(ka) == 0 || len(kb) == 0 {
+		return 0
+	}
+	shared := 0
+	for _, w := range ka {
+		if slices.Contains(kb, w) {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ka)+len(kb)-shared)
+}
+
+// SimilarIssue is an open issue that may duplicate a proposed one.
+type SimilarIssue struct {
+	Number     int     `json:"number"`
+	Title      string  `json:"title"`
+	URL        string  `json:"url"`
+	Similarity float64 `json:"similarity"`
+}
+
+// SimilarIssuesResult is the result of find_similar_issues.
+type SimilarIssuesResult struct {
+	Query  string         `json:"query"`
+	Issues []SimilarIssue `json:"issues"`
+}
+
+// rankSimilarIssues orders issues by title similarity to title, highest first,
+// keeping the search order for ties, and returns at most limit of them.
+func rankSimilarIssues(title string, issues []*github.Issue, limit int) []SimilarIssue {
+	ranked := make([]SimilarIssue, 0, len(issues))
+	for _, issue := range issues {
+		ranked = append(ranked, SimilarIssue{
+			Number:     issue.GetNumber(),
+			Title:      issue.GetTitle(),
+			URL:        issue.GetHTMLURL(),
+			Similarity: math.Round(titleSimilarity(title, issue.GetTitle())*100) / 100,
+		})
+	}
+	slices.SortStableFunc(ranked, func(a, b SimilarIssue) int {
+		switch {
+		case a.Similarity > b.Similarity:
+			return -1
+		case a.Similarity < b.Similarity:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// FindSimilarIssues creates a tool that looks for open issues likely to
+// duplicate one that is about to be created.
+func FindSimilarIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "find_similar_issues",
+			Description: t("TOOL_FIND_SIMILAR_ISSUES_DESCRIPTION", "Find open issues in a repository that may duplicate a proposed issue. Searches using keywords from the title and body and ranks results by title similarity. Call this before creating an issue to avoid duplicates."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_FIND_SIMILAR_ISSUES_USER_TITLE", "Find similar issues"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"title": {
+						Type:        "string",
+						Description: "Title of the proposed issue",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Body of the proposed issue, used for extra keywords when the title is short",
+					},
+					"limit": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of issues to return (default %d, max %d)", defaultSimilarLimit, maxSimilarLimit),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxSimilarLimit)),
+					},
+				},
+				Required: []string{"owner", "repo", "title"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			title, err := RequiredParam[string](args, "title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			body, err := OptionalParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			limit, err := OptionalIntParamWithDefault(args, "limit", defaultSimilarLimit)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if limit < 1 || limit > maxSimilarLimit {
+				return utils.NewToolResultError(fmt.Sprintf("limit must be between 1 and %d", maxSimilarLimit)), nil, nil
+			}
+
+			query := similarIssuesQuery(owner, repo, title, body)
+			if query == "" {
+				return utils.NewToolResultError("title and body do not contain any searchable keywords"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+				ListOptions: github.ListOptions{PerPage: similarityCandidates},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search for similar issues", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to search for similar issues", resp, body), nil, nil
+			}
+
+			return MarshalledTextResult(SimilarIssuesResult{
+				Query:  query,
+				Issues: rankSimilarIssues(title, result.Issues, limit),
+			}), nil, nil
+		})
+}
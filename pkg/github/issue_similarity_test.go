@@ -0,0 +1,159 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_similarIssuesQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		body     string
+		expected string
+	}{
+		{
+			name:     "keywords from title without stop words",
+			title:    "Crash when the Docker image starts with an empty token",
+			expected: "repo:owner/repo is:issue is:open crash OR docker OR image OR starts OR empty OR token",
+		},
+		{
+			name:     "short title is padded from the body",
+			title:    "Login fails",
+			body:     "After upgrading, SSO login fails with a timeout.",
+			expected: "repo:owner/repo is:issue is:open login OR fails OR upgrading OR sso OR timeout",
+		},
+		{
+			name:     "nothing searchable",
+			title:    "a bug in it",
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, similarIssuesQuery("owner", "repo", tc.title, tc.body))
+		})
+	}
+}
+
+func Test_FindSimilarIssues(t *testing.T) {
+	// Verify tool definition once
+	serverTool := FindSimilarIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "find_similar_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "title"})
+
+	// Search returns hits in best-match order, which is not title similarity order
+	searchResult := &github.IssuesSearchResult{
+		Total: github.Ptr(3),
+		Issues: []*github.Issue{
+			{Number: github.Ptr(1), Title: github.Ptr("Document token scopes"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/1")},
+			{Number: github.Ptr(2), Title: github.Ptr("Server crash on empty token"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/2")},
+			{Number: github.Ptr(3), Title: github.Ptr("Docker image crash on startup"), HTMLURL: github.Ptr("https://github.com/owner/repo/issues/3")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedOrder  []int
+		expectedErrMsg string
+	}{
+		{
+			name: "query built from title and results ranked by title similarity",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: expectQueryParams(t, map[string]string{
+					"q":        "repo:owner/repo is:issue is:open crash OR empty OR token",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, searchResult)),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Crash with an empty token",
+			},
+			expectedOrder: []int{2, 1, 3},
+		},
+		{
+			name: "limit truncates ranked results",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: mockResponse(t, http.StatusOK, searchResult),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Docker image crash",
+				"limit": float64(1),
+			},
+			expectedOrder: []int{3},
+		},
+		{
+			name:         "no searchable keywords",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "a bug",
+			},
+			expectedErrMsg: "title and body do not contain any searchable keywords",
+		},
+		{
+			name: "search fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Crash on startup",
+			},
+			expectedErrMsg: "failed to search for similar issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned SimilarIssuesResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+
+			order := make([]int, 0, len(returned.Issues))
+			for _, issue := range returned.Issues {
+				order = append(order, issue.Number)
+			}
+			assert.Equal(t, tc.expectedOrder, order)
+			assert.GreaterOrEqual(t, returned.Issues[0].Similarity, returned.Issues[len(returned.Issues)-1].Similarity)
+		})
+	}
+}
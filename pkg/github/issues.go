@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -261,7 +263,7 @@ Options are:
 	}
 	WithPagination(schema)
 
-	return NewTool(
+	tool := NewTool(
 		ToolsetMetadataIssues,
 		mcp.Tool{
 			Name:        "issue_read",
@@ -324,6 +326,17 @@ Options are:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
 		})
+	tool.Examples = []inventory.ToolExample{
+		{
+			Description: "Get issue #42 in github/github-mcp-server",
+			Arguments:   map[string]any{"method": "get", "owner": "github", "repo": "github-mcp-server", "issue_number": 42},
+		},
+		{
+			Description: "Get the first page of comments on the same issue",
+			Arguments:   map[string]any{"method": "get_comments", "owner": "github", "repo": "github-mcp-server", "issue_number": 42, "perPage": 30},
+		},
+	}
+	return tool
 }
 
 func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int) (*mcp.CallToolResult, error) {
@@ -379,18 +392,22 @@ func GetIssue(ctx context.Context, client *github.Client, deps ToolDependencies,
 }
 
 func GetIssueComments(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int, pagination PaginationParams) (*mcp.CallToolResult, error) {
-	cache, err := deps.GetRepoAccessCache(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
-	}
-	flags := deps.GetFlags(ctx)
-
-	opts := &github.IssueListCommentsOptions{
+	return listIssueComments(ctx, client, deps, owner, repo, issueNumber, &github.IssueListCommentsOptions{
 		ListOptions: github.ListOptions{
 			Page:    pagination.Page,
 			PerPage: pagination.PerPage,
 		},
+	})
+}
+
+// listIssueComments lists the comments on an issue with the given options, applying
+// lockdown filtering and trimming each comment to its minimal form.
+func listIssueComments(ctx context.Context, client *github.Client, deps ToolDependencies, owner string, repo string, issueNumber int, opts *github.IssueListCommentsOptions) (*mcp.CallToolResult, error) {
+	cache, err := deps.GetRepoAccessCache(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo access cache: %w", err)
 	}
+	flags := deps.GetFlags(ctx)
 
 	comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
 	if err != nil {
@@ -697,13 +714,703 @@ func AddIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool
 		})
 }
 
-// SubIssueWrite creates a tool to add a sub-issue to a parent issue.
+// UpdateIssueComment creates a tool to replace the body of an existing issue comment.
+func UpdateIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "update_issue_comment",
+			Description: t("TOOL_UPDATE_ISSUE_COMMENT_DESCRIPTION", "Replace the body of an existing comment on an issue or pull request in a GitHub repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_ISSUE_COMMENT_USER_TITLE", "Edit issue comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "ID of the comment to edit",
+					},
+					"body": {
+						Type:        "string",
+						Description: "New comment content",
+					},
+				},
+				Required: []string{"owner", "repo", "comment_id", "body"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "comment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			body, err := RequiredParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			result, err := editIssueComment(ctx, client, owner, repo, commentID, body)
+			return result, nil, err
+		})
+}
+
+// editIssueComment replaces the body of an issue comment and returns the updated comment.
+func editIssueComment(ctx context.Context, client *github.Client, owner, repo string, commentID int64, body string) (*mcp.CallToolResult, error) {
+	updated, resp, err := client.Issues.EditComment(ctx, owner, repo, commentID, &github.IssueComment{
+		Body: github.Ptr(body),
+	})
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update comment", resp, err), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update comment", resp, body), nil
+	}
+
+	return MarshalledTextResult(convertToMinimalIssueComment(updated)), nil
+}
+
+// DeleteIssueComment creates a tool to delete a comment on an issue.
+func DeleteIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "delete_issue_comment",
+			Description: t("TOOL_DELETE_ISSUE_COMMENT_DESCRIPTION", "Delete a comment on an issue or pull request in a GitHub repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_ISSUE_COMMENT_USER_TITLE", "Delete issue comment"),
+				ReadOnlyHint:    false,
+				DestructiveHint: jsonschema.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"comment_id": {
+						Type:        "number",
+						Description: "ID of the comment to delete",
+					},
+				},
+				Required: []string{"owner", "repo", "comment_id"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			commentID, err := RequiredBigInt(args, "comment_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			resp, err := client.Issues.DeleteComment(ctx, owner, repo, commentID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to delete comment", resp, err), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to delete comment", resp, body), nil, nil
+			}
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully deleted comment %d from %s/%s", commentID, owner, repo)), nil, nil
+		})
+}
+
+// commentMarkerKeyPattern restricts marker keys to characters that cannot end an HTML comment.
+var commentMarkerKeyPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// commentMarker is the hidden token that identifies a comment managed by upsert_issue_comment.
+func commentMarker(key string) string {
+	return fmt.Sprintf("<!-- marker:%s -->", key)
+}
+
+// findMarkedIssueComment pages through the comments on an issue and returns the first one
+// containing marker, or nil if there is none.
+func findMarkedIssueComment(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, marker string) (*github.IssueComment, *github.Response, error) {
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	for {
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				return comment, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// UpsertIssueComment creates a tool that maintains a single comment on an issue, identified
+// by a hidden marker, creating it on the first call and editing it on later calls.
+func UpsertIssueComment(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "upsert_issue_comment",
+			Description: t("TOOL_UPSERT_ISSUE_COMMENT_DESCRIPTION", "Create or update a status comment on an issue or pull request. The comment is identified by a hidden marker derived from 'marker_key': if a comment with that marker exists its body is replaced, otherwise a new comment is created. Use this instead of add_issue_comment for comments that should be updated on later runs."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPSERT_ISSUE_COMMENT_USER_TITLE", "Create or update status comment"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Issue or pull request number",
+					},
+					"marker_key": {
+						Type:        "string",
+						Description: "Key identifying the comment across runs, e.g. 'ci-status'. Letters, digits, '.', '_' and '-' only",
+					},
+					"body": {
+						Type:        "string",
+						Description: "Comment content. The hidden marker is appended automatically",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number", "marker_key", "body"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			markerKey, err := RequiredParam[string](args, "marker_key")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if !commentMarkerKeyPattern.MatchString(markerKey) {
+				return utils.NewToolResultError(fmt.Sprintf("invalid marker_key %q: use only letters, digits, '.', '_' and '-'", markerKey)), nil, nil
+			}
+			body, err := RequiredParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			marker := commentMarker(markerKey)
+			existing, resp, err := findMarkedIssueComment(ctx, client, owner, repo, issueNumber, marker)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list comments", resp, err), nil, nil
+			}
+
+			markedBody := body + "\n\n" + marker
+			var (
+				action  string
+				comment *github.IssueComment
+			)
+			if existing != nil {
+				action = "updated"
+				comment, resp, err = client.Issues.EditComment(ctx, owner, repo, existing.GetID(), &github.IssueComment{Body: github.Ptr(markedBody)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to update comment", resp, err), nil, nil
+				}
+			} else {
+				action = "created"
+				comment, resp, err = client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{Body: github.Ptr(markedBody)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create comment", resp, err), nil, nil
+				}
+			}
+			_ = resp.Body.Close()
+
+			result := struct {
+				Action  string              `json:"action"`
+				Comment MinimalIssueComment `json:"comment"`
+			}{
+				Action:  action,
+				Comment: convertToMinimalIssueComment(comment),
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+}
+
+// issueLockReasons are the lock reasons accepted by the GitHub API.
+var issueLockReasons = []any{"off-topic", "too heated", "resolved", "spam"}
+
+// ManageIssueLock creates a tool to lock or unlock the conversation on an issue.
+func ManageIssueLock(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "manage_issue_lock",
+			Description: t("TOOL_MANAGE_ISSUE_LOCK_DESCRIPTION", "Lock or unlock the conversation on an issue or pull request in a GitHub repository. Locked conversations only accept comments from collaborators."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_ISSUE_LOCK_USER_TITLE", "Lock or unlock issue conversation"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Issue number",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to lock or unlock the conversation",
+						Enum:        []any{"lock", "unlock"},
+					},
+					"lock_reason": {
+						Type:        "string",
+						Description: "Reason for locking. Only valid with the 'lock' action",
+						Enum:        issueLockReasons,
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			lockReason, err := OptionalParam[string](args, "lock_reason")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			switch action {
+			case "lock":
+				if lockReason != "" && !slices.Contains(issueLockReasons, any(lockReason)) {
+					return utils.NewToolResultError(fmt.Sprintf("invalid lock_reason: %s", lockReason)), nil, nil
+				}
+			case "unlock":
+				if lockReason != "" {
+					return utils.NewToolResultError("lock_reason can only be used with the 'lock' action"), nil, nil
+				}
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("unknown action: %s", action)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			var resp *github.Response
+			if action == "lock" {
+				var opts *github.LockIssueOptions
+				if lockReason != "" {
+					opts = &github.LockIssueOptions{LockReason: lockReason}
+				}
+				resp, err = client.Issues.Lock(ctx, owner, repo, issueNumber, opts)
+			} else {
+				resp, err = client.Issues.Unlock(ctx, owner, repo, issueNumber)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to %s issue", action),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to %s issue", action), resp, body), nil, nil
+			}
+
+			result := struct {
+				Number     int    `json:"number"`
+				Locked     bool   `json:"locked"`
+				LockReason string `json:"lock_reason,omitempty"`
+			}{
+				Number:     issueNumber,
+				Locked:     action == "lock",
+				LockReason: lockReason,
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+}
+
+// TransferIssue creates a tool to move an issue to another repository.
+func TransferIssue(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "transfer_issue",
+			Description: t("TOOL_TRANSFER_ISSUE_DESCRIPTION", "Transfer an issue to another repository. The token must have write access to both repositories. Returns the issue's new number and URL in the target repository."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_TRANSFER_ISSUE_USER_TITLE", "Transfer issue"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"issue_number": {
+						Type:        "number",
+						Description: "Number of the issue to transfer",
+					},
+					"target_repo": {
+						Type:        "string",
+						Description: "Repository to move the issue to, as 'owner/repo'. A bare repository name uses the same owner as the source repository",
+					},
+				},
+				Required: []string{"owner", "repo", "issue_number", "target_repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			targetRepo, err := RequiredParam[string](args, "target_repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			targetOwner, targetName := owner, targetRepo
+			if o, n, found := strings.Cut(targetRepo, "/"); found {
+				targetOwner, targetName = o, n
+			}
+			if targetOwner == "" || targetName == "" || strings.Contains(targetName, "/") {
+				return utils.NewToolResultError(fmt.Sprintf("invalid target_repo %q: expected 'owner/repo'", targetRepo)), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
+			}
+
+			var query struct {
+				Repository struct {
+					Issue struct {
+						ID githubv4.ID
+					} `graphql:"issue(number: $issueNumber)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+				Target struct {
+					ID               githubv4.ID
+					ViewerPermission githubv4.String
+				} `graphql:"target: repository(owner: $targetOwner, name: $targetRepo)"`
+			}
+			vars := map[string]any{
+				"owner":       githubv4.String(owner),
+				"repo":        githubv4.String(repo),
+				"issueNumber": githubv4.Int(issueNumber), // #nosec G115 - issue numbers are always small positive integers
+				"targetOwner": githubv4.String(targetOwner),
+				"targetRepo":  githubv4.String(targetName),
+			}
+			if err := gqlClient.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					fmt.Sprintf("failed to resolve issue #%d or target repository %s/%s", issueNumber, targetOwner, targetName),
+					err,
+				), nil, nil
+			}
+			if query.Target.ID == nil || query.Target.ID == "" {
+				return utils.NewToolResultError(fmt.Sprintf("target repository %s/%s does not exist or is not accessible", targetOwner, targetName)), nil, nil
+			}
+			switch query.Target.ViewerPermission {
+			case "ADMIN", "MAINTAIN", "WRITE":
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("the token does not have write access to target repository %s/%s", targetOwner, targetName)), nil, nil
+			}
+
+			var mutation struct {
+				TransferIssue struct {
+					Issue struct {
+						Number githubv4.Int
+						URL    githubv4.String
+					}
+				} `graphql:"transferIssue(input: $input)"`
+			}
+			input := githubv4.TransferIssueInput{
+				IssueID:      query.Repository.Issue.ID,
+				RepositoryID: query.Target.ID,
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to transfer issue", err), nil, nil
+			}
+
+			result := struct {
+				Number int    `json:"number"`
+				URL    string `json:"url"`
+			}{
+				Number: int(mutation.TransferIssue.Issue.Number),
+				URL:    string(mutation.TransferIssue.Issue.URL),
+			}
+			return MarshalledTextResult(result), nil, nil
+		})
+	tool.RepoArguments = []inventory.RepoArgument{{Repo: "target_repo"}}
+	return tool
+}
+
+// ListIssueComments creates a tool to list the comments on an issue.
+func ListIssueComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"issue_number": {
+				Type:        "number",
+				Description: "Issue number",
+			},
+			"since": {
+				Type:        "string",
+				Description: "Only return comments updated at or after this time (ISO 8601 timestamp)",
+			},
+		},
+		Required: []string{"owner", "repo", "issue_number"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "list_issue_comments",
+			Description: t("TOOL_LIST_ISSUE_COMMENTS_DESCRIPTION", "List comments on an issue in a GitHub repository, oldest first, with each comment's author, body, timestamps and reactions. Use 'since' to fetch only comments updated after a point in time."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ISSUE_COMMENTS_USER_TITLE", "List issue comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			since, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			opts := &github.IssueListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if since != "" {
+				sinceTime, err := parseISOTimestamp(since)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("failed to list issue comments: %s", err.Error())), nil, nil
+				}
+				opts.Since = &sinceTime
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			result, err := listIssueComments(ctx, client, deps, owner, repo, issueNumber, opts)
+			return result, nil, err
+		})
+}
+
+// ListSubIssues creates a tool to list the sub-issues of a parent issue in priority order.
+func ListSubIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"issue_number": {
+				Type:        "number",
+				Description: "The number of the parent issue",
+			},
+		},
+		Required: []string{"owner", "repo", "issue_number"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataIssues,
+		mcp.Tool{
+			Name:        "list_sub_issues",
+			Description: t("TOOL_LIST_SUB_ISSUES_DESCRIPTION", "List the sub-issues of a parent issue in a GitHub repository, in their current priority order. The returned IDs can be used as 'sub_issue_id', 'after_id' or 'before_id' with sub_issue_write."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_SUB_ISSUES_USER_TITLE", "List sub-issues"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			issueNumber, err := RequiredInt(args, "issue_number")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("failed to get GitHub client", err), nil, nil
+			}
+
+			result, err := GetSubIssues(ctx, client, deps, owner, repo, issueNumber, pagination)
+			return result, nil, err
+		})
+}
+
+// SubIssueWrite creates a tool to add, remove or reprioritize a sub-issue of a parent issue.
 func SubIssueWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataIssues,
 		mcp.Tool{
 			Name:        "sub_issue_write",
-			Description: t("TOOL_SUB_ISSUE_WRITE_DESCRIPTION", "Add a sub-issue to a parent issue in a GitHub repository."),
+			Description: t("TOOL_SUB_ISSUE_WRITE_DESCRIPTION", "Add, remove or reorder a sub-issue of a parent issue in a GitHub repository."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_SUB_ISSUE_WRITE_USER_TITLE", "Change sub-issue"),
 				ReadOnlyHint: false,
@@ -964,12 +1671,13 @@ func SearchIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 				Description: "Sort order",
 				Enum:        []any{"asc", "desc"},
 			},
+			"fields": searchFieldsSchema(),
 		},
 		Required: []string{"query"},
 	}
 	WithPagination(schema)
 
-	return NewTool(
+	tool := NewTool(
 		ToolsetMetadataIssues,
 		mcp.Tool{
 			Name:        "search_issues",
@@ -985,6 +1693,8 @@ func SearchIssues(t translations.TranslationHelperFunc) inventory.ServerTool {
 			result, err := searchHandler(ctx, deps.GetClient, args, "issue", "failed to search issues")
 			return result, nil, err
 		})
+	tool.SearchQueryArgument = "query"
+	return tool
 }
 
 // IssueWrite creates a tool to create a new or update an existing issue in a GitHub repository.
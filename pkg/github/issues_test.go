@@ -483,6 +483,7 @@ func Test_SearchIssues(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "repo")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "sort")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "order")
+	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "fields")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.(*jsonschema.Schema).Properties, "page")
 	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"query"})
@@ -777,6 +778,98 @@ func Test_SearchIssues(t *testing.T) {
 	}
 }
 
+func Test_SearchIssuesFieldsProjection(t *testing.T) {
+	serverTool := SearchIssues(translations.NullTranslationHelper)
+
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:   github.Ptr(42),
+				Title:    github.Ptr("Bug: Something is broken"),
+				Body:     github.Ptr("This is a bug report"),
+				State:    github.Ptr("open"),
+				HTMLURL:  github.Ptr("https://github.com/owner/repo/issues/42"),
+				Comments: github.Ptr(5),
+				User: &github.User{
+					Login: github.Ptr("user1"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expectedKeys   []string
+	}{
+		{
+			name: "projection keeps only requested fields",
+			requestArgs: map[string]any{
+				"query":  "is:open",
+				"fields": []any{"number", "title", "state"},
+			},
+			expectedKeys: []string{"number", "title", "state"},
+		},
+		{
+			name: "unknown field is rejected",
+			requestArgs: map[string]any{
+				"query":  "is:open",
+				"fields": []any{"number", "bogus"},
+			},
+			expectError:    true,
+			expectedErrMsg: `unknown field "bogus"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetSearchIssues: mockResponse(t, http.StatusOK, mockSearchResult),
+			}))
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned struct {
+				TotalCount int              `json:"total_count"`
+				Items      []map[string]any `json:"items"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, 1, returned.TotalCount)
+			require.Len(t, returned.Items, 1)
+
+			keys := make([]string, 0, len(returned.Items[0]))
+			for k := range returned.Items[0] {
+				keys = append(keys, k)
+			}
+			assert.ElementsMatch(t, tc.expectedKeys, keys)
+			assert.NotContains(t, returned.Items[0], "body")
+			assert.NotContains(t, returned.Items[0], "user")
+			assert.NotContains(t, returned.Items[0], "comments")
+			assert.Equal(t, float64(42), returned.Items[0]["number"])
+		})
+	}
+}
+
 func Test_CreateIssue(t *testing.T) {
 	// Verify tool definition once
 	serverTool := IssueWrite(translations.NullTranslationHelper)
@@ -2035,6 +2128,121 @@ func Test_GetIssueComments(t *testing.T) {
 	}
 }
 
+func Test_ListIssueComments(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListIssueComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_issue_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "since")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockComments := []*github.IssueComment{
+		{
+			ID:        github.Ptr(int64(123)),
+			Body:      github.Ptr("Reproduced on main"),
+			User:      &github.User{Login: github.Ptr("user1")},
+			CreatedAt: &github.Timestamp{Time: createdAt},
+			Reactions: &github.Reactions{TotalCount: github.Ptr(3), PlusOne: github.Ptr(2), Heart: github.Ptr(1)},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectedComments []MinimalIssueComment
+		expectedErrMsg   string
+	}{
+		{
+			name: "since and pagination are passed through",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: expectQueryParams(t, map[string]string{
+					"since":    "2025-02-28T00:00:00Z",
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(mockResponse(t, http.StatusOK, mockComments)),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"since":        "2025-02-28",
+				"page":         float64(2),
+				"perPage":      float64(10),
+			},
+			expectedComments: []MinimalIssueComment{
+				{
+					ID:        123,
+					Body:      "Reproduced on main",
+					User:      &MinimalUser{Login: "user1"},
+					CreatedAt: createdAt.Format(time.RFC3339),
+					Reactions: &MinimalReactions{TotalCount: 3, PlusOne: 2, Heart: 1},
+				},
+			},
+		},
+		{
+			name: "defaults without since",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(mockResponse(t, http.StatusOK, []*github.IssueComment{})),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectedComments: []MinimalIssueComment{},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"since":        "last week",
+			},
+			expectedErrMsg: "invalid ISO 8601 timestamp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+				Flags:  stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned []MinimalIssueComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedComments, returned)
+		})
+	}
+}
+
 func Test_GetIssueLabels(t *testing.T) {
 	t.Parallel()
 
@@ -2600,6 +2808,699 @@ func Test_GetSubIssues(t *testing.T) {
 	}
 }
 
+func Test_ListSubIssues(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListSubIssues(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_sub_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "issue_number")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number"})
+
+	// Sub-issues are returned by the API in priority order
+	mockSubIssues := []*github.Issue{
+		{
+			ID:     github.Ptr(int64(2002)),
+			Number: github.Ptr(124),
+			Title:  github.Ptr("Second priority"),
+			User:   &github.User{Login: github.Ptr("user1")},
+		},
+		{
+			ID:     github.Ptr(int64(1001)),
+			Number: github.Ptr(123),
+			Title:  github.Ptr("First priority"),
+			User:   &github.User{Login: github.Ptr("user2")},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]any
+		expectedSubIssues []*github.Issue
+		expectedErrMsg    string
+	}{
+		{
+			name: "lists sub-issues in order",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, mockSubIssues),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectedSubIssues: mockSubIssues,
+		},
+		{
+			name: "passes pagination through",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "1",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockSubIssues[1:]),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"perPage":      float64(1),
+			},
+			expectedSubIssues: mockSubIssues[1:],
+		},
+		{
+			name: "parent issue not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesSubIssuesByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectedErrMsg: "failed to list sub-issues",
+		},
+		{
+			name:         "missing issue_number",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "missing required parameter: issue_number",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			gqlClient := githubv4.NewClient(nil)
+			deps := BaseDeps{
+				Client:          client,
+				GQLClient:       gqlClient,
+				RepoAccessCache: stubRepoAccessCache(gqlClient, 15*time.Minute),
+				Flags:           stubFeatureFlags(map[string]bool{"lockdown-mode": false}),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returnedSubIssues []*github.Issue
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returnedSubIssues))
+
+			require.Len(t, returnedSubIssues, len(tc.expectedSubIssues))
+			for i, subIssue := range returnedSubIssues {
+				assert.Equal(t, tc.expectedSubIssues[i].GetID(), subIssue.GetID())
+				assert.Equal(t, tc.expectedSubIssues[i].GetTitle(), subIssue.GetTitle())
+			}
+		})
+	}
+}
+
+func Test_UpdateIssueComment(t *testing.T) {
+	// Verify tool definition once
+	serverTool := UpdateIssueComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_issue_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "comment_id", "body"})
+
+	mockComment := &github.IssueComment{
+		ID:      github.Ptr(int64(123)),
+		Body:    github.Ptr("Build passed"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-123"),
+		User:    &github.User{Login: github.Ptr("ci-bot")},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]any
+		expectedComment MinimalIssueComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "edits comment body",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesCommentsByOwnerByRepoByCommentID: expect(t, expectations{
+					path:        "/repos/owner/repo/issues/comments/123",
+					requestBody: map[string]any{"body": "Build passed"},
+				}).andThen(mockResponse(t, http.StatusOK, mockComment)),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"body":       "Build passed",
+			},
+			expectedComment: MinimalIssueComment{
+				ID:      123,
+				Body:    "Build passed",
+				HTMLURL: "https://github.com/owner/repo/issues/42#issuecomment-123",
+				User:    &MinimalUser{Login: "ci-bot"},
+			},
+		},
+		{
+			name: "comment not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposIssuesCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(999),
+				"body":       "Build passed",
+			},
+			expectedErrMsg: "failed to update comment",
+		},
+		{
+			name:         "missing body",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+			},
+			expectedErrMsg: "missing required parameter: body",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned MinimalIssueComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedComment, returned)
+		})
+	}
+}
+
+func Test_DeleteIssueComment(t *testing.T) {
+	// Verify tool definition once
+	serverTool := DeleteIssueComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_issue_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "comment_id"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "deletes comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentsByOwnerByRepoByCommentID: expectPath(t, "/repos/owner/repo/issues/comments/123").andThen(
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					},
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+			},
+			expectedText: "Successfully deleted comment 123 from owner/repo",
+		},
+		{
+			name: "not permitted",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesCommentsByOwnerByRepoByCommentID: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+			},
+			expectedErrMsg: "failed to delete comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func Test_UpsertIssueComment(t *testing.T) {
+	// Verify tool definition once
+	serverTool := UpsertIssueComment(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "upsert_issue_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number", "marker_key", "body"})
+
+	markedBody := "Coverage: 91%\n\n<!-- marker:coverage -->"
+	otherComments := []*github.IssueComment{
+		{ID: github.Ptr(int64(1)), Body: github.Ptr("LGTM")},
+		{ID: github.Ptr(int64(2)), Body: github.Ptr("Status\n\n<!-- marker:lint -->")},
+	}
+	savedComment := &github.IssueComment{
+		ID:   github.Ptr(int64(3)),
+		Body: github.Ptr(markedBody),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedAction string
+		expectedErrMsg string
+	}{
+		{
+			name: "creates comment with marker when none exists",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, otherComments),
+				PostReposIssuesCommentsByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"body": markedBody,
+				}).andThen(mockResponse(t, http.StatusCreated, savedComment)),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"marker_key":   "coverage",
+				"body":         "Coverage: 91%",
+			},
+			expectedAction: "created",
+		},
+		{
+			name: "updates the comment carrying the marker",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, append(otherComments, &github.IssueComment{
+					ID:   github.Ptr(int64(3)),
+					Body: github.Ptr("Coverage: 88%\n\n<!-- marker:coverage -->"),
+				})),
+				PatchReposIssuesCommentsByOwnerByRepoByCommentID: expect(t, expectations{
+					path:        "/repos/owner/repo/issues/comments/3",
+					requestBody: map[string]any{"body": markedBody},
+				}).andThen(mockResponse(t, http.StatusOK, savedComment)),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"marker_key":   "coverage",
+				"body":         "Coverage: 91%",
+			},
+			expectedAction: "updated",
+		},
+		{
+			name:         "rejects marker keys that could break the HTML comment",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"marker_key":   "x -->",
+				"body":         "Coverage: 91%",
+			},
+			expectedErrMsg: "invalid marker_key",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned struct {
+				Action  string              `json:"action"`
+				Comment MinimalIssueComment `json:"comment"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedAction, returned.Action)
+			assert.Equal(t, int64(3), returned.Comment.ID)
+			assert.Equal(t, markedBody, returned.Comment.Body)
+		})
+	}
+}
+
+func Test_ManageIssueLock(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManageIssueLock(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "manage_issue_lock", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "action")
+	assert.Contains(t, schema.Properties, "lock_reason")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "issue_number", "action"})
+
+	noContent := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "lock with reason",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: expectRequestBody(t, map[string]any{
+					"lock_reason": "too heated",
+				}).andThen(noContent),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"action":       "lock",
+				"lock_reason":  "too heated",
+			},
+			expectedResult: map[string]any{"number": float64(42), "locked": true, "lock_reason": "too heated"},
+		},
+		{
+			name: "unlock",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposIssuesLockByOwnerByRepoByIssueNumber: noContent,
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"action":       "unlock",
+			},
+			expectedResult: map[string]any{"number": float64(42), "locked": false},
+		},
+		{
+			name:         "invalid lock reason",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"action":       "lock",
+				"lock_reason":  "boring",
+			},
+			expectedErrMsg: "invalid lock_reason: boring",
+		},
+		{
+			name:         "lock reason with unlock",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"action":       "unlock",
+				"lock_reason":  "spam",
+			},
+			expectedErrMsg: "lock_reason can only be used with the 'lock' action",
+		},
+		{
+			name: "issue not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposIssuesLockByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"action":       "lock",
+			},
+			expectedErrMsg: "failed to lock issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_TransferIssue(t *testing.T) {
+	// Verify tool definition once
+	serverTool := TransferIssue(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "transfer_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, tool.InputSchema.(*jsonschema.Schema).Required, []string{"owner", "repo", "issue_number", "target_repo"})
+
+	lookupQuery := struct {
+		Repository struct {
+			Issue struct {
+				ID githubv4.ID
+			} `graphql:"issue(number: $issueNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+		Target struct {
+			ID               githubv4.ID
+			ViewerPermission githubv4.String
+		} `graphql:"target: repository(owner: $targetOwner, name: $targetRepo)"`
+	}{}
+	lookupVars := func(targetOwner, targetRepo string) map[string]any {
+		return map[string]any{
+			"owner":       githubv4.String("owner"),
+			"repo":        githubv4.String("repo"),
+			"issueNumber": githubv4.Int(42),
+			"targetOwner": githubv4.String(targetOwner),
+			"targetRepo":  githubv4.String(targetRepo),
+		}
+	}
+	lookupResponse := func(permission string) githubv4mock.GQLResponse {
+		return githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{"id": "I_kwDOA0xdyM50BPaO"},
+			},
+			"target": map[string]any{
+				"id":               "R_kgDOTarget",
+				"viewerPermission": permission,
+			},
+		})
+	}
+	transferMutation := githubv4mock.NewMutationMatcher(
+		struct {
+			TransferIssue struct {
+				Issue struct {
+					Number githubv4.Int
+					URL    githubv4.String
+				}
+			} `graphql:"transferIssue(input: $input)"`
+		}{},
+		githubv4.TransferIssueInput{
+			IssueID:      "I_kwDOA0xdyM50BPaO",
+			RepositoryID: "R_kgDOTarget",
+		},
+		nil,
+		githubv4mock.DataResponse(map[string]any{
+			"transferIssue": map[string]any{
+				"issue": map[string]any{
+					"number": 7,
+					"url":    "https://github.com/other-owner/target/issues/7",
+				},
+			},
+		}),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "transfers to a repository owned by someone else",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(lookupQuery, lookupVars("other-owner", "target"), lookupResponse("WRITE")),
+				transferMutation,
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "other-owner/target",
+			},
+			expectedResult: map[string]any{"number": float64(7), "url": "https://github.com/other-owner/target/issues/7"},
+		},
+		{
+			name: "bare target name uses the source owner",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(lookupQuery, lookupVars("owner", "target"), lookupResponse("ADMIN")),
+				transferMutation,
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "target",
+			},
+			expectedResult: map[string]any{"number": float64(7), "url": "https://github.com/other-owner/target/issues/7"},
+		},
+		{
+			name: "target repository does not exist",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(lookupQuery, lookupVars("owner", "missing"), githubv4mock.ErrorResponse("Could not resolve to a Repository with the name 'owner/missing'.")),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "owner/missing",
+			},
+			expectedErrMsg: "failed to resolve issue #42 or target repository owner/missing",
+		},
+		{
+			name: "no write access to target repository",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(lookupQuery, lookupVars("other-owner", "target"), lookupResponse("READ")),
+			),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "other-owner/target",
+			},
+			expectedErrMsg: "the token does not have write access to target repository other-owner/target",
+		},
+		{
+			name:         "malformed target repository",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"target_repo":  "a/b/c",
+			},
+			expectedErrMsg: `invalid target_repo "a/b/c"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gqlClient := githubv4.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				GQLClient: gqlClient,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var returned map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
 func Test_RemoveSubIssue(t *testing.T) {
 	// Verify tool definition once
 	serverTool := SubIssueWrite(translations.NullTranslationHelper)
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// maxMarkdownInputBytes is the largest markdown document render_markdown accepts.
+	maxMarkdownInputBytes = 64 * 1024
+	// maxMarkdownOutputBytes caps how much rendered HTML render_markdown returns.
+	maxMarkdownOutputBytes = 256 * 1024
+)
+
+// RenderMarkdown creates a tool to render markdown to HTML the way GitHub would.
+func RenderMarkdown(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataExperiments,
+		mcp.Tool{
+			Name:        "render_markdown",
+			Description: t("TOOL_RENDER_MARKDOWN_DESCRIPTION", "Render markdown to HTML as GitHub would, e.g. to preview an issue or pull request body before posting it. Use gfm mode with a repository context to resolve references like #123 and @mentions."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_RENDER_MARKDOWN_USER_TITLE", "Render markdown"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"text": {
+						Type:        "string",
+						Description: fmt.Sprintf("Markdown to render, up to %d bytes", maxMarkdownInputBytes),
+					},
+					"mode": {
+						Type:        "string",
+						Description: "Rendering mode: 'markdown' renders plain markdown like a README, 'gfm' renders GitHub Flavored Markdown like an issue or comment",
+						Enum:        []any{"markdown", "gfm"},
+						Default:     json.RawMessage(`"markdown"`),
+					},
+					"context": {
+						Type:        "string",
+						Description: "Repository ('owner/repo') used to resolve issue references and links in gfm mode",
+					},
+				},
+				Required: []string{"text"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			text, err := RequiredParam[string](args, "text")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(text) > maxMarkdownInputBytes {
+				return utils.NewToolResultError(fmt.Sprintf("text is %d bytes; the maximum is %d bytes", len(text), maxMarkdownInputBytes)), nil, nil
+			}
+			mode, err := OptionalParam[string](args, "mode")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if mode == "" {
+				mode = "markdown"
+			}
+			if mode != "markdown" && mode != "gfm" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid mode %q: must be 'markdown' or 'gfm'", mode)), nil, nil
+			}
+			repoContext, err := OptionalParam[string](args, "context")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if repoContext != "" {
+				owner, repo, ok := strings.Cut(repoContext, "/")
+				if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+					return utils.NewToolResultError(fmt.Sprintf("invalid context %q: must be in the form 'owner/repo'", repoContext)), nil, nil
+				}
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			html, resp, err := client.Markdown.Render(ctx, text, &github.MarkdownOptions{
+				Mode:    mode,
+				Context: repoContext,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to render markdown",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if html, truncated := truncateText(html, maxMarkdownOutputBytes); truncated {
+				return utils.NewToolResultText(html + fmt.Sprintf("\n\n[output truncated after %d bytes]", maxMarkdownOutputBytes)), nil, nil
+			}
+			return utils.NewToolResultText(html), nil, nil
+		},
+	)
+}
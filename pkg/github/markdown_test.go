@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderMarkdown(t *testing.T) {
+	// Verify tool definition once
+	serverTool := RenderMarkdown(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "render_markdown", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataExperiments.ID, serverTool.Toolset.ID)
+	assert.Contains(t, schema.Properties, "text")
+	assert.Contains(t, schema.Properties, "mode")
+	assert.Contains(t, schema.Properties, "context")
+	assert.ElementsMatch(t, schema.Required, []string{"text"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "plain markdown by default",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: expectRequestBody(t, map[string]any{
+					"text": "# Hello",
+					"mode": "markdown",
+				}).andThen(
+					mockResponse(t, http.StatusOK, "<h1>Hello</h1>"),
+				),
+			}),
+			requestArgs: map[string]any{
+				"text": "# Hello",
+			},
+			expectedText: "<h1>Hello</h1>",
+		},
+		{
+			name: "gfm mode passes the repository context",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: expectRequestBody(t, map[string]any{
+					"text":    "Fixes #42",
+					"mode":    "gfm",
+					"context": "owner/repo",
+				}).andThen(
+					mockResponse(t, http.StatusOK, `<p>Fixes <a href="https://github.com/owner/repo/issues/42">#42</a></p>`),
+				),
+			}),
+			requestArgs: map[string]any{
+				"text":    "Fixes #42",
+				"mode":    "gfm",
+				"context": "owner/repo",
+			},
+			expectedText: `<p>Fixes <a href="https://github.com/owner/repo/issues/42">#42</a></p>`,
+		},
+		{
+			name: "large output is truncated",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: mockResponse(t, http.StatusOK, strings.Repeat("x", maxMarkdownOutputBytes+1)),
+			}),
+			requestArgs: map[string]any{
+				"text": "lots",
+			},
+			expectedText: strings.Repeat("x", maxMarkdownOutputBytes) + "\n\n[output truncated after 262144 bytes]",
+		},
+		{
+			name: "text too large",
+			requestArgs: map[string]any{
+				"text": strings.Repeat("a", maxMarkdownInputBytes+1),
+			},
+			expectError:    true,
+			expectedErrMsg: "the maximum is 65536 bytes",
+		},
+		{
+			name: "invalid mode",
+			requestArgs: map[string]any{
+				"text": "# Hello",
+				"mode": "html",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid mode \"html\": must be 'markdown' or 'gfm'",
+		},
+		{
+			name: "invalid context",
+			requestArgs: map[string]any{
+				"text":    "# Hello",
+				"mode":    "gfm",
+				"context": "owner",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid context \"owner\": must be in the form 'owner/repo'",
+		},
+		{
+			name: "render fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostMarkdown: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "Validation Failed"}`),
+			}),
+			requestArgs: map[string]any{
+				"text": "# Hello",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to render markdown",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
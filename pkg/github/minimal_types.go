@@ -10,6 +10,7 @@ import (
 type MinimalUser struct {
 	Login      string       `json:"login"`
 	ID         int64        `json:"id,omitempty"`
+	Name       string       `json:"name,omitempty"`
 	ProfileURL string       `json:"profile_url,omitempty"`
 	AvatarURL  string       `json:"avatar_url,omitempty"`
 	Details    *UserDetails `json:"details,omitempty"` // Optional field for additional user details
@@ -35,7 +36,9 @@ type MinimalRepository struct {
 	OpenIssues    int      `json:"open_issues_count"`
 	UpdatedAt     string   `json:"updated_at,omitempty"`
 	CreatedAt     string   `json:"created_at,omitempty"`
+	PushedAt      string   `json:"pushed_at,omitempty"`
 	Topics        []string `json:"topics,omitempty"`
+	Visibility    string   `json:"visibility,omitempty"`
 	Private       bool     `json:"private"`
 	Fork          bool     `json:"fork"`
 	Archived      bool     `json:"archived"`
@@ -77,6 +80,7 @@ type MinimalCommitFile struct {
 	Additions int    `json:"additions,omitempty"`
 	Deletions int    `json:"deletions,omitempty"`
 	Changes   int    `json:"changes,omitempty"`
+	Patch     string `json:"patch,omitempty"`
 }
 
 // MinimalPRFile represents a file changed in a pull request.
@@ -115,6 +119,28 @@ type MinimalRelease struct {
 	Author      *MinimalUser `json:"author,omitempty"`
 }
 
+// MinimalReleaseAsset is the trimmed output type for release asset objects.
+type MinimalReleaseAsset struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	Label              string `json:"label,omitempty"`
+	ContentType        string `json:"content_type"`
+	Size               int    `json:"size"`
+	DownloadCount      int    `json:"download_count"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// MinimalArtifact is the trimmed output type for workflow run artifact objects.
+type MinimalArtifact struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"size_in_bytes"`
+	Expired     bool   `json:"expired"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+	RunID       int64  `json:"workflow_run_id,omitempty"`
+}
+
 // MinimalBranch is the trimmed output type for branch objects.
 type MinimalBranch struct {
 	Name      string `json:"name"`
@@ -631,6 +657,24 @@ type MinimalReviewComment struct {
 	HTMLURL   string `json:"html_url"`
 }
 
+// MinimalPullRequestReviewComment is the trimmed output type for REST PR review comment objects.
+// It keeps the fields needed to reconstruct review threads from a flat list.
+type MinimalPullRequestReviewComment struct {
+	ID                  int64        `json:"id"`
+	InReplyToID         int64        `json:"in_reply_to_id,omitempty"`
+	PullRequestReviewID int64        `json:"pull_request_review_id,omitempty"`
+	Path                string       `json:"path"`
+	Line                *int         `json:"line,omitempty"`
+	StartLine           *int         `json:"start_line,omitempty"`
+	Side                string       `json:"side,omitempty"`
+	DiffHunk            string       `json:"diff_hunk,omitempty"`
+	Body                string       `json:"body,omitempty"`
+	User                *MinimalUser `json:"user,omitempty"`
+	HTMLURL             string       `json:"html_url"`
+	CreatedAt           string       `json:"created_at,omitempty"`
+	UpdatedAt           string       `json:"updated_at,omitempty"`
+}
+
 // MinimalReviewThread is the trimmed output type for PR review thread objects.
 type MinimalReviewThread struct {
 	IsResolved  bool                   `json:"is_resolved"`
@@ -672,6 +716,23 @@ func convertToMinimalBranch(branch *github.Branch) MinimalBranch {
 	}
 }
 
+func convertToMinimalArtifact(artifact *github.Artifact) MinimalArtifact {
+	m := MinimalArtifact{
+		ID:          artifact.GetID(),
+		Name:        artifact.GetName(),
+		SizeInBytes: artifact.GetSizeInBytes(),
+		Expired:     artifact.GetExpired(),
+		RunID:       artifact.GetWorkflowRun().GetID(),
+	}
+	if artifact.CreatedAt != nil {
+		m.CreatedAt = artifact.CreatedAt.Format(time.RFC3339)
+	}
+	if artifact.ExpiresAt != nil {
+		m.ExpiresAt = artifact.ExpiresAt.Format(time.RFC3339)
+	}
+	return m
+}
+
 func convertToMinimalReviewThreadsResponse(query reviewThreadsQuery) MinimalReviewThreadsResponse {
 	threads := query.Repository.PullRequest.ReviewThreads
 
@@ -707,6 +768,31 @@ func convertToMinimalReviewThread(thread reviewThreadNode) MinimalReviewThread {
 	}
 }
 
+func convertToMinimalPullRequestReviewComment(comment *github.PullRequestComment) MinimalPullRequestReviewComment {
+	m := MinimalPullRequestReviewComment{
+		ID:                  comment.GetID(),
+		InReplyToID:         comment.GetInReplyTo(),
+		PullRequestReviewID: comment.GetPullRequestReviewID(),
+		Path:                comment.GetPath(),
+		Line:                comment.Line,
+		StartLine:           comment.StartLine,
+		Side:                comment.GetSide(),
+		DiffHunk:            comment.GetDiffHunk(),
+		Body:                comment.GetBody(),
+		User:                convertToMinimalUser(comment.GetUser()),
+		HTMLURL:             comment.GetHTMLURL(),
+	}
+
+	if comment.CreatedAt != nil {
+		m.CreatedAt = comment.CreatedAt.Format(time.RFC3339)
+	}
+	if comment.UpdatedAt != nil {
+		m.UpdatedAt = comment.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return m
+}
+
 func convertToMinimalReviewComment(c reviewCommentNode) MinimalReviewComment {
 	m := MinimalReviewComment{
 		Body:    string(c.Body),
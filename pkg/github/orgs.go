@@ -0,0 +1,509 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// OrganizationMembership is the trimmed output type for a user's membership in an organization.
+type OrganizationMembership struct {
+	Org      string `json:"org"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	State    string `json:"state"`
+}
+
+// OrganizationTeam is the trimmed output type for a team in an organization.
+type OrganizationTeam struct {
+	Slug        string            `json:"slug"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Privacy     string            `json:"privacy,omitempty"`
+	Parent      *OrganizationTeam `json:"parent,omitempty"`
+}
+
+// OrganizationPlan is the trimmed output type for an organization's billing plan.
+type OrganizationPlan struct {
+	Name        string `json:"name"`
+	Seats       *int   `json:"seats,omitempty"`
+	FilledSeats *int   `json:"filled_seats,omitempty"`
+}
+
+// OrganizationProfile is the trimmed output type for an organization. Fields that are only
+// visible to organization members or owners are pointers so they are omitted when the token
+// cannot see them.
+type OrganizationProfile struct {
+	Login                        string            `json:"login"`
+	ID                           int64             `json:"id"`
+	Name                         string            `json:"name,omitempty"`
+	Description                  string            `json:"description,omitempty"`
+	HTMLURL                      string            `json:"html_url"`
+	Blog                         string            `json:"blog,omitempty"`
+	Location                     string            `json:"location,omitempty"`
+	Email                        string            `json:"email,omitempty"`
+	IsVerified                   bool              `json:"is_verified"`
+	PublicRepos                  int               `json:"public_repos"`
+	Followers                    int               `json:"followers"`
+	CreatedAt                    string            `json:"created_at,omitempty"`
+	TotalPrivateRepos            *int64            `json:"total_private_repos,omitempty"`
+	Plan                         *OrganizationPlan `json:"plan,omitempty"`
+	DefaultRepositoryPermission  *string           `json:"default_repository_permission,omitempty"`
+	MembersCanCreateRepositories *bool             `json:"members_can_create_repositories,omitempty"`
+	TwoFactorRequirementEnabled  *bool             `json:"two_factor_requirement_enabled,omitempty"`
+}
+
+// ListOrganizationMembers creates a tool to list the members of an organization.
+func ListOrganizationMembers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "list_organization_members",
+			Description: t("TOOL_LIST_ORGANIZATION_MEMBERS_DESCRIPTION", "List the members of a GitHub organization, optionally filtered by role"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ORGANIZATION_MEMBERS_USER_TITLE", "List organization members"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization login",
+					},
+					"role": {
+						Type:        "string",
+						Description: "Filter members by their role in the organization. Defaults to all.",
+						Enum:        []any{"all", "admin", "member"},
+					},
+				},
+				Required: []string{"org"},
+			}),
+		},
+		[]scopes.Scope{scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			role, err := OptionalParam[string](args, "role")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListMembersOptions{
+				Role: role,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			members, resp, err := client.Organizations.ListMembers(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list members of organization '%s'", org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list organization members", resp, body), nil, nil
+			}
+
+			minimalMembers := make([]*MinimalUser, 0, len(members))
+			for _, member := range members {
+				minimalMembers = append(minimalMembers, convertToMinimalUser(member))
+			}
+
+			r, err := json.Marshal(minimalMembers)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// GetOrganizationMembership creates a tool to get a user's role and membership state in an organization.
+func GetOrganizationMembership(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "get_organization_membership",
+			Description: t("TOOL_GET_ORGANIZATION_MEMBERSHIP_DESCRIPTION", "Get a user's role (admin or member) and membership state (active or pending) in a GitHub organization"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ORGANIZATION_MEMBERSHIP_USER_TITLE", "Get organization membership"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization login",
+					},
+					"username": {
+						Type:        "string",
+						Description: "Username to look up",
+					},
+				},
+				Required: []string{"org", "username"},
+			},
+		},
+		[]scopes.Scope{scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			membership, resp, err := client.Organizations.GetOrgMembership(ctx, username, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get membership of '%s' in organization '%s'", username, org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(OrganizationMembership{
+				Org:      org,
+				Username: membership.GetUser().GetLogin(),
+				Role:     membership.GetRole(),
+				State:    membership.GetState(),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// ListOrganizationRepositories creates a tool to list the repositories of an organization.
+func ListOrganizationRepositories(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "list_organization_repositories",
+			Description: t("TOOL_LIST_ORGANIZATION_REPOSITORIES_DESCRIPTION", "List the repositories of a GitHub organization. Prefer this over search_repositories when you need every repository in an organization."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ORGANIZATION_REPOSITORIES_USER_TITLE", "List organization repositories"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization login",
+					},
+					"type": {
+						Type:        "string",
+						Description: "Filter repositories by type. Defaults to all.",
+						Enum:        []any{"all", "public", "private", "forks", "sources", "member"},
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Sort field. Defaults to created.",
+						Enum:        []any{"created", "updated", "pushed", "full_name"},
+					},
+					"direction": {
+						Type:        "string",
+						Description: "Sort direction. Defaults to asc when sorting by full_name, otherwise desc.",
+						Enum:        []any{"asc", "desc"},
+					},
+				},
+				Required: []string{"org"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repoType, err := OptionalParam[string](args, "type")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			direction, err := OptionalParam[string](args, "direction")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.RepositoryListByOrgOptions{
+				Type:      repoType,
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			repos, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repositories of organization '%s'", org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list organization repositories", resp, body), nil, nil
+			}
+
+			minimalRepos := make([]MinimalRepository, 0, len(repos))
+			for _, repo := range repos {
+				minimalRepo := MinimalRepository{
+					ID:            repo.GetID(),
+					Name:          repo.GetName(),
+					FullName:      repo.GetFullName(),
+					Description:   repo.GetDescription(),
+					HTMLURL:       repo.GetHTMLURL(),
+					Language:      repo.GetLanguage(),
+					Stars:         repo.GetStargazersCount(),
+					Forks:         repo.GetForksCount(),
+					OpenIssues:    repo.GetOpenIssuesCount(),
+					Visibility:    repo.GetVisibility(),
+					Private:       repo.GetPrivate(),
+					Fork:          repo.GetFork(),
+					Archived:      repo.GetArchived(),
+					DefaultBranch: repo.GetDefaultBranch(),
+				}
+				if repo.PushedAt != nil {
+					minimalRepo.PushedAt = repo.PushedAt.Format(time.RFC3339)
+				}
+				minimalRepos = append(minimalRepos, minimalRepo)
+			}
+
+			r, err := json.Marshal(minimalRepos)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// ListOrganizationTeams creates a tool to list all teams in an organization.
+func ListOrganizationTeams(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "list_organization_teams",
+			Description: t("TOOL_LIST_ORGANIZATION_TEAMS_DESCRIPTION", "List all teams in a GitHub organization visible to the authenticated user, including each team's parent team. Use get_teams for the teams the user belongs to."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_ORGANIZATION_TEAMS_USER_TITLE", "List organization teams"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization login",
+					},
+				},
+				Required: []string{"org"},
+			}),
+		},
+		[]scopes.Scope{scopes.ReadOrg},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			teams, resp, err := client.Teams.ListTeams(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list teams of organization '%s'", org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list organization teams", resp, body), nil, nil
+			}
+
+			orgTeams := make([]OrganizationTeam, 0, len(teams))
+			for _, team := range teams {
+				orgTeam := OrganizationTeam{
+					Slug:        team.GetSlug(),
+					Name:        team.GetName(),
+					Description: team.GetDescription(),
+					Privacy:     team.GetPrivacy(),
+				}
+				if parent := team.GetParent(); parent != nil {
+					orgTeam.Parent = &OrganizationTeam{
+						Slug: parent.GetSlug(),
+						Name: parent.GetName(),
+					}
+				}
+				orgTeams = append(orgTeams, orgTeam)
+			}
+
+			r, err := json.Marshal(orgTeams)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// GetOrganization creates a tool to get the profile and settings of an organization.
+func GetOrganization(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataOrgs,
+		mcp.Tool{
+			Name:        "get_organization",
+			Description: t("TOOL_GET_ORGANIZATION_DESCRIPTION", "Get the profile of a GitHub organization. Plan, seat, default repository permission and two-factor requirement details are only included when the authenticated user can see them."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_ORGANIZATION_USER_TITLE", "Get organization"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"org": {
+						Type:        "string",
+						Description: "Organization login",
+					},
+				},
+				Required: []string{"org"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			org, err := RequiredParam[string](args, "org")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			organization, resp, err := client.Organizations.Get(ctx, org)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get organization '%s'", org),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			profile := OrganizationProfile{
+				Login:                        organization.GetLogin(),
+				ID:                           organization.GetID(),
+				Name:                         organization.GetName(),
+				Description:                  organization.GetDescription(),
+				HTMLURL:                      organization.GetHTMLURL(),
+				Blog:                         organization.GetBlog(),
+				Location:                     organization.GetLocation(),
+				Email:                        organization.GetEmail(),
+				IsVerified:                   organization.GetIsVerified(),
+				PublicRepos:                  organization.GetPublicRepos(),
+				Followers:                    organization.GetFollowers(),
+				TotalPrivateRepos:            organization.TotalPrivateRepos,
+				DefaultRepositoryPermission:  organization.DefaultRepoPermission,
+				MembersCanCreateRepositories: organization.MembersCanCreateRepos,
+				TwoFactorRequirementEnabled:  organization.TwoFactorRequirementEnabled,
+			}
+			if organization.CreatedAt != nil {
+				profile.CreatedAt = organization.CreatedAt.Format(time.RFC3339)
+			}
+			if plan := organization.Plan; plan != nil {
+				profile.Plan = &OrganizationPlan{
+					Name:        plan.GetName(),
+					Seats:       plan.Seats,
+					FilledSeats: plan.FilledSeats,
+				}
+			}
+
+			return MarshalledTextResult(profile), nil, nil
+		},
+	)
+}
@@ -0,0 +1,563 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrganizationMembers(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListOrganizationMembers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_organization_members", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "org")
+	assert.Contains(t, schema.Properties, "role")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"org"})
+
+	mockMembers := []*github.User{
+		{Login: github.Ptr("octocat"), ID: github.Ptr(int64(1))},
+		{Login: github.Ptr("hubot"), ID: github.Ptr(int64(2))},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedLogins []string
+		expectedErrMsg string
+	}{
+		{
+			name: "filters members by role",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsMembersByOrg: expectPath(t, "/orgs/acme/members").andThen(
+					expectQueryParams(t, map[string]string{
+						"role":     "admin",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMembers[:1]),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org":  "acme",
+				"role": "admin",
+			},
+			expectedLogins: []string{"octocat"},
+		},
+		{
+			name: "lists all members without role",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsMembersByOrg: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockMembers),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org": "acme",
+			},
+			expectedLogins: []string{"octocat", "hubot"},
+		},
+		{
+			name: "organization not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsMembersByOrg: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list members of organization 'missing'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalUser
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			logins := make([]string, 0, len(returned))
+			for _, u := range returned {
+				logins = append(logins, u.Login)
+			}
+			assert.Equal(t, tc.expectedLogins, logins)
+		})
+	}
+}
+
+func Test_GetOrganizationMembership(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetOrganizationMembership(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_organization_membership", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "org")
+	assert.Contains(t, schema.Properties, "username")
+	assert.ElementsMatch(t, schema.Required, []string{"org", "username"})
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]any
+		expectError        bool
+		expectedMembership OrganizationMembership
+		expectedErrMsg     string
+	}{
+		{
+			name: "returns role and state",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsMembershipsByOrgByUsername: expectPath(t, "/orgs/acme/memberships/octocat").andThen(
+					mockResponse(t, http.StatusOK, &github.Membership{
+						Role:  github.Ptr("admin"),
+						State: github.Ptr("active"),
+						User:  &github.User{Login: github.Ptr("octocat")},
+					}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org":      "acme",
+				"username": "octocat",
+			},
+			expectedMembership: OrganizationMembership{
+				Org:      "acme",
+				Username: "octocat",
+				Role:     "admin",
+				State:    "active",
+			},
+		},
+		{
+			name: "user is not a member",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsMembershipsByOrgByUsername: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org":      "acme",
+				"username": "stranger",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get membership of 'stranger' in organization 'acme'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned OrganizationMembership
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedMembership, returned)
+		})
+	}
+}
+
+func Test_ListOrganizationRepositories(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListOrganizationRepositories(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_organization_repositories", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "org")
+	assert.Contains(t, schema.Properties, "type")
+	assert.Contains(t, schema.Properties, "sort")
+	assert.Contains(t, schema.Properties, "direction")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"org"})
+
+	pushedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockRepos := []*github.Repository{
+		{
+			ID:         github.Ptr(int64(1)),
+			Name:       github.Ptr("widgets"),
+			FullName:   github.Ptr("acme/widgets"),
+			Visibility: github.Ptr("private"),
+			Private:    github.Ptr(true),
+			PushedAt:   &github.Timestamp{Time: pushedAt},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "forwards type filter and sorting",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsReposByOrg: expectPath(t, "/orgs/acme/repos").andThen(
+					expectQueryParams(t, map[string]string{
+						"type":      "private",
+						"sort":      "pushed",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRepos),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org":       "acme",
+				"type":      "private",
+				"sort":      "pushed",
+				"direction": "desc",
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsReposByOrg: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repositories of organization 'missing'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalRepository
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned, 1)
+			assert.Equal(t, "acme/widgets", returned[0].FullName)
+			assert.Equal(t, "private", returned[0].Visibility)
+			assert.Equal(t, "2024-05-01T10:00:00Z", returned[0].PushedAt)
+		})
+	}
+}
+
+func Test_ListOrganizationTeams(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListOrganizationTeams(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_organization_teams", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "org")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"org"})
+
+	mockTeams := []*github.Team{
+		{
+			Slug:        github.Ptr("engineering"),
+			Name:        github.Ptr("Engineering"),
+			Description: github.Ptr("All engineers"),
+			Privacy:     github.Ptr("closed"),
+		},
+		{
+			Slug:        github.Ptr("platform"),
+			Name:        github.Ptr("Platform"),
+			Description: github.Ptr("Platform team"),
+			Privacy:     github.Ptr("closed"),
+			Parent: &github.Team{
+				Slug: github.Ptr("engineering"),
+				Name: github.Ptr("Engineering"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedTeams  []OrganizationTeam
+		expectedErrMsg string
+	}{
+		{
+			name: "maps teams and parent relationships",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsTeamsByOrg: expectPath(t, "/orgs/acme/teams").andThen(
+					mockResponse(t, http.StatusOK, mockTeams),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org": "acme",
+			},
+			expectedTeams: []OrganizationTeam{
+				{
+					Slug:        "engineering",
+					Name:        "Engineering",
+					Description: "All engineers",
+					Privacy:     "closed",
+				},
+				{
+					Slug:        "platform",
+					Name:        "Platform",
+					Description: "Platform team",
+					Privacy:     "closed",
+					Parent: &OrganizationTeam{
+						Slug: "engineering",
+						Name: "Engineering",
+					},
+				},
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsTeamsByOrg: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list teams of organization 'missing'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []OrganizationTeam
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedTeams, returned)
+		})
+	}
+}
+
+func Test_GetOrganization(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetOrganization(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_organization", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "org")
+	assert.ElementsMatch(t, schema.Required, []string{"org"})
+
+	publicOrg := &github.Organization{
+		Login:       github.Ptr("acme"),
+		ID:          github.Ptr(int64(42)),
+		Name:        github.Ptr("Acme Corp"),
+		Description: github.Ptr("We make everything"),
+		HTMLURL:     github.Ptr("https://github.com/acme"),
+		IsVerified:  github.Ptr(true),
+		PublicRepos: github.Ptr(12),
+		Followers:   github.Ptr(100),
+		CreatedAt:   &github.Timestamp{Time: time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ownerVisibleOrg := *publicOrg
+	ownerVisibleOrg.TotalPrivateRepos = github.Ptr(int64(30))
+	ownerVisibleOrg.Plan = &github.Plan{
+		Name:        github.Ptr("enterprise"),
+		Seats:       github.Ptr(50),
+		FilledSeats: github.Ptr(45),
+	}
+	ownerVisibleOrg.DefaultRepoPermission = github.Ptr("read")
+	ownerVisibleOrg.MembersCanCreateRepos = github.Ptr(false)
+	ownerVisibleOrg.TwoFactorRequirementEnabled = github.Ptr(true)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		assertResult   func(t *testing.T, raw map[string]any, profile OrganizationProfile)
+	}{
+		{
+			name: "maps owner visible fields",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsByOrg: expectPath(t, "/orgs/acme").andThen(
+					mockResponse(t, http.StatusOK, &ownerVisibleOrg),
+				),
+			}),
+			requestArgs: map[string]any{
+				"org": "acme",
+			},
+			assertResult: func(t *testing.T, _ map[string]any, profile OrganizationProfile) {
+				assert.Equal(t, "acme", profile.Login)
+				assert.Equal(t, "Acme Corp", profile.Name)
+				assert.Equal(t, 12, profile.PublicRepos)
+				assert.Equal(t, "2015-06-01T00:00:00Z", profile.CreatedAt)
+				require.NotNil(t, profile.Plan)
+				assert.Equal(t, "enterprise", profile.Plan.Name)
+				assert.Equal(t, 50, *profile.Plan.Seats)
+				assert.Equal(t, 45, *profile.Plan.FilledSeats)
+				assert.Equal(t, int64(30), *profile.TotalPrivateRepos)
+				assert.Equal(t, "read", *profile.DefaultRepositoryPermission)
+				assert.False(t, *profile.MembersCanCreateRepositories)
+				assert.True(t, *profile.TwoFactorRequirementEnabled)
+			},
+		},
+		{
+			name: "omits restricted fields the token cannot see",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsByOrg: mockResponse(t, http.StatusOK, publicOrg),
+			}),
+			requestArgs: map[string]any{
+				"org": "acme",
+			},
+			assertResult: func(t *testing.T, raw map[string]any, profile OrganizationProfile) {
+				assert.Equal(t, "acme", profile.Login)
+				assert.True(t, profile.IsVerified)
+				for _, field := range []string{
+					"plan",
+					"total_private_repos",
+					"default_repository_permission",
+					"members_can_create_repositories",
+					"two_factor_requirement_enabled",
+				} {
+					assert.NotContains(t, raw, field)
+				}
+			},
+		},
+		{
+			name: "organization not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsByOrg: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"org": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get organization 'missing'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var raw map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &raw))
+			var profile OrganizationProfile
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &profile))
+			tc.assertResult(t, raw, profile)
+		})
+	}
+}
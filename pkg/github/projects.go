@@ -28,6 +28,9 @@ const (
 	ProjectStatusUpdateGetFailedError    = "failed to get project status update"
 	ProjectStatusUpdateCreateFailedError = "failed to create project status update"
 	ProjectResolveIDFailedError          = "failed to resolve project ID"
+	ProjectFieldCreateFailedError        = "failed to create project field"
+	ProjectArchiveFailedError            = "failed to archive a project item"
+	ProjectUnarchiveFailedError          = "failed to unarchive a project item"
 	MaxProjectsPerPage                   = 50
 )
 
@@ -45,6 +48,9 @@ const (
 	projectsMethodListProjectStatusUpdates  = "list_project_status_updates"
 	projectsMethodGetProjectStatusUpdate    = "get_project_status_update"
 	projectsMethodCreateProjectStatusUpdate = "create_project_status_update"
+	projectsMethodCreateProjectField        = "create_project_field"
+	projectsMethodArchiveProjectItem        = "archive_project_item"
+	projectsMethodUnarchiveProjectItem      = "unarchive_project_item"
 )
 
 // GraphQL types for ProjectV2 status updates
@@ -403,7 +409,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 		ToolsetMetadataProjects,
 		mcp.Tool{
 			Name:        "projects_write",
-			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Add, update, or delete project items, or create status updates in a GitHub Project."),
+			Description: t("TOOL_PROJECTS_WRITE_DESCRIPTION", "Add, update, archive, unarchive, or delete project items, create status updates, or create custom fields in a GitHub Project."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:           t("TOOL_PROJECTS_WRITE_USER_TITLE", "Modify GitHub Project items"),
 				ReadOnlyHint:    false,
@@ -420,6 +426,9 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 							projectsMethodUpdateProjectItem,
 							projectsMethodDeleteProjectItem,
 							projectsMethodCreateProjectStatusUpdate,
+							projectsMethodCreateProjectField,
+							projectsMethodArchiveProjectItem,
+							projectsMethodUnarchiveProjectItem,
 						},
 					},
 					"owner_type": {
@@ -437,7 +446,7 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					},
 					"item_id": {
 						Type:        "number",
-						Description: "The project item ID. Required for 'update_project_item' and 'delete_project_item' methods.",
+						Description: "The project item ID. Required for 'update_project_item', 'delete_project_item', 'archive_project_item' and 'unarchive_project_item' methods.",
 					},
 					"item_type": {
 						Type:        "string",
@@ -481,6 +490,22 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "The target date of the status update in YYYY-MM-DD format. Used for 'create_project_status_update' method.",
 					},
+					"field_name": {
+						Type:        "string",
+						Description: "The name of the new field. Required for 'create_project_field' method.",
+					},
+					"data_type": {
+						Type:        "string",
+						Description: "The data type of the new field. Required for 'create_project_field' method.",
+						Enum:        []any{"text", "number", "date", "single_select"},
+					},
+					"single_select_options": {
+						Type:        "array",
+						Description: "Option names for a 'single_select' field, in display order. Required when data_type is 'single_select'.",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
 				},
 				Required: []string{"method", "owner", "project_number"},
 			},
@@ -577,6 +602,12 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
 				return deleteProjectItem(ctx, client, owner, ownerType, projectNumber, itemID)
+			case projectsMethodArchiveProjectItem, projectsMethodUnarchiveProjectItem:
+				itemID, err := RequiredBigInt(args, "item_id")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				return setProjectItemArchived(ctx, client, gqlClient, owner, ownerType, projectNumber, itemID, method == projectsMethodArchiveProjectItem)
 			case projectsMethodCreateProjectStatusUpdate:
 				body, err := OptionalParam[string](args, "body")
 				if err != nil {
@@ -595,11 +626,26 @@ func ProjectsWrite(t translations.TranslationHelperFunc) inventory.ServerTool {
 					return utils.NewToolResultError(err.Error()), nil, nil
 				}
 				return createProjectStatusUpdate(ctx, gqlClient, owner, ownerType, projectNumber, body, status, startDate, targetDate)
+			case projectsMethodCreateProjectField:
+				fieldName, err := RequiredParam[string](args, "field_name")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				dataType, err := RequiredParam[string](args, "data_type")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				options, err := OptionalStringArrayParam(args, "single_select_options")
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				return createProjectField(ctx, gqlClient, owner, ownerType, projectNumber, fieldName, dataType, options)
 			default:
 				return utils.NewToolResultError(fmt.Sprintf("unknown method: %s", method)), nil, nil
 			}
 		},
 	)
+	tool.RepoArguments = []inventory.RepoArgument{{Owner: "item_owner", Repo: "item_repo"}}
 	return tool
 }
 
@@ -830,6 +876,8 @@ func listProjectItems(ctx context.Context, client *github.Client, args map[strin
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	newProjectOptionResolver(client, owner, ownerType, projectNumber).resolve(ctx, projectItems)
+
 	response := map[string]any{
 		"items":    projectItems,
 		"pageInfo": buildPageInfo(resp),
@@ -843,6 +891,145 @@ func listProjectItems(ctx context.Context, client *github.Client, args map[strin
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// projectOptionResolver renders the opaque single-select option and iteration IDs in project
+// item field values as their display names. The project's field definitions are fetched at
+// most once per resolver, so one resolver should be used for all items of a call.
+type projectOptionResolver struct {
+	client        *github.Client
+	owner         string
+	ownerType     string
+	projectNumber int
+
+	loaded bool
+	// names maps a field ID to the display names of its options or iterations, keyed by ID.
+	names map[int64]map[string]string
+}
+
+func newProjectOptionResolver(client *github.Client, owner, ownerType string, projectNumber int) *projectOptionResolver {
+	return &projectOptionResolver{
+		client:        client,
+		owner:         owner,
+		ownerType:     ownerType,
+		projectNumber: projectNumber,
+	}
+}
+
+// optionValueKey returns the key under which a resolved name is stored for a field type,
+// or "" if values of that type are not option references.
+func optionValueKey(dataType string) string {
+	switch dataType {
+	case "single_select", "status":
+		return "name"
+	case "iteration":
+		return "title"
+	default:
+		return ""
+	}
+}
+
+// optionValueID extracts the option or iteration ID from a raw field value, which the API
+// returns either as the bare ID or as an object with an "id" member.
+func optionValueID(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]any:
+		id, _ := v["id"].(string)
+		return id
+	default:
+		return ""
+	}
+}
+
+// load fetches every field definition of the project and indexes option and iteration names.
+func (r *projectOptionResolver) load(ctx context.Context) error {
+	r.loaded = true
+	r.names = make(map[int64]map[string]string)
+
+	perPage := MaxProjectsPerPage
+	opts := &github.ListProjectsOptions{
+		ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: &perPage},
+	}
+	for {
+		var fields []*github.ProjectV2Field
+		var resp *github.Response
+		var err error
+		if r.ownerType == "org" {
+			fields, resp, err = r.client.Projects.ListOrganizationProjectFields(ctx, r.owner, r.projectNumber, opts)
+		} else {
+			fields, resp, err = r.client.Projects.ListUserProjectFields(ctx, r.owner, r.projectNumber, opts)
+		}
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+
+		for _, field := range fields {
+			names := make(map[string]string)
+			for _, option := range field.Options {
+				if option.GetID() != "" && option.GetName() != nil {
+					names[option.GetID()] = option.GetName().GetRaw()
+				}
+			}
+			if field.Configuration != nil {
+				for _, iteration := range field.Configuration.Iterations {
+					if iteration.GetID() != "" && iteration.GetTitle() != nil {
+						names[iteration.GetID()] = iteration.GetTitle().GetRaw()
+					}
+				}
+			}
+			if len(names) > 0 {
+				r.names[field.GetID()] = names
+			}
+		}
+
+		if resp.After == "" {
+			return nil
+		}
+		after := resp.After
+		opts.After = &after
+	}
+}
+
+// resolve rewrites single-select and iteration values on items in place so that they carry
+// the option name or iteration title alongside the ID. Field definitions are only fetched
+// when at least one such value is present. If they cannot be fetched, values are left as
+// returned by the API rather than failing the call.
+func (r *projectOptionResolver) resolve(ctx context.Context, items []*github.ProjectV2Item) {
+	var pending []*github.ProjectV2ItemFieldValue
+	for _, item := range items {
+		for _, fv := range item.Fields {
+			if optionValueKey(fv.GetDataType()) != "" && optionValueID(fv.Value) != "" {
+				pending = append(pending, fv)
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+	if !r.loaded {
+		if err := r.load(ctx); err != nil {
+			return
+		}
+	}
+
+	for _, fv := range pending {
+		id := optionValueID(fv.Value)
+		name, ok := r.names[fv.GetID()][id]
+		if !ok {
+			continue
+		}
+		resolved := map[string]any{"id": id}
+		if m, isMap := fv.Value.(map[string]any); isMap {
+			for k, v := range m {
+				resolved[k] = v
+			}
+		}
+		resolved[optionValueKey(fv.GetDataType())] = name
+		fv.Value = resolved
+	}
+}
+
 func getProject(ctx context.Context, client *github.Client, owner, ownerType string, projectNumber int) (*mcp.CallToolResult, any, error) {
 	var resp *github.Response
 	var project *github.ProjectV2
@@ -949,6 +1136,8 @@ func getProjectItem(ctx context.Context, client *github.Client, owner, ownerType
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get project item", resp, body), nil, nil
 	}
 
+	newProjectOptionResolver(client, owner, ownerType, projectNumber).resolve(ctx, []*github.ProjectV2Item{projectItem})
+
 	r, err := json.Marshal(projectItem)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -1025,6 +1214,91 @@ func deleteProjectItem(ctx context.Context, client *github.Client, owner, ownerT
 	return utils.NewToolResultText("project item successfully deleted"), nil, nil
 }
 
+// setProjectItemArchived archives or unarchives a project item. The REST API does not
+// support archiving, so the item's node ID is looked up over REST and the change is
+// made with the archiveProjectV2Item/unarchiveProjectV2Item GraphQL mutations.
+func setProjectItemArchived(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, itemID int64, archived bool) (*mcp.CallToolResult, any, error) {
+	failedMsg := ProjectUnarchiveFailedError
+	if archived {
+		failedMsg = ProjectArchiveFailedError
+	}
+
+	var resp *github.Response
+	var projectItem *github.ProjectV2Item
+	var err error
+
+	if ownerType == "org" {
+		projectItem, resp, err = client.Projects.GetOrganizationProjectItem(ctx, owner, projectNumber, itemID, nil)
+	} else {
+		projectItem, resp, err = client.Projects.GetUserProjectItem(ctx, owner, projectNumber, itemID, nil)
+	}
+
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx,
+			failedMsg,
+			resp,
+			err,
+		), nil, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, failedMsg, resp, body), nil, nil
+	}
+
+	projectID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+	itemNodeID := githubv4.ID(projectItem.GetNodeID())
+
+	var isArchived bool
+	if archived {
+		var mutation struct {
+			ArchiveProjectV2Item struct {
+				Item struct {
+					ID         githubv4.ID
+					IsArchived githubv4.Boolean
+				}
+			} `graphql:"archiveProjectV2Item(input: $input)"`
+		}
+		input := githubv4.ArchiveProjectV2ItemInput{ProjectID: projectID, ItemID: itemNodeID}
+		if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("%s: %v", failedMsg, err)), nil, nil
+		}
+		isArchived = bool(mutation.ArchiveProjectV2Item.Item.IsArchived)
+	} else {
+		var mutation struct {
+			UnarchiveProjectV2Item struct {
+				Item struct {
+					ID         githubv4.ID
+					IsArchived githubv4.Boolean
+				}
+			} `graphql:"unarchiveProjectV2Item(input: $input)"`
+		}
+		input := githubv4.UnarchiveProjectV2ItemInput{ProjectID: projectID, ItemID: itemNodeID}
+		if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+			return utils.NewToolResultError(fmt.Sprintf("%s: %v", failedMsg, err)), nil, nil
+		}
+		isArchived = bool(mutation.UnarchiveProjectV2Item.Item.IsArchived)
+	}
+
+	r, err := json.Marshal(map[string]any{
+		"id":       itemID,
+		"node_id":  projectItem.GetNodeID(),
+		"archived": isArchived,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
 // resolveProjectNodeID resolves (owner, ownerType, projectNumber) to a project node ID via GraphQL.
 func resolveProjectNodeID(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int) (githubv4.ID, error) {
 	var projectIDQueryUser struct {
@@ -1198,6 +1472,98 @@ func createProjectStatusUpdate(ctx context.Context, gqlClient *githubv4.Client,
 	return utils.NewToolResultText(string(r)), nil, nil
 }
 
+// projectFieldDataTypes maps the data_type values accepted by create_project_field to GraphQL field types.
+var projectFieldDataTypes = map[string]githubv4.ProjectV2CustomFieldType{
+	"text":          githubv4.ProjectV2CustomFieldTypeText,
+	"number":        githubv4.ProjectV2CustomFieldTypeNumber,
+	"date":          githubv4.ProjectV2CustomFieldTypeDate,
+	"single_select": githubv4.ProjectV2CustomFieldTypeSingleSelect,
+}
+
+// createdProjectFieldNode is the field returned by the createProjectV2Field mutation.
+type createdProjectFieldNode struct {
+	Common struct {
+		ID       githubv4.ID
+		Name     githubv4.String
+		DataType githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+	SingleSelect struct {
+		Options []struct {
+			ID   githubv4.String
+			Name githubv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+}
+
+// createProjectField creates a custom field on a project via GraphQL and returns its node ID.
+func createProjectField(ctx context.Context, gqlClient *githubv4.Client, owner, ownerType string, projectNumber int, name, dataType string, options []string) (*mcp.CallToolResult, any, error) {
+	fieldType, ok := projectFieldDataTypes[dataType]
+	if !ok {
+		return utils.NewToolResultError(fmt.Sprintf("invalid data_type %q: must be one of text, number, date, single_select", dataType)), nil, nil
+	}
+	if fieldType == githubv4.ProjectV2CustomFieldTypeSingleSelect && len(options) == 0 {
+		return utils.NewToolResultError("single_select_options must contain at least one option when data_type is 'single_select'"), nil, nil
+	}
+	if fieldType != githubv4.ProjectV2CustomFieldTypeSingleSelect && len(options) > 0 {
+		return utils.NewToolResultError("single_select_options can only be used when data_type is 'single_select'"), nil, nil
+	}
+
+	projectID, err := resolveProjectNodeID(ctx, gqlClient, owner, ownerType, projectNumber)
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil, nil
+	}
+
+	input := githubv4.CreateProjectV2FieldInput{
+		ProjectID: projectID,
+		DataType:  fieldType,
+		Name:      githubv4.String(name),
+	}
+	if len(options) > 0 {
+		selectOptions := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+		for _, option := range options {
+			selectOptions = append(selectOptions, githubv4.ProjectV2SingleSelectFieldOptionInput{
+				Name:        githubv4.String(option),
+				Color:       githubv4.ProjectV2SingleSelectFieldOptionColorGray,
+				Description: githubv4.String(""),
+			})
+		}
+		input.SingleSelectOptions = &selectOptions
+	}
+
+	var mutation struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field createdProjectFieldNode
+		} `graphql:"createProjectV2Field(input: $input)"`
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return utils.NewToolResultError(fmt.Sprintf("%s: %v", ProjectFieldCreateFailedError, err)), nil, nil
+	}
+
+	field := mutation.CreateProjectV2Field.ProjectV2Field
+	createdOptions := make([]map[string]string, 0, len(field.SingleSelect.Options))
+	for _, option := range field.SingleSelect.Options {
+		createdOptions = append(createdOptions, map[string]string{
+			"id":   string(option.ID),
+			"name": string(option.Name),
+		})
+	}
+	result := map[string]any{
+		"id":        fmt.Sprint(field.Common.ID),
+		"name":      string(field.Common.Name),
+		"data_type": string(field.Common.DataType),
+	}
+	if len(createdOptions) > 0 {
+		result["options"] = createdOptions
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return utils.NewToolResultText(string(r)), nil, nil
+}
+
 // listProjectStatusUpdates lists status updates for a project via GraphQL.
 func listProjectStatusUpdates(ctx context.Context, gqlClient *githubv4.Client, args map[string]any, owner, ownerType string) (*mcp.CallToolResult, any, error) {
 	if ownerType != "user" && ownerType != "org" {
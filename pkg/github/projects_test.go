@@ -222,6 +222,80 @@ func Test_ProjectsList_ListProjectItems(t *testing.T) {
 	})
 }
 
+func Test_ProjectsList_ListProjectItems_ResolvesOptionNames(t *testing.T) {
+	toolDef := ProjectsList(translations.NullTranslationHelper)
+
+	fields := []map[string]any{
+		{
+			"id":        101,
+			"name":      "Status",
+			"data_type": "single_select",
+			"options": []map[string]any{
+				{"id": "f75ad846", "name": map[string]any{"raw": "Todo"}},
+				{"id": "47fc9ee4", "name": map[string]any{"raw": "In Progress"}},
+			},
+		},
+		{"id": 102, "name": "Estimate", "data_type": "number"},
+	}
+	items := []map[string]any{
+		{
+			"id": 1001,
+			"fields": []map[string]any{
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": "47fc9ee4"},
+				{"id": 102, "name": "Estimate", "data_type": "number", "value": 3},
+			},
+		},
+		{
+			"id": 1002,
+			"fields": []map[string]any{
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "f75ad846", "color": "GRAY"}},
+			},
+		},
+	}
+
+	fieldRequests := 0
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetOrgsProjectsV2ItemsByProject: mockResponse(t, http.StatusOK, items),
+		GetOrgsProjectsV2FieldsByProject: func(w http.ResponseWriter, r *http.Request) {
+			fieldRequests++
+			mockResponse(t, http.StatusOK, fields)(w, r)
+		},
+	})
+
+	client := gh.NewClient(mockedClient)
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "list_project_items",
+		"owner":          "octo-org",
+		"owner_type":     "org",
+		"project_number": float64(1),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		Items []struct {
+			Fields []struct {
+				Name  string `json:"name"`
+				Value any    `json:"value"`
+			} `json:"fields"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+	require.Len(t, response.Items, 2)
+
+	assert.Equal(t, map[string]any{"id": "47fc9ee4", "name": "In Progress"}, response.Items[0].Fields[0].Value)
+	assert.Equal(t, float64(3), response.Items[0].Fields[1].Value)
+	assert.Equal(t, map[string]any{"id": "f75ad846", "name": "Todo", "color": "GRAY"}, response.Items[1].Fields[0].Value)
+	assert.Equal(t, 1, fieldRequests, "field definitions should be fetched once per call")
+}
+
 func Test_ProjectsGet(t *testing.T) {
 	// Verify tool definition once
 	toolDef := ProjectsGet(translations.NullTranslationHelper)
@@ -406,6 +480,61 @@ func Test_ProjectsGet_GetProjectItem(t *testing.T) {
 	})
 }
 
+func Test_ProjectsGet_GetProjectItem_ResolvesIterationTitle(t *testing.T) {
+	toolDef := ProjectsGet(translations.NullTranslationHelper)
+
+	fields := []map[string]any{
+		{
+			"id":        201,
+			"name":      "Sprint",
+			"data_type": "iteration",
+			"configuration": map[string]any{
+				"iterations": []map[string]any{
+					{"id": "c7d2a1", "title": map[string]any{"raw": "Sprint 12"}, "start_date": "2025-03-03"},
+				},
+			},
+		},
+	}
+	item := map[string]any{
+		"id": 1001,
+		"fields": []map[string]any{
+			{"id": 201, "name": "Sprint", "data_type": "iteration", "value": "c7d2a1"},
+		},
+	}
+
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUsersProjectsV2ItemsByUsernameByProjectByItemID: mockResponse(t, http.StatusOK, item),
+		GetUsersProjectsV2FieldsByUsernameByProject:        mockResponse(t, http.StatusOK, fields),
+	})
+
+	client := gh.NewClient(mockedClient)
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := toolDef.Handler(deps)
+	request := createMCPRequest(map[string]any{
+		"method":         "get_project_item",
+		"owner":          "octocat",
+		"owner_type":     "user",
+		"project_number": float64(1),
+		"item_id":        float64(1001),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		Fields []struct {
+			Value any `json:"value"`
+		} `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+	require.Len(t, response.Fields, 1)
+	assert.Equal(t, map[string]any{"id": "c7d2a1", "title": "Sprint 12"}, response.Fields[0].Value)
+}
+
 func Test_ProjectsWrite(t *testing.T) {
 	// Verify tool definition once
 	toolDef := ProjectsWrite(translations.NullTranslationHelper)
@@ -1020,3 +1149,270 @@ func Test_ProjectsWrite_CreateProjectStatusUpdate(t *testing.T) {
 		assert.Equal(t, "AT_RISK", response["status"])
 	})
 }
+
+func Test_ProjectsWrite_CreateProjectField(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	projectIDQuery := githubv4mock.NewQueryMatcher(
+		struct {
+			User struct {
+				ProjectV2 struct {
+					ID githubv4.ID
+				} `graphql:"projectV2(number: $projectNumber)"`
+			} `graphql:"user(login: $owner)"`
+		}{},
+		map[string]any{
+			"owner":         githubv4.String("octocat"),
+			"projectNumber": githubv4.Int(3),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"user": map[string]any{
+				"projectV2": map[string]any{
+					"id": "PVT_project3",
+				},
+			},
+		}),
+	)
+
+	t.Run("single select field with options", func(t *testing.T) {
+		gqlMockedClient := githubv4mock.NewMockedHTTPClient(
+			projectIDQuery,
+			githubv4mock.NewMutationMatcher(
+				struct {
+					CreateProjectV2Field struct {
+						ProjectV2Field createdProjectFieldNode
+					} `graphql:"createProjectV2Field(input: $input)"`
+				}{},
+				githubv4.CreateProjectV2FieldInput{
+					ProjectID: githubv4.ID("PVT_project3"),
+					DataType:  githubv4.ProjectV2CustomFieldTypeSingleSelect,
+					Name:      githubv4.String("Priority"),
+					SingleSelectOptions: &[]githubv4.ProjectV2SingleSelectFieldOptionInput{
+						{Name: githubv4.String("High"), Color: githubv4.ProjectV2SingleSelectFieldOptionColorGray, Description: githubv4.String("")},
+						{Name: githubv4.String("Low"), Color: githubv4.ProjectV2SingleSelectFieldOptionColorGray, Description: githubv4.String("")},
+					},
+				},
+				nil,
+				githubv4mock.DataResponse(map[string]any{
+					"createProjectV2Field": map[string]any{
+						"projectV2Field": map[string]any{
+							"id":       "PVTSSF_field1",
+							"name":     "Priority",
+							"dataType": "SINGLE_SELECT",
+							"options": []any{
+								map[string]any{"id": "opt_high", "name": "High"},
+								map[string]any{"id": "opt_low", "name": "Low"},
+							},
+						},
+					},
+				}),
+			),
+		)
+
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(gqlMockedClient),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":                "create_project_field",
+			"owner":                 "octocat",
+			"owner_type":            "user",
+			"project_number":        float64(3),
+			"field_name":            "Priority",
+			"data_type":             "single_select",
+			"single_select_options": []any{"High", "Low"},
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		textContent := getTextResult(t, result)
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, "PVTSSF_field1", response["id"])
+		assert.Equal(t, "Priority", response["name"])
+		assert.Equal(t, "SINGLE_SELECT", response["data_type"])
+		assert.Equal(t, []any{
+			map[string]any{"id": "opt_high", "name": "High"},
+			map[string]any{"id": "opt_low", "name": "Low"},
+		}, response["options"])
+	})
+
+	t.Run("single select without options", func(t *testing.T) {
+		deps := BaseDeps{
+			GQLClient: githubv4.NewClient(githubv4mock.NewMockedHTTPClient()),
+		}
+		handler := toolDef.Handler(deps)
+		request := createMCPRequest(map[string]any{
+			"method":         "create_project_field",
+			"owner":          "octocat",
+			"owner_type":     "user",
+			"project_number": float64(3),
+			"field_name":     "Priority",
+			"data_type":      "single_select",
+		})
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+		require.NoError(t, err)
+		errorContent := getErrorResult(t, result)
+		assert.Contains(t, errorContent.Text, "single_select_options must contain at least one option")
+	})
+}
+
+func Test_ProjectsWrite_ArchiveProjectItem(t *testing.T) {
+	toolDef := ProjectsWrite(translations.NullTranslationHelper)
+
+	type archiveMutation struct {
+		ArchiveProjectV2Item struct {
+			Item struct {
+				ID         githubv4.ID
+				IsArchived githubv4.Boolean
+			}
+		} `graphql:"archiveProjectV2Item(input: $input)"`
+	}
+	type unarchiveMutation struct {
+		UnarchiveProjectV2Item struct {
+			Item struct {
+				ID         githubv4.ID
+				IsArchived githubv4.Boolean
+			}
+		} `graphql:"unarchiveProjectV2Item(input: $input)"`
+	}
+
+	projectItem := map[string]any{"id": 1001, "node_id": "PVTI_item1001"}
+
+	tests := []struct {
+		name             string
+		method           string
+		mockedClient     *http.Client
+		gqlClient        *http.Client
+		expectedArchived bool
+		expectedErrMsg   string
+	}{
+		{
+			name:   "archive item in org project",
+			method: "archive_project_item",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, projectItem),
+			}),
+			gqlClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Organization struct {
+							ProjectV2 struct {
+								ID githubv4.ID
+							} `graphql:"projectV2(number: $projectNumber)"`
+						} `graphql:"organization(login: $owner)"`
+					}{},
+					map[string]any{
+						"owner":         githubv4.String("octo-org"),
+						"projectNumber": githubv4.Int(1),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"organization": map[string]any{
+							"projectV2": map[string]any{"id": "PVT_project1"},
+						},
+					}),
+				),
+				githubv4mock.NewMutationMatcher(
+					archiveMutation{},
+					githubv4.ArchiveProjectV2ItemInput{
+						ProjectID: githubv4.ID("PVT_project1"),
+						ItemID:    githubv4.ID("PVTI_item1001"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"archiveProjectV2Item": map[string]any{
+							"item": map[string]any{"id": "PVTI_item1001", "isArchived": true},
+						},
+					}),
+				),
+			),
+			expectedArchived: true,
+		},
+		{
+			name:   "unarchive item in org project",
+			method: "unarchive_project_item",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusOK, projectItem),
+			}),
+			gqlClient: githubv4mock.NewMockedHTTPClient(
+				githubv4mock.NewQueryMatcher(
+					struct {
+						Organization struct {
+							ProjectV2 struct {
+								ID githubv4.ID
+							} `graphql:"projectV2(number: $projectNumber)"`
+						} `graphql:"organization(login: $owner)"`
+					}{},
+					map[string]any{
+						"owner":         githubv4.String("octo-org"),
+						"projectNumber": githubv4.Int(1),
+					},
+					githubv4mock.DataResponse(map[string]any{
+						"organization": map[string]any{
+							"projectV2": map[string]any{"id": "PVT_project1"},
+						},
+					}),
+				),
+				githubv4mock.NewMutationMatcher(
+					unarchiveMutation{},
+					githubv4.UnarchiveProjectV2ItemInput{
+						ProjectID: githubv4.ID("PVT_project1"),
+						ItemID:    githubv4.ID("PVTI_item1001"),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"unarchiveProjectV2Item": map[string]any{
+							"item": map[string]any{"id": "PVTI_item1001", "isArchived": false},
+						},
+					}),
+				),
+			),
+			expectedArchived: false,
+		},
+		{
+			name:   "item not found",
+			method: "archive_project_item",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetOrgsProjectsV2ItemsByProjectByItemID: mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			}),
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			expectedErrMsg: ProjectArchiveFailedError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{
+				Client:    gh.NewClient(tc.mockedClient),
+				GQLClient: githubv4.NewClient(tc.gqlClient),
+			}
+			handler := toolDef.Handler(deps)
+			request := createMCPRequest(map[string]any{
+				"method":         tc.method,
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(1),
+				"item_id":        float64(1001),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			var response map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, float64(1001), response["id"])
+			assert.Equal(t, "PVTI_item1001", response["node_id"])
+			assert.Equal(t, tc.expectedArchived, response["archived"])
+		})
+	}
+}
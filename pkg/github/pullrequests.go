@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/google/go-github/v82/github"
@@ -629,6 +631,225 @@ func CreatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 		})
 }
 
+// CreatePullRequestWithChanges creates a tool that creates a branch, commits a set of file
+// changes to it and opens a pull request in a single call.
+func CreatePullRequestWithChanges(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "create_pull_request_with_changes",
+			Description: t("TOOL_CREATE_PULL_REQUEST_WITH_CHANGES_DESCRIPTION", "Create a new branch from a base branch, commit the given file changes to it in a single commit, and open a pull request. If opening the pull request fails, the new branch is deleted again."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_PULL_REQUEST_WITH_CHANGES_USER_TITLE", "Open pull request with file changes"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"baseBranch": {
+						Type:        "string",
+						Description: "Branch to branch off from and merge into",
+					},
+					"headBranch": {
+						Type:        "string",
+						Description: "Name of the new branch to create for the changes. Must not already exist.",
+					},
+					"message": {
+						Type:        "string",
+						Description: "Commit message",
+					},
+					"files": {
+						Type:        "array",
+						Description: "Array of file objects to commit, each object with path (string) and content (string)",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"path": {
+									Type:        "string",
+									Description: "path to the file",
+								},
+								"content": {
+									Type:        "string",
+									Description: "file content",
+								},
+							},
+							Required: []string{"path", "content"},
+						},
+					},
+					"title": {
+						Type:        "string",
+						Description: "PR title",
+					},
+					"body": {
+						Type:        "string",
+						Description: "PR description",
+					},
+					"draft": {
+						Type:        "boolean",
+						Description: "Create as draft PR",
+					},
+				},
+				Required: []string{"owner", "repo", "baseBranch", "headBranch", "message", "files", "title"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			baseBranch, err := RequiredParam[string](args, "baseBranch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			headBranch, err := RequiredParam[string](args, "headBranch")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			message, err := RequiredParam[string](args, "message")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			title, err := RequiredParam[string](args, "title")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			body, err := OptionalParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			draft, err := OptionalParam[bool](args, "draft")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			filesObj, ok := args["files"].([]any)
+			if !ok || len(filesObj) == 0 {
+				return utils.NewToolResultError("files parameter must be a non-empty array of objects with path and content"), nil, nil
+			}
+			entries, err := treeEntriesFromFiles(filesObj)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Resolve the base branch head and its tree
+			baseRef, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+baseBranch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get base branch reference",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			baseCommit, resp, err := client.Git.GetCommit(ctx, owner, repo, baseRef.GetObject().GetSHA())
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get base commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			// Build the commit first. Unreferenced trees and commits are harmless, so nothing
+			// needs to be rolled back if any of these steps fail.
+			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, baseCommit.GetTree().GetSHA(), entries)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create tree",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, github.Commit{
+				Message: github.Ptr(message),
+				Tree:    newTree,
+				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
+			}, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to create commit",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			// Create the head branch directly at the new commit
+			headRef, resp, err := client.Git.CreateRef(ctx, owner, repo, github.CreateRef{
+				Ref: "refs/heads/" + headBranch,
+				SHA: newCommit.GetSHA(),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create branch %s", headBranch),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			pr, resp, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+				Title: github.Ptr(title),
+				Head:  github.Ptr(headBranch),
+				Base:  github.Ptr(baseBranch),
+				Body:  github.Ptr(body),
+				Draft: github.Ptr(draft),
+			})
+			if err != nil {
+				// Roll back the branch so a retry can reuse the same name
+				rollbackNote := fmt.Sprintf("branch %s was deleted", headBranch)
+				delResp, delErr := client.Git.DeleteRef(ctx, owner, repo, headRef.GetRef())
+				if delResp != nil && delResp.Body != nil {
+					_ = delResp.Body.Close()
+				}
+				if delErr != nil {
+					rollbackNote = fmt.Sprintf("branch %s could not be deleted and must be cleaned up manually: %v", headBranch, delErr)
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create pull request (%s)", rollbackNote),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalResponse := MinimalResponse{
+				ID:  fmt.Sprintf("%d", pr.GetID()),
+				URL: pr.GetHTMLURL(),
+			}
+
+			r, err := json.Marshal(minimalResponse)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
 // UpdatePullRequest creates a tool to update an existing pull request.
 func UpdatePullRequest(t translations.TranslationHelperFunc) inventory.ServerTool {
 	schema := &jsonschema.Schema{
@@ -799,62 +1020,8 @@ func UpdatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 					return utils.NewToolResultErrorFromErr("failed to get GitHub GraphQL client", err), nil, nil
 				}
 
-				var prQuery struct {
-					Repository struct {
-						PullRequest struct {
-							ID      githubv4.ID
-							IsDraft githubv4.Boolean
-						} `graphql:"pullRequest(number: $prNum)"`
-					} `graphql:"repository(owner: $owner, name: $repo)"`
-				}
-
-				err = gqlClient.Query(ctx, &prQuery, map[string]any{
-					"owner": githubv4.String(owner),
-					"repo":  githubv4.String(repo),
-					"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
-				})
-				if err != nil {
-					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err), nil, nil
-				}
-
-				currentIsDraft := bool(prQuery.Repository.PullRequest.IsDraft)
-
-				if currentIsDraft != draftValue {
-					if draftValue {
-						// Convert to draft
-						var mutation struct {
-							ConvertPullRequestToDraft struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"convertPullRequestToDraft(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err), nil, nil
-						}
-					} else {
-						// Mark as ready for review
-						var mutation struct {
-							MarkPullRequestReadyForReview struct {
-								PullRequest struct {
-									ID      githubv4.ID
-									IsDraft githubv4.Boolean
-								}
-							} `graphql:"markPullRequestReadyForReview(input: $input)"`
-						}
-
-						err = gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
-							PullRequestID: prQuery.Repository.PullRequest.ID,
-						}, nil)
-						if err != nil {
-							return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err), nil, nil
-						}
-					}
+				if _, errResult := setPullRequestDraft(ctx, gqlClient, owner, repo, pullNumber, draftValue); errResult != nil {
+					return errResult, nil, nil
 				}
 			}
 
@@ -914,9 +1081,435 @@ func UpdatePullRequest(t translations.TranslationHelperFunc) inventory.ServerToo
 				URL: finalPR.GetHTMLURL(),
 			}
 
-			r, err := json.Marshal(minimalResponse)
+			r, err := json.Marshal(minimalResponse)
+			if err != nil {
+				return utils.NewToolResultErrorFromErr("Failed to marshal response", err), nil, nil
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		})
+}
+
+// setPullRequestDraft converts a pull request to a draft or marks it ready for review,
+// unless it is already in that state, and returns its resulting draft flag.
+func setPullRequestDraft(ctx context.Context, gqlClient *githubv4.Client, owner, repo string, pullNumber int, draft bool) (bool, *mcp.CallToolResult) {
+	var prQuery struct {
+		Repository struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			} `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	err := gqlClient.Query(ctx, &prQuery, map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+	})
+	if err != nil {
+		return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to find pull request", err)
+	}
+
+	if bool(prQuery.Repository.PullRequest.IsDraft) == draft {
+		return draft, nil
+	}
+
+	if draft {
+		// Convert to draft
+		var mutation struct {
+			ConvertPullRequestToDraft struct {
+				PullRequest struct {
+					ID      githubv4.ID
+					IsDraft githubv4.Boolean
+				}
+			} `graphql:"convertPullRequestToDraft(input: $input)"`
+		}
+
+		err = gqlClient.Mutate(ctx, &mutation, githubv4.ConvertPullRequestToDraftInput{
+			PullRequestID: prQuery.Repository.PullRequest.ID,
+		}, nil)
+		if err != nil {
+			return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to convert pull request to draft", err)
+		}
+		return bool(mutation.ConvertPullRequestToDraft.PullRequest.IsDraft), nil
+	}
+
+	// Mark as ready for review
+	var mutation struct {
+		MarkPullRequestReadyForReview struct {
+			PullRequest struct {
+				ID      githubv4.ID
+				IsDraft githubv4.Boolean
+			}
+		} `graphql:"markPullRequestReadyForReview(input: $input)"`
+	}
+
+	err = gqlClient.Mutate(ctx, &mutation, githubv4.MarkPullRequestReadyForReviewInput{
+		PullRequestID: prQuery.Repository.PullRequest.ID,
+	}, nil)
+	if err != nil {
+		return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "Failed to mark pull request ready for review", err)
+	}
+	return bool(mutation.MarkPullRequestReadyForReview.PullRequest.IsDraft), nil
+}
+
+// pullRequestStateResult is the response returned by manage_pull_request_state.
+type pullRequestStateResult struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Merged bool   `json:"merged"`
+	URL    string `json:"url"`
+}
+
+// ManagePullRequestState creates a tool to close or reopen a pull request.
+func ManagePullRequestState(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "manage_pull_request_state",
+			Description: t("TOOL_MANAGE_PULL_REQUEST_STATE_DESCRIPTION", "Close or reopen a pull request in a GitHub repository. Merged pull requests cannot be reopened."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_PULL_REQUEST_STATE_USER_TITLE", "Close or reopen pull request"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to close or reopen the pull request",
+						Enum:        []any{"close", "reopen"},
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var state string
+			switch action {
+			case "close":
+				state = "closed"
+			case "reopen":
+				state = "open"
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid action %q: must be close or reopen", action)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get pull request",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			if action == "reopen" && pr.GetMerged() {
+				return utils.NewToolResultError(fmt.Sprintf("cannot reopen pull request #%d: it has already been merged", pullNumber)), nil, nil
+			}
+
+			if pr.GetState() != state {
+				pr, resp, err = client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{
+					State: github.Ptr(state),
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to %s pull request", action),
+						resp,
+						err,
+					), nil, nil
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				if resp.StatusCode != http.StatusOK {
+					bodyBytes, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to %s pull request", action), resp, bodyBytes), nil, nil
+				}
+			}
+
+			r, err := json.Marshal(pullRequestStateResult{
+				Number: pr.GetNumber(),
+				State:  pr.GetState(),
+				Merged: pr.GetMerged(),
+				URL:    pr.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		})
+}
+
+// pullRequestDraftResult is the response returned by manage_pull_request_draft.
+type pullRequestDraftResult struct {
+	Number int  `json:"number"`
+	Draft  bool `json:"draft"`
+}
+
+// ManagePullRequestDraft creates a tool to convert a pull request to a draft or mark it ready for review.
+func ManagePullRequestDraft(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "manage_pull_request_draft",
+			Description: t("TOOL_MANAGE_PULL_REQUEST_DRAFT_DESCRIPTION", "Convert a pull request in a GitHub repository to a draft, or mark a draft pull request as ready for review."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_PULL_REQUEST_DRAFT_USER_TITLE", "Convert pull request to draft or mark ready for review"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to convert the pull request to a draft or mark it ready for review",
+						Enum:        []any{"convert_to_draft", "mark_ready"},
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			var draft bool
+			switch action {
+			case "convert_to_draft":
+				draft = true
+			case "mark_ready":
+				draft = false
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid action %q: must be convert_to_draft or mark_ready", action)), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			isDraft, errResult := setPullRequestDraft(ctx, gqlClient, owner, repo, pullNumber, draft)
+			if errResult != nil {
+				return errResult, nil, nil
+			}
+
+			r, err := json.Marshal(pullRequestDraftResult{
+				Number: pullNumber,
+				Draft:  isDraft,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		})
+}
+
+// requestedReviewersResult is the response returned by manage_pull_request_reviewers.
+type requestedReviewersResult struct {
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"team_reviewers"`
+}
+
+// ManagePullRequestReviewers creates a tool to request or remove human and team reviewers on a pull request.
+func ManagePullRequestReviewers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "manage_pull_request_reviewers",
+			Description: t("TOOL_MANAGE_PULL_REQUEST_REVIEWERS_DESCRIPTION", "Request reviews from users and teams on a pull request, or remove pending review requests. Returns the pull request's resulting set of requested reviewers. To request a review from Copilot, use request_copilot_review instead."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_PULL_REQUEST_REVIEWERS_USER_TITLE", "Manage pull request reviewers"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to add or remove the given review requests",
+						Enum:        []any{"add", "remove"},
+					},
+					"reviewers": {
+						Type:        "array",
+						Description: "GitHub usernames to add or remove as reviewers",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+					"teamReviewers": {
+						Type:        "array",
+						Description: "Team slugs to add or remove as reviewers",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if action != "add" && action != "remove" {
+				return utils.NewToolResultError(fmt.Sprintf("invalid action %q: must be add or remove", action)), nil, nil
+			}
+			reviewers, err := OptionalStringArrayParam(args, "reviewers")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			teamReviewers, err := OptionalStringArrayParam(args, "teamReviewers")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if len(reviewers) == 0 && len(teamReviewers) == 0 {
+				return utils.NewToolResultError("at least one of reviewers or teamReviewers must be provided"), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			request := github.ReviewersRequest{
+				Reviewers:     reviewers,
+				TeamReviewers: teamReviewers,
+			}
+
+			var resp *github.Response
+			if action == "add" {
+				_, resp, err = client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, request)
+			} else {
+				resp, err = client.PullRequests.RemoveReviewers(ctx, owner, repo, pullNumber, request)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to %s reviewers", action),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+
+			requested, resp, err := client.PullRequests.ListReviewers(ctx, owner, repo, pullNumber, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list requested reviewers",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := requestedReviewersResult{
+				Reviewers:     make([]string, 0, len(requested.Users)),
+				TeamReviewers: make([]string, 0, len(requested.Teams)),
+			}
+			for _, user := range requested.Users {
+				result.Reviewers = append(result.Reviewers, user.GetLogin())
+			}
+			for _, team := range requested.Teams {
+				result.TeamReviewers = append(result.TeamReviewers, team.GetSlug())
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
-				return utils.NewToolResultErrorFromErr("Failed to marshal response", err), nil, nil
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
 			return utils.NewToolResultText(string(r)), nil, nil
@@ -1014,6 +1607,98 @@ func AddReplyToPullRequestComment(t translations.TranslationHelperFunc) inventor
 		})
 }
 
+// ListPullRequestReviewComments creates a tool to list the review comments on a pull request.
+func ListPullRequestReviewComments(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "list_pull_request_review_comments",
+			Description: t("TOOL_LIST_PULL_REQUEST_REVIEW_COMMENTS_DESCRIPTION", "List the review comments on a pull request as a flat list. Each comment includes its file path, line, diff hunk, author and in_reply_to_id, so review threads can be reconstructed by following in_reply_to_id back to the first comment."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_PULL_REQUEST_REVIEW_COMMENTS_USER_TITLE", "List pull request review comments"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.PullRequestListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request review comments",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list pull request review comments", resp, body), nil, nil
+			}
+
+			minimalComments := make([]MinimalPullRequestReviewComment, 0, len(comments))
+			for _, comment := range comments {
+				minimalComments = append(minimalComments, convertToMinimalPullRequestReviewComment(comment))
+			}
+
+			r, err := json.Marshal(minimalComments)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		})
+}
+
 // ListPullRequests creates a tool to list and filter repository pull requests.
 func ListPullRequests(t translations.TranslationHelperFunc) inventory.ServerTool {
 	schema := &jsonschema.Schema{
@@ -1157,6 +1842,113 @@ func ListPullRequests(t translations.TranslationHelperFunc) inventory.ServerTool
 		})
 }
 
+// MinimalPullRequestCommit is the trimmed output type for the commits of a pull request.
+type MinimalPullRequestCommit struct {
+	SHA                string               `json:"sha"`
+	Message            string               `json:"message"`
+	Author             *MinimalCommitAuthor `json:"author,omitempty"`
+	AuthorLogin        string               `json:"author_login,omitempty"`
+	Verified           bool                 `json:"verified"`
+	VerificationReason string               `json:"verification_reason,omitempty"`
+	HTMLURL            string               `json:"html_url"`
+}
+
+// ListPullRequestCommits creates a tool to list the commits of a pull request.
+func ListPullRequestCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+	WithPagination(schema)
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "list_pull_request_commits",
+			Description: t("TOOL_LIST_PULL_REQUEST_COMMITS_DESCRIPTION", "List the commits of a pull request, oldest first, with their SHA, message, author and signature verification status. Unlike list_commits, which lists the history of a branch, this only returns the commits the pull request adds. At most 250 commits are listed."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_PULL_REQUEST_COMMITS_USER_TITLE", "List pull request commits"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			commits, resp, err := client.PullRequests.ListCommits(ctx, owner, repo, pullNumber, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list pull request commits",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list pull request commits", resp, body), nil, nil
+			}
+
+			result := make([]MinimalPullRequestCommit, 0, len(commits))
+			for _, commit := range commits {
+				verification := commit.GetCommit().GetVerification()
+				result = append(result, MinimalPullRequestCommit{
+					SHA:                commit.GetSHA(),
+					Message:            commit.GetCommit().GetMessage(),
+					Author:             convertToMinimalCommitAuthor(commit.GetCommit().GetAuthor()),
+					AuthorLogin:        commit.GetAuthor().GetLogin(),
+					Verified:           verification.GetVerified(),
+					VerificationReason: verification.GetReason(),
+					HTMLURL:            commit.GetHTMLURL(),
+				})
+			}
+
+			return MarshalledTextResult(result), nil, nil
+		})
+}
+
 // MergePullRequest creates a tool to merge a pull request.
 func MergePullRequest(t translations.TranslationHelperFunc) inventory.ServerTool {
 	schema := &jsonschema.Schema{
@@ -1305,12 +2097,13 @@ func SearchPullRequests(t translations.TranslationHelperFunc) inventory.ServerTo
 				Description: "Sort order",
 				Enum:        []any{"asc", "desc"},
 			},
+			"fields": searchFieldsSchema(),
 		},
 		Required: []string{"query"},
 	}
 	WithPagination(schema)
 
-	return NewTool(
+	tool := NewTool(
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "search_pull_requests",
@@ -1326,6 +2119,19 @@ func SearchPullRequests(t translations.TranslationHelperFunc) inventory.ServerTo
 			result, err := searchHandler(ctx, deps.GetClient, args, "pr", "failed to search pull requests")
 			return result, nil, err
 		})
+	tool.SearchQueryArgument = "query"
+	return tool
+}
+
+// pullRequestBranchUpdateResult is the response returned by update_pull_request_branch,
+// and appended to its error message when GitHub refuses to update the branch.
+type pullRequestBranchUpdateResult struct {
+	// Status is updating, head_changed, conflicts or not_updated.
+	Status         string `json:"status"`
+	Message        string `json:"message"`
+	HeadSHA        string `json:"head_sha,omitempty"`
+	MergeableState string `json:"mergeable_state,omitempty"`
+	Suggestion     string `json:"suggestion,omitempty"`
 }
 
 // UpdatePullRequestBranch creates a tool to update a pull request branch with the latest changes from the base branch.
@@ -1347,7 +2153,7 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 			},
 			"expectedHeadSha": {
 				Type:        "string",
-				Description: "The expected SHA of the pull request's HEAD ref",
+				Description: "The expected SHA of the pull request's HEAD ref. The update is refused if the branch has moved, e.g. because of a concurrent push",
 			},
 		},
 		Required: []string{"owner", "repo", "pullNumber"},
@@ -1357,7 +2163,7 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 		ToolsetMetadataPullRequests,
 		mcp.Tool{
 			Name:        "update_pull_request_branch",
-			Description: t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch."),
+			Description: t("TOOL_UPDATE_PULL_REQUEST_BRANCH_DESCRIPTION", "Update the branch of a pull request with the latest changes from the base branch. Reports whether the update is proceeding cleanly, or why it could not be made (merge conflicts, or the head moved past expectedHeadSha) with the pull request's mergeable_state and a suggested next step."),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_UPDATE_PULL_REQUEST_BRANCH_USER_TITLE", "Update pull request branch"),
 				ReadOnlyHint: false,
@@ -1396,13 +2202,26 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
 				// and it's not a real error.
 				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return utils.NewToolResultText("Pull request branch update is in progress"), nil, nil
+					return MarshalledTextResult(pullRequestBranchUpdateResult{
+						Status:  "updating",
+						Message: "Pull request branch update is in progress: the base branch merges into it without conflicts",
+					}), nil, nil
 				}
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				errResult := ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to update pull request branch",
 					resp,
 					err,
-				), nil, nil
+				)
+				// GitHub refuses the update with 422 when the head moved or the merge conflicts
+				if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+					report := explainPullRequestBranchUpdateFailure(ctx, client, owner, repo, pullNumber, err)
+					if data, err := json.Marshal(report); err == nil {
+						if text, ok := errResult.Content[0].(*mcp.TextContent); ok {
+							text.Text += "\n\n" + string(data)
+						}
+					}
+				}
+				return errResult, nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -1414,15 +2233,46 @@ func UpdatePullRequestBranch(t translations.TranslationHelperFunc) inventory.Ser
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to update pull request branch", resp, bodyBytes), nil, nil
 			}
 
-			r, err := json.Marshal(result)
-			if err != nil {
-				return utils.NewToolResultErrorFromErr("failed to marshal response", err), nil, nil
-			}
-
-			return utils.NewToolResultText(string(r)), nil, nil
+			return MarshalledTextResult(pullRequestBranchUpdateResult{
+				Status:  "updating",
+				Message: result.GetMessage(),
+			}), nil, nil
 		})
 }
 
+// explainPullRequestBranchUpdateFailure describes why GitHub refused to update a pull
+// request branch, using the pull request's current head and mergeable state.
+func explainPullRequestBranchUpdateFailure(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, updateErr error) pullRequestBranchUpdateResult {
+	report := pullRequestBranchUpdateResult{
+		Status:  "not_updated",
+		Message: updateErr.Error(),
+	}
+	var ghErr *github.ErrorResponse
+	if errors.As(updateErr, &ghErr) && ghErr.Message != "" {
+		report.Message = ghErr.Message
+	}
+
+	pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+	if err == nil {
+		_ = resp.Body.Close()
+		report.HeadSHA = pr.GetHead().GetSHA()
+		report.MergeableState = pr.GetMergeableState()
+	}
+
+	message := strings.ToLower(report.Message)
+	switch {
+	case strings.Contains(message, "expected head sha"):
+		report.Status = "head_changed"
+		report.Suggestion = "The pull request branch changed since expectedHeadSha was read, e.g. because of a concurrent push. Review the new head commit, then retry with expectedHeadSha set to head_sha."
+	case strings.Contains(message, "conflict") || report.MergeableState == "dirty":
+		report.Status = "conflicts"
+		report.Suggestion = "The base branch cannot be merged into the pull request branch without conflicts. Resolve them by merging or rebasing the base branch locally and pushing, then check the pull request with get_pull_request_merge_status."
+	default:
+		report.Suggestion = "Check the pull request with get_pull_request_merge_status before retrying."
+	}
+	return report
+}
+
 type PullRequestReviewWriteParams struct {
 	Method     string
 	Owner      string
@@ -1743,6 +2593,149 @@ func DeletePendingPullRequestReview(ctx context.Context, client *githubv4.Client
 	return utils.NewToolResultText("pending pull request review successfully deleted"), nil
 }
 
+// SubmitPullRequestReview creates a tool to submit a pending pull request review with an event and summary body.
+func SubmitPullRequestReview(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "submit_pull_request_review",
+			Description: t("TOOL_SUBMIT_PULL_REQUEST_REVIEW_DESCRIPTION", "Submit a pending pull request review with an event (APPROVE, REQUEST_CHANGES or COMMENT) and an optional summary body. If reviewId is omitted, the current user's pending review on the pull request is submitted."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_SUBMIT_PULL_REQUEST_REVIEW_USER_TITLE", "Submit pending pull request review"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"pullNumber": {
+						Type:        "number",
+						Description: "Pull request number",
+					},
+					"reviewId": {
+						Type:        "number",
+						Description: "ID of the pending review to submit. Defaults to the current user's pending review.",
+					},
+					"event": {
+						Type:        "string",
+						Description: "Review action to perform",
+						Enum:        []any{"APPROVE", "REQUEST_CHANGES", "COMMENT"},
+					},
+					"body": {
+						Type:        "string",
+						Description: "Summary text of the review",
+					},
+				},
+				Required: []string{"owner", "repo", "pullNumber", "event"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			reviewID, err := OptionalIntParam(args, "reviewId")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			event, err := RequiredParam[string](args, "event")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			switch event {
+			case "APPROVE", "REQUEST_CHANGES", "COMMENT":
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid event %q: must be APPROVE, REQUEST_CHANGES or COMMENT", event)), nil, nil
+			}
+			body, err := OptionalParam[string](args, "body")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			id := int64(reviewID)
+			if id == 0 {
+				// Pending reviews are only visible to their author, so the pending review in
+				// this list belongs to the current user. Page through until it is found.
+				opts := &github.ListOptions{PerPage: 100}
+				for {
+					reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							"failed to list pull request reviews",
+							resp,
+							err,
+						), nil, nil
+					}
+					_ = resp.Body.Close()
+
+					for _, review := range reviews {
+						if review.GetState() == "PENDING" {
+							id = review.GetID()
+							break
+						}
+					}
+					if id != 0 || resp.NextPage == 0 {
+						break
+					}
+					opts.Page = resp.NextPage
+				}
+				if id == 0 {
+					return utils.NewToolResultError(fmt.Sprintf("no pending review found for the current user on pull request #%d", pullNumber)), nil, nil
+				}
+			}
+
+			request := &github.PullRequestReviewRequest{
+				Event: github.Ptr(event),
+			}
+			if body != "" {
+				request.Body = github.Ptr(body)
+			}
+
+			review, resp, err := client.PullRequests.SubmitReview(ctx, owner, repo, pullNumber, id, request)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to submit pull request review",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalResponse := MinimalResponse{
+				ID:  fmt.Sprintf("%d", review.GetID()),
+				URL: review.GetHTMLURL(),
+			}
+
+			r, err := json.Marshal(minimalResponse)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		})
+}
+
 // AddCommentToPendingReview creates a tool to add a comment to a pull request review.
 func AddCommentToPendingReview(t translations.TranslationHelperFunc) inventory.ServerTool {
 	schema := &jsonschema.Schema{
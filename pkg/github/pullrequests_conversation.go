@@ -0,0 +1,245 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/sanitize"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxPullRequestConversationItems caps the number of items get_pull_request_conversation
+// returns, and the number of items of each type it fetches.
+const maxPullRequestConversationItems = 200
+
+// Types of PullRequestConversationItem.
+const (
+	conversationItemIssueComment  = "issue_comment"
+	conversationItemReview        = "review"
+	conversationItemReviewComment = "review_comment"
+)
+
+// PullRequestConversationItem is a comment, review or review comment in the
+// conversation of a pull request.
+type PullRequestConversationItem struct {
+	Type      string `json:"type"`
+	ID        int64  `json:"id"`
+	Author    string `json:"author"`
+	Body      string `json:"body,omitempty"`
+	CreatedAt string `json:"created_at"`
+	URL       string `json:"url,omitempty"`
+	// ReviewState is set on reviews, e.g. APPROVED or CHANGES_REQUESTED.
+	ReviewState string `json:"review_state,omitempty"`
+	// ReviewID, Path, Line and InReplyToID locate review comments.
+	ReviewID    int64  `json:"review_id,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	InReplyToID int64  `json:"in_reply_to_id,omitempty"`
+
+	createdAt time.Time
+}
+
+// PullRequestConversation is the output of get_pull_request_conversation.
+type PullRequestConversation struct {
+	Items []PullRequestConversationItem `json:"items"`
+	// Truncated is set when later items were left out to respect the item cap.
+	Truncated bool `json:"truncated"`
+}
+
+// GetPullRequestConversation creates a tool to get the comments, reviews and review
+// comments of a pull request as a single thread.
+func GetPullRequestConversation(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "get_pull_request_conversation",
+			Description: t("TOOL_GET_PULL_REQUEST_CONVERSATION_DESCRIPTION", fmt.Sprintf("Get the whole conversation of a pull request as a single thread in chronological order: comments, review summaries and review comments on the diff, each with its type, author, body and, for review comments, file path and line. At most the first %d items are returned; truncated is set when there are more.", maxPullRequestConversationItems)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_PULL_REQUEST_CONVERSATION_USER_TITLE", "Get pull request conversation"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var items []PullRequestConversationItem
+
+			comments, resp, err := listUpTo(maxPullRequestConversationItems, func(opts github.ListOptions) ([]*github.IssueComment, *github.Response, error) {
+				return client.Issues.ListComments(ctx, owner, repo, pullNumber, &github.IssueListCommentsOptions{ListOptions: opts})
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request comments", resp, err), nil, nil
+			}
+			for _, comment := range comments {
+				items = append(items, PullRequestConversationItem{
+					Type:      conversationItemIssueComment,
+					ID:        comment.GetID(),
+					Author:    comment.GetUser().GetLogin(),
+					Body:      comment.GetBody(),
+					URL:       comment.GetHTMLURL(),
+					createdAt: comment.GetCreatedAt().Time,
+				})
+			}
+
+			reviews, resp, err := listUpTo(maxPullRequestConversationItems, func(opts github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+				return client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, &opts)
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request reviews", resp, err), nil, nil
+			}
+			for _, review := range reviews {
+				// Pending reviews are unsubmitted drafts
+				if review.GetState() == "PENDING" {
+					continue
+				}
+				items = append(items, PullRequestConversationItem{
+					Type:        conversationItemReview,
+					ID:          review.GetID(),
+					Author:      review.GetUser().GetLogin(),
+					Body:        review.GetBody(),
+					URL:         review.GetHTMLURL(),
+					ReviewState: review.GetState(),
+					createdAt:   review.GetSubmittedAt().Time,
+				})
+			}
+
+			reviewComments, resp, err := listUpTo(maxPullRequestConversationItems, func(opts github.ListOptions) ([]*github.PullRequestComment, *github.Response, error) {
+				return client.PullRequests.ListComments(ctx, owner, repo, pullNumber, &github.PullRequestListCommentsOptions{ListOptions: opts})
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request review comments", resp, err), nil, nil
+			}
+			for _, comment := range reviewComments {
+				items = append(items, PullRequestConversationItem{
+					Type:        conversationItemReviewComment,
+					ID:          comment.GetID(),
+					Author:      comment.GetUser().GetLogin(),
+					Body:        comment.GetBody(),
+					URL:         comment.GetHTMLURL(),
+					ReviewID:    comment.GetPullRequestReviewID(),
+					Path:        comment.GetPath(),
+					Line:        comment.GetLine(),
+					InReplyToID: comment.GetInReplyTo(),
+					createdAt:   comment.GetCreatedAt().Time,
+				})
+			}
+
+			if deps.GetFlags(ctx).LockdownMode {
+				cache, err := deps.GetRepoAccessCache(ctx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get repo access cache: %w", err)
+				}
+				if cache == nil {
+					return nil, nil, fmt.Errorf("lockdown cache is not configured")
+				}
+				filtered := make([]PullRequestConversationItem, 0, len(items))
+				for _, item := range items {
+					if item.Author == "" {
+						continue
+					}
+					isSafeContent, err := cache.IsSafeContent(ctx, item.Author, owner, repo)
+					if err != nil {
+						return nil, nil, fmt.Errorf("failed to check lockdown mode: %w", err)
+					}
+					if isSafeContent {
+						filtered = append(filtered, item)
+					}
+				}
+				items = filtered
+			}
+
+			slices.SortStableFunc(items, func(a, b PullRequestConversationItem) int {
+				return a.createdAt.Compare(b.createdAt)
+			})
+
+			// Each type is fetched oldest first, so the oldest items of the merged
+			// thread are complete even when a type has more than the cap
+			conversation := PullRequestConversation{
+				Items: make([]PullRequestConversationItem, 0, min(len(items), maxPullRequestConversationItems)),
+				Truncated: len(items) > maxPullRequestConversationItems ||
+					len(comments) == maxPullRequestConversationItems ||
+					len(reviews) == maxPullRequestConversationItems ||
+					len(reviewComments) == maxPullRequestConversationItems,
+			}
+			if len(items) > maxPullRequestConversationItems {
+				items = items[:maxPullRequestConversationItems]
+			}
+			for _, item := range items {
+				item.Body = sanitize.Sanitize(item.Body)
+				if !item.createdAt.IsZero() {
+					item.CreatedAt = item.createdAt.Format(time.RFC3339)
+				}
+				conversation.Items = append(conversation.Items, item)
+			}
+
+			return MarshalledTextResult(conversation), nil, nil
+		})
+}
+
+// listUpTo calls list with increasing pages of up to 100 items until it has limit
+// items or there are no more pages.
+func listUpTo[T any](limit int, list func(opts github.ListOptions) ([]T, *github.Response, error)) ([]T, *github.Response, error) {
+	var all []T
+	opts := github.ListOptions{PerPage: min(limit, 100)}
+	for {
+		page, resp, err := list(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, page...)
+		if len(all) >= limit || resp.NextPage == 0 {
+			if len(all) > limit {
+				all = all[:limit]
+			}
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
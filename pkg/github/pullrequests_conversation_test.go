@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPullRequestConversation(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetPullRequestConversation(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_pull_request_conversation", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	at := func(minute int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2026, 3, 1, 10, minute, 0, 0, time.UTC)}
+	}
+
+	issueComments := []*github.IssueComment{
+		{ID: github.Ptr(int64(1)), User: &github.User{Login: github.Ptr("author")}, Body: github.Ptr("Ready for review"), CreatedAt: at(0)},
+		{ID: github.Ptr(int64(2)), User: &github.User{Login: github.Ptr("author")}, Body: github.Ptr("Addressed the feedback"), CreatedAt: at(30)},
+	}
+	reviews := []*github.PullRequestReview{
+		{ID: github.Ptr(int64(10)), User: &github.User{Login: github.Ptr("reviewer")}, Body: github.Ptr("A few nits"), State: github.Ptr("CHANGES_REQUESTED"), SubmittedAt: at(10)},
+		{ID: github.Ptr(int64(11)), User: &github.User{Login: github.Ptr("reviewer")}, Body: github.Ptr("LGTM"), State: github.Ptr("APPROVED"), SubmittedAt: at(40)},
+		{ID: github.Ptr(int64(12)), User: &github.User{Login: github.Ptr("someone")}, State: github.Ptr("PENDING")},
+	}
+	reviewComments := []*github.PullRequestComment{
+		{ID: github.Ptr(int64(100)), PullRequestReviewID: github.Ptr(int64(10)), User: &github.User{Login: github.Ptr("reviewer")}, Body: github.Ptr("Rename this"), Path: github.Ptr("main.go"), Line: github.Ptr(12), CreatedAt: at(9)},
+		{ID: github.Ptr(int64(101)), PullRequestReviewID: github.Ptr(int64(13)), InReplyTo: github.Ptr(int64(100)), User: &github.User{Login: github.Ptr("author")}, Body: github.Ptr("Done"), Path: github.Ptr("main.go"), Line: github.Ptr(12), CreatedAt: at(20)},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "interleaves all comment types by time",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: expectQueryParams(t, map[string]string{
+					"per_page": "100",
+				}).andThen(mockResponse(t, http.StatusOK, issueComments)),
+				GetReposPullsReviewsByOwnerByRepoByPullNumber:  mockResponse(t, http.StatusOK, reviews),
+				GetReposPullsCommentsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, reviewComments),
+			}),
+		},
+		{
+			name: "review comments fail",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposIssuesCommentsByOwnerByRepoByIssueNumber: mockResponse(t, http.StatusOK, issueComments),
+				GetReposPullsReviewsByOwnerByRepoByPullNumber:    mockResponse(t, http.StatusOK, reviews),
+				GetReposPullsCommentsByOwnerByRepoByPullNumber:   mockResponse(t, http.StatusInternalServerError, `{"message": "Internal Server Error"}`),
+			}),
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request review comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var conversation PullRequestConversation
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &conversation))
+			assert.False(t, conversation.Truncated)
+
+			type entry struct {
+				Type string
+				ID   int64
+			}
+			var order []entry
+			for _, item := range conversation.Items {
+				order = append(order, entry{item.Type, item.ID})
+			}
+			assert.Equal(t, []entry{
+				{"issue_comment", 1},
+				{"review_comment", 100},
+				{"review", 10},
+				{"review_comment", 101},
+				{"issue_comment", 2},
+				{"review", 11},
+			}, order)
+
+			assert.Equal(t, PullRequestConversationItem{
+				Type:        "review_comment",
+				ID:          101,
+				Author:      "author",
+				Body:        "Done",
+				CreatedAt:   "2026-03-01T10:20:00Z",
+				ReviewID:    13,
+				Path:        "main.go",
+				Line:        12,
+				InReplyToID: 100,
+			}, conversation.Items[3])
+			assert.Equal(t, "CHANGES_REQUESTED", conversation.Items[2].ReviewState)
+			assert.Equal(t, "2026-03-01T10:10:00Z", conversation.Items[2].CreatedAt)
+		})
+	}
+}
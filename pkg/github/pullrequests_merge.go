@@ -0,0 +1,422 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// MinimalPullRequestMergeStatus is the output of get_pull_request_merge_status.
+type MinimalPullRequestMergeStatus struct {
+	Number int    `json:"number"`
+	State  string `json:"state"`
+	Draft  bool   `json:"draft"`
+	// Mergeable is null while GitHub is still computing mergeability.
+	Mergeable      *bool  `json:"mergeable"`
+	MergeableState string `json:"mergeable_state"`
+	// ReviewDecision is APPROVED, CHANGES_REQUESTED or REVIEW_REQUIRED, and empty
+	// when no review is required.
+	ReviewDecision string `json:"review_decision,omitempty"`
+	// ChecksState is the rollup of the head commit's checks and statuses, e.g.
+	// SUCCESS, PENDING or FAILURE, and empty when the commit has none.
+	ChecksState string `json:"checks_state,omitempty"`
+	BehindBy    int    `json:"behind_by"`
+	BehindBase  bool   `json:"behind_base"`
+	HeadSHA     string `json:"head_sha"`
+	BaseRef     string `json:"base_ref"`
+}
+
+// pullRequestMergeStatusQuery fetches the review and check status of a pull request,
+// which the REST API does not summarize.
+type pullRequestMergeStatusQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ReviewDecision githubv4.PullRequestReviewDecision
+			Commits        struct {
+				Nodes []struct {
+					Commit struct {
+						StatusCheckRollup *struct {
+							State githubv4.StatusState
+						}
+					}
+				}
+			} `graphql:"commits(last: 1)"`
+		} `graphql:"pullRequest(number: $prNum)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetPullRequestMergeStatus creates a tool to check whether a pull request can be merged.
+func GetPullRequestMergeStatus(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "get_pull_request_merge_status",
+			Description: t("TOOL_GET_PULL_REQUEST_MERGE_STATUS_DESCRIPTION", "Check whether a pull request can be merged before calling merge_pull_request. Returns mergeable, mergeable_state (clean, blocked, dirty, behind, unstable, ...), the review decision, the state of the head commit's checks, and how many commits the branch is behind its base. mergeable is null if GitHub is still computing it; call again shortly."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_PULL_REQUEST_MERGE_STATUS_USER_TITLE", "Get pull request merge status"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			// GitHub computes mergeability in the background after the pull request
+			// or its base changes, and reports null until it is done
+			pollConfig := getPollConfig(ctx)
+			var pr *github.PullRequest
+			for attempt := 0; ; attempt++ {
+				var errResult *mcp.CallToolResult
+				pr, errResult, err = getPullRequestForMergeStatus(ctx, client, owner, repo, pullNumber)
+				if errResult != nil || err != nil {
+					return errResult, nil, err
+				}
+				if pr.Mergeable != nil || attempt >= pollConfig.MaxAttempts {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					return nil, nil, ctx.Err()
+				case <-time.After(pollConfig.Delay):
+				}
+			}
+
+			status := MinimalPullRequestMergeStatus{
+				Number:         pr.GetNumber(),
+				State:          pr.GetState(),
+				Draft:          pr.GetDraft(),
+				Mergeable:      pr.Mergeable,
+				MergeableState: pr.GetMergeableState(),
+				HeadSHA:        pr.GetHead().GetSHA(),
+				BaseRef:        pr.GetBase().GetRef(),
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, status.BaseRef, status.HeadSHA, &github.ListOptions{PerPage: 1})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to compare pull request branch with base",
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			status.BehindBy = comparison.GetBehindBy()
+			status.BehindBase = status.BehindBy > 0
+
+			vars := map[string]any{
+				"owner": githubv4.String(owner),
+				"repo":  githubv4.String(repo),
+				"prNum": githubv4.Int(int32(pullNumber)), //nolint:gosec // pullNumber is controlled by user input validation
+			}
+			var query pullRequestMergeStatusQuery
+			if err := gqlClient.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx,
+					"failed to get pull request review and check status",
+					err,
+				), nil, nil
+			}
+			status.ReviewDecision = string(query.Repository.PullRequest.ReviewDecision)
+			if nodes := query.Repository.PullRequest.Commits.Nodes; len(nodes) > 0 && nodes[0].Commit.StatusCheckRollup != nil {
+				status.ChecksState = string(nodes[0].Commit.StatusCheckRollup.State)
+			}
+
+			return MarshalledTextResult(status), nil, nil
+		})
+}
+
+// getPullRequestForMergeStatus gets a pull request, returning an error result if the
+// request fails.
+func getPullRequestForMergeStatus(ctx context.Context, client *github.Client, owner, repo string, pullNumber int) (*github.PullRequest, *mcp.CallToolResult, error) {
+	pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx,
+			"failed to get pull request",
+			resp,
+			err,
+		), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return nil, ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get pull request", resp, body), nil
+	}
+	return pr, nil, nil
+}
+
+// MinimalAutoMergeState is the auto-merge state of a pull request, returned after
+// enabling or disabling auto-merge.
+type MinimalAutoMergeState struct {
+	Number      int    `json:"number"`
+	Enabled     bool   `json:"auto_merge_enabled"`
+	MergeMethod string `json:"merge_method,omitempty"`
+	EnabledBy   string `json:"enabled_by,omitempty"`
+	EnabledAt   string `json:"enabled_at,omitempty"`
+	URL         string `json:"url"`
+}
+
+// pullRequestAutoMergeFragment is the part of a pull request that describes its
+// auto-merge state.
+type pullRequestAutoMergeFragment struct {
+	Number           githubv4.Int
+	URL              githubv4.String
+	AutoMergeRequest *struct {
+		EnabledAt   githubv4.DateTime
+		MergeMethod githubv4.PullRequestMergeMethod
+		EnabledBy   struct {
+			Login githubv4.String
+		}
+	}
+}
+
+func convertToMinimalAutoMergeState(pr pullRequestAutoMergeFragment) MinimalAutoMergeState {
+	state := MinimalAutoMergeState{
+		Number: int(pr.Number),
+		URL:    string(pr.URL),
+	}
+	if req := pr.AutoMergeRequest; req != nil {
+		state.Enabled = true
+		state.MergeMethod = string(req.MergeMethod)
+		state.EnabledBy = string(req.EnabledBy.Login)
+		if !req.EnabledAt.IsZero() {
+			state.EnabledAt = req.EnabledAt.Format(time.RFC3339)
+		}
+	}
+	return state
+}
+
+// getPullRequestNodeID looks up the GraphQL node ID of a pull request, which
+// mutations take instead of its number.
+func getPullRequestNodeID(ctx context.Context, gqlClient *githubv4.Client, owner, repo string, pullNumber int) (githubv4.ID, error) {
+	var query struct {
+		Repository struct {
+			PullRequest struct {
+				ID githubv4.ID
+			} `graphql:"pullRequest(number: $prNum)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	err := gqlClient.Query(ctx, &query, map[string]any{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"prNum": githubv4.Int(int32(pullNumber)), //nolint:gosec // pullNumber is controlled by user input validation
+	})
+	if err != nil {
+		return nil, err
+	}
+	return query.Repository.PullRequest.ID, nil
+}
+
+// EnableAutoMerge creates a tool to enable auto-merge on a pull request.
+func EnableAutoMerge(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+			"mergeMethod": {
+				Type:        "string",
+				Description: "Merge method to use once the pull request can be merged. Defaults to merge",
+				Enum:        []any{"merge", "squash", "rebase"},
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "enable_pull_request_auto_merge",
+			Description: t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Enable auto-merge on a pull request, so that GitHub merges it as soon as its required reviews and checks pass. Use this instead of merge_pull_request when the pull request is not yet mergeable. Auto-merge must be allowed in the repository settings."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_USER_TITLE", "Enable pull request auto-merge"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			mergeMethod, err := OptionalParam[string](args, "mergeMethod")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			input := githubv4.EnablePullRequestAutoMergeInput{}
+			switch mergeMethod {
+			case "":
+			case "merge", "squash", "rebase":
+				input.MergeMethod = newGQLStringlike[githubv4.PullRequestMergeMethod](strings.ToUpper(mergeMethod))
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid mergeMethod %q: must be one of merge, squash or rebase", mergeMethod)), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			input.PullRequestID, err = getPullRequestNodeID(ctx, gqlClient, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find pull request", err), nil, nil
+			}
+
+			var mutation struct {
+				EnablePullRequestAutoMerge struct {
+					PullRequest pullRequestAutoMergeFragment
+				} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to enable auto-merge", err), nil, nil
+			}
+
+			return MarshalledTextResult(convertToMinimalAutoMergeState(mutation.EnablePullRequestAutoMerge.PullRequest)), nil, nil
+		})
+}
+
+// DisableAutoMerge creates a tool to disable auto-merge on a pull request.
+func DisableAutoMerge(t translations.TranslationHelperFunc) inventory.ServerTool {
+	schema := &jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"owner": {
+				Type:        "string",
+				Description: "Repository owner",
+			},
+			"repo": {
+				Type:        "string",
+				Description: "Repository name",
+			},
+			"pullNumber": {
+				Type:        "number",
+				Description: "Pull request number",
+			},
+		},
+		Required: []string{"owner", "repo", "pullNumber"},
+	}
+
+	return NewTool(
+		ToolsetMetadataPullRequests,
+		mcp.Tool{
+			Name:        "disable_pull_request_auto_merge",
+			Description: t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Disable auto-merge on a pull request, so that it is no longer merged automatically when its required reviews and checks pass."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_USER_TITLE", "Disable pull request auto-merge"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: schema,
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pullNumber, err := RequiredInt(args, "pullNumber")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			gqlClient, err := deps.GetGQLClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			prID, err := getPullRequestNodeID(ctx, gqlClient, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to find pull request", err), nil, nil
+			}
+
+			var mutation struct {
+				DisablePullRequestAutoMerge struct {
+					PullRequest pullRequestAutoMergeFragment
+				} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+			}
+			input := githubv4.DisablePullRequestAutoMergeInput{PullRequestID: prID}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to disable auto-merge", err), nil, nil
+			}
+
+			return MarshalledTextResult(convertToMinimalAutoMergeState(mutation.DisablePullRequestAutoMerge.PullRequest)), nil, nil
+		})
+}
@@ -0,0 +1,443 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/githubv4mock"
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPullRequestMergeStatus(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetPullRequestMergeStatus(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_pull_request_merge_status", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	computedPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		Mergeable:      github.Ptr(true),
+		MergeableState: github.Ptr("clean"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+	computingPR := &github.PullRequest{
+		Number:         github.Ptr(42),
+		State:          github.Ptr("open"),
+		MergeableState: github.Ptr("unknown"),
+		Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+		Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+
+	gqlStatus := func(reviewDecision, checksState string) *http.Client {
+		return githubv4mock.NewMockedHTTPClient(
+			githubv4mock.NewQueryMatcher(
+				pullRequestMergeStatusQuery{},
+				map[string]any{
+					"owner": githubv4.String("owner"),
+					"repo":  githubv4.String("repo"),
+					"prNum": githubv4.Int(42),
+				},
+				githubv4mock.DataResponse(map[string]any{
+					"repository": map[string]any{
+						"pullRequest": map[string]any{
+							"reviewDecision": reviewDecision,
+							"commits": map[string]any{
+								"nodes": []any{
+									map[string]any{
+										"commit": map[string]any{
+											"statusCheckRollup": map[string]any{"state": checksState},
+										},
+									},
+								},
+							},
+						},
+					},
+				}),
+			),
+		)
+	}
+
+	compare := func(behindBy int) http.HandlerFunc {
+		return expectPath(t, "/repos/owner/repo/compare/main...abcd1234").andThen(
+			mockResponse(t, http.StatusOK, &github.CommitsComparison{
+				Status:   github.Ptr("diverged"),
+				AheadBy:  github.Ptr(2),
+				BehindBy: github.Ptr(behindBy),
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		restHandlers   func(calls *int) map[string]http.HandlerFunc
+		gqlClient      *http.Client
+		maxAttempts    int
+		expectedCalls  int
+		expectError    bool
+		expectedErrMsg string
+		expected       MinimalPullRequestMergeStatus
+	}{
+		{
+			name: "clean pull request",
+			restHandlers: func(calls *int) map[string]http.HandlerFunc {
+				return map[string]http.HandlerFunc{
+					GetReposPullsByOwnerByRepoByPullNumber: func(w http.ResponseWriter, r *http.Request) {
+						*calls++
+						mockResponse(t, http.StatusOK, computedPR)(w, r)
+					},
+					GetReposCompareByOwnerByRepoByBasehead: compare(0),
+				}
+			},
+			gqlClient:     gqlStatus("APPROVED", "SUCCESS"),
+			maxAttempts:   3,
+			expectedCalls: 1,
+			expected: MinimalPullRequestMergeStatus{
+				Number:         42,
+				State:          "open",
+				Mergeable:      github.Ptr(true),
+				MergeableState: "clean",
+				ReviewDecision: "APPROVED",
+				ChecksState:    "SUCCESS",
+				HeadSHA:        "abcd1234",
+				BaseRef:        "main",
+			},
+		},
+		{
+			name: "polls until mergeability is computed",
+			restHandlers: func(calls *int) map[string]http.HandlerFunc {
+				return map[string]http.HandlerFunc{
+					GetReposPullsByOwnerByRepoByPullNumber: func(w http.ResponseWriter, r *http.Request) {
+						*calls++
+						if *calls < 3 {
+							mockResponse(t, http.StatusOK, computingPR)(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, &github.PullRequest{
+							Number:         github.Ptr(42),
+							State:          github.Ptr("open"),
+							Mergeable:      github.Ptr(true),
+							MergeableState: github.Ptr("behind"),
+							Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+							Base:           &github.PullRequestBranch{Ref: github.Ptr("main")},
+						})(w, r)
+					},
+					GetReposCompareByOwnerByRepoByBasehead: compare(5),
+				}
+			},
+			gqlClient:     gqlStatus("REVIEW_REQUIRED", "PENDING"),
+			maxAttempts:   5,
+			expectedCalls: 3,
+			expected: MinimalPullRequestMergeStatus{
+				Number:         42,
+				State:          "open",
+				Mergeable:      github.Ptr(true),
+				MergeableState: "behind",
+				ReviewDecision: "REVIEW_REQUIRED",
+				ChecksState:    "PENDING",
+				BehindBy:       5,
+				BehindBase:     true,
+				HeadSHA:        "abcd1234",
+				BaseRef:        "main",
+			},
+		},
+		{
+			name: "mergeability still being computed after polling",
+			restHandlers: func(calls *int) map[string]http.HandlerFunc {
+				return map[string]http.HandlerFunc{
+					GetReposPullsByOwnerByRepoByPullNumber: func(w http.ResponseWriter, r *http.Request) {
+						*calls++
+						mockResponse(t, http.StatusOK, computingPR)(w, r)
+					},
+					GetReposCompareByOwnerByRepoByBasehead: compare(0),
+				}
+			},
+			gqlClient:     gqlStatus("APPROVED", "SUCCESS"),
+			maxAttempts:   2,
+			expectedCalls: 3,
+			expected: MinimalPullRequestMergeStatus{
+				Number:         42,
+				State:          "open",
+				MergeableState: "unknown",
+				ReviewDecision: "APPROVED",
+				ChecksState:    "SUCCESS",
+				HeadSHA:        "abcd1234",
+				BaseRef:        "main",
+			},
+		},
+		{
+			name: "pull request not found",
+			restHandlers: func(calls *int) map[string]http.HandlerFunc {
+				return map[string]http.HandlerFunc{
+					GetReposPullsByOwnerByRepoByPullNumber: func(w http.ResponseWriter, r *http.Request) {
+						*calls++
+						mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`)(w, r)
+					},
+				}
+			},
+			gqlClient:      githubv4mock.NewMockedHTTPClient(),
+			maxAttempts:    3,
+			expectedCalls:  1,
+			expectError:    true,
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			deps := BaseDeps{
+				Client:    github.NewClient(MockHTTPClientWithHandlers(tc.restHandlers(&calls))),
+				GQLClient: githubv4.NewClient(tc.gqlClient),
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+			ctx := ContextWithPollConfig(ContextWithDeps(context.Background(), deps), PollConfig{MaxAttempts: tc.maxAttempts, Delay: 0})
+			result, err := handler(ctx, &request)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			var status MinimalPullRequestMergeStatus
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &status))
+			assert.Equal(t, tc.expected, status)
+			if tc.expected.Mergeable == nil {
+				assert.Contains(t, textContent.Text, `"mergeable":null`)
+			}
+		})
+	}
+}
+
+func Test_EnableAutoMerge(t *testing.T) {
+	// Verify tool definition once
+	serverTool := EnableAutoMerge(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "enable_pull_request_auto_merge", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "mergeMethod")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+		expected       MinimalAutoMergeState
+	}{
+		{
+			name: "enable auto-merge with squash",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestNodeIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						EnablePullRequestAutoMerge struct {
+							PullRequest pullRequestAutoMergeFragment
+						} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+					}{},
+					githubv4.EnablePullRequestAutoMergeInput{
+						PullRequestID: "PR_kwDOA0xdyM50BPaO",
+						MergeMethod:   github.Ptr(githubv4.PullRequestMergeMethodSquash),
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"enablePullRequestAutoMerge": map[string]any{
+							"pullRequest": map[string]any{
+								"number": 42,
+								"url":    "https://github.com/owner/repo/pull/42",
+								"autoMergeRequest": map[string]any{
+									"enabledAt":   "2026-01-02T03:04:05Z",
+									"mergeMethod": "SQUASH",
+									"enabledBy":   map[string]any{"login": "octocat"},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"mergeMethod": "squash",
+			},
+			expected: MinimalAutoMergeState{
+				Number:      42,
+				Enabled:     true,
+				MergeMethod: "SQUASH",
+				EnabledBy:   "octocat",
+				EnabledAt:   "2026-01-02T03:04:05Z",
+				URL:         "https://github.com/owner/repo/pull/42",
+			},
+		},
+		{
+			name: "auto-merge not allowed",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				pullRequestNodeIDMatcher(),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						EnablePullRequestAutoMerge struct {
+							PullRequest pullRequestAutoMergeFragment
+						} `graphql:"enablePullRequestAutoMerge(input: $input)"`
+					}{},
+					githubv4.EnablePullRequestAutoMergeInput{
+						PullRequestID: "PR_kwDOA0xdyM50BPaO",
+					},
+					nil,
+					githubv4mock.ErrorResponse("Pull request Auto merge is not allowed for this repository"),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to enable auto-merge",
+		},
+		{
+			name:         "invalid merge method",
+			mockedClient: githubv4mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"mergeMethod": "fast-forward",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid mergeMethod "fast-forward"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{GQLClient: githubv4.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var state MinimalAutoMergeState
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &state))
+			assert.Equal(t, tc.expected, state)
+		})
+	}
+}
+
+func Test_DisableAutoMerge(t *testing.T) {
+	// Verify tool definition once
+	serverTool := DisableAutoMerge(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "disable_pull_request_auto_merge", tool.Name)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+
+	mockedClient := githubv4mock.NewMockedHTTPClient(
+		pullRequestNodeIDMatcher(),
+		githubv4mock.NewMutationMatcher(
+			struct {
+				DisablePullRequestAutoMerge struct {
+					PullRequest pullRequestAutoMergeFragment
+				} `graphql:"disablePullRequestAutoMerge(input: $input)"`
+			}{},
+			githubv4.DisablePullRequestAutoMergeInput{
+				PullRequestID: "PR_kwDOA0xdyM50BPaO",
+			},
+			nil,
+			githubv4mock.DataResponse(map[string]any{
+				"disablePullRequestAutoMerge": map[string]any{
+					"pullRequest": map[string]any{
+						"number":           42,
+						"url":              "https://github.com/owner/repo/pull/42",
+						"autoMergeRequest": nil,
+					},
+				},
+			}),
+		),
+	)
+	deps := BaseDeps{GQLClient: githubv4.NewClient(mockedClient)}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var state MinimalAutoMergeState
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &state))
+	assert.Equal(t, MinimalAutoMergeState{
+		Number: 42,
+		URL:    "https://github.com/owner/repo/pull/42",
+	}, state)
+}
+
+// pullRequestNodeIDMatcher matches the lookup of pull request 42's node ID.
+func pullRequestNodeIDMatcher() githubv4mock.Matcher {
+	return githubv4mock.NewQueryMatcher(
+		struct {
+			Repository struct {
+				PullRequest struct {
+					ID githubv4.ID
+				} `graphql:"pullRequest(number: $prNum)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}{},
+		map[string]any{
+			"owner": githubv4.String("owner"),
+			"repo":  githubv4.String("repo"),
+			"prNum": githubv4.Int(42),
+		},
+		githubv4mock.DataResponse(map[string]any{
+			"repository": map[string]any{
+				"pullRequest": map[string]any{
+					"id": "PR_kwDOA0xdyM50BPaO",
+				},
+			},
+		}),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -368,6 +369,428 @@ func Test_UpdatePullRequest(t *testing.T) {
 	}
 }
 
+func Test_ManagePullRequestState(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManagePullRequestState(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "manage_pull_request_state", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "pullNumber")
+	assert.Contains(t, schema.Properties, "action")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber", "action"})
+
+	openPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("open"),
+		Merged:  github.Ptr(false),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+	}
+	closedPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("closed"),
+		Merged:  github.Ptr(false),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+	}
+	mergedPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		State:   github.Ptr("closed"),
+		Merged:  github.Ptr(true),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedState  string
+		expectedErrMsg string
+	}{
+		{
+			name: "close open pull request",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, openPR),
+				PatchReposPullsByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"state": "closed",
+				}).andThen(
+					mockResponse(t, http.StatusOK, closedPR),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "close",
+			},
+			expectedState: "closed",
+		},
+		{
+			name: "reopen closed pull request",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, closedPR),
+				PatchReposPullsByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"state": "open",
+				}).andThen(
+					mockResponse(t, http.StatusOK, openPR),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "reopen",
+			},
+			expectedState: "open",
+		},
+		{
+			name: "refuse to reopen merged pull request",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, mergedPR),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "reopen",
+			},
+			expectError:    true,
+			expectedErrMsg: "cannot reopen pull request #42: it has already been merged",
+		},
+		{
+			name:         "invalid action",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "merge",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid action",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned pullRequestStateResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, 42, returned.Number)
+			assert.Equal(t, tc.expectedState, returned.State)
+			assert.False(t, returned.Merged)
+		})
+	}
+}
+
+func Test_ManagePullRequestDraft(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManagePullRequestDraft(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "manage_pull_request_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "action")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber", "action"})
+
+	prQueryMatcher := func(isDraft bool) githubv4mock.Matcher {
+		return githubv4mock.NewQueryMatcher(
+			struct {
+				Repository struct {
+					PullRequest struct {
+						ID      githubv4.ID
+						IsDraft githubv4.Boolean
+					} `graphql:"pullRequest(number: $prNum)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}{},
+			map[string]any{
+				"owner": githubv4.String("owner"),
+				"repo":  githubv4.String("repo"),
+				"prNum": githubv4.Int(42),
+			},
+			githubv4mock.DataResponse(map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"id":      "PR_kwDOA0xdyM50BPaO",
+						"isDraft": isDraft,
+					},
+				},
+			}),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		action         string
+		expectError    bool
+		expectedErrMsg string
+		expectedDraft  bool
+	}{
+		{
+			name: "convert to draft",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				prQueryMatcher(false),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						ConvertPullRequestToDraft struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+							}
+						} `graphql:"convertPullRequestToDraft(input: $input)"`
+					}{},
+					githubv4.ConvertPullRequestToDraftInput{
+						PullRequestID: "PR_kwDOA0xdyM50BPaO",
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"convertPullRequestToDraft": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": true,
+							},
+						},
+					}),
+				),
+			),
+			action:        "convert_to_draft",
+			expectedDraft: true,
+		},
+		{
+			name: "mark ready for review",
+			mockedClient: githubv4mock.NewMockedHTTPClient(
+				prQueryMatcher(true),
+				githubv4mock.NewMutationMatcher(
+					struct {
+						MarkPullRequestReadyForReview struct {
+							PullRequest struct {
+								ID      githubv4.ID
+								IsDraft githubv4.Boolean
+							}
+						} `graphql:"markPullRequestReadyForReview(input: $input)"`
+					}{},
+					githubv4.MarkPullRequestReadyForReviewInput{
+						PullRequestID: "PR_kwDOA0xdyM50BPaO",
+					},
+					nil,
+					githubv4mock.DataResponse(map[string]any{
+						"markPullRequestReadyForReview": map[string]any{
+							"pullRequest": map[string]any{
+								"id":      "PR_kwDOA0xdyM50BPaO",
+								"isDraft": false,
+							},
+						},
+					}),
+				),
+			),
+			action:        "mark_ready",
+			expectedDraft: false,
+		},
+		{
+			name:          "already a draft",
+			mockedClient:  githubv4mock.NewMockedHTTPClient(prQueryMatcher(true)),
+			action:        "convert_to_draft",
+			expectedDraft: true,
+		},
+		{
+			name:           "invalid action",
+			mockedClient:   githubv4mock.NewMockedHTTPClient(),
+			action:         "publish",
+			expectError:    true,
+			expectedErrMsg: `invalid action "publish"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{GQLClient: githubv4.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     tc.action,
+			})
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var returned pullRequestDraftResult
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+			assert.Equal(t, pullRequestDraftResult{Number: 42, Draft: tc.expectedDraft}, returned)
+		})
+	}
+}
+
+func Test_ManagePullRequestReviewers(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManagePullRequestReviewers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "manage_pull_request_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "pullNumber")
+	assert.Contains(t, schema.Properties, "action")
+	assert.Contains(t, schema.Properties, "reviewers")
+	assert.Contains(t, schema.Properties, "teamReviewers")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber", "action"})
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]any
+		expectError       bool
+		expectedReviewers []string
+		expectedTeams     []string
+		expectedErrMsg    string
+	}{
+		{
+			name: "add user and team reviewers",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"reviewers":      []any{"octocat"},
+					"team_reviewers": []any{"core"},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, &github.PullRequest{Number: github.Ptr(42)}),
+				),
+				GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.Reviewers{
+					Users: []*github.User{{Login: github.Ptr("octocat")}},
+					Teams: []*github.Team{{Slug: github.Ptr("core")}},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"action":        "add",
+				"reviewers":     []any{"octocat"},
+				"teamReviewers": []any{"core"},
+			},
+			expectedReviewers: []string{"octocat"},
+			expectedTeams:     []string{"core"},
+		},
+		{
+			name: "remove user reviewer",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"reviewers": []any{"octocat"},
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.PullRequest{Number: github.Ptr(42)}),
+				),
+				GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.Reviewers{
+					Users: []*github.User{},
+					Teams: []*github.Team{{Slug: github.Ptr("core")}},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "remove",
+				"reviewers":  []any{"octocat"},
+			},
+			expectedReviewers: []string{},
+			expectedTeams:     []string{"core"},
+		},
+		{
+			name:         "no reviewers provided",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "add",
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of reviewers or teamReviewers must be provided",
+		},
+		{
+			name: "request reviewers fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"message":"Reviews may only be requested from collaborators."}`))
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"action":     "add",
+				"reviewers":  []any{"stranger"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add reviewers",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned requestedReviewersResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedReviewers, returned.Reviewers)
+			assert.Equal(t, tc.expectedTeams, returned.TeamReviewers)
+		})
+	}
+}
+
 func Test_UpdatePullRequest_Draft(t *testing.T) {
 	// Setup mock PR for success case
 	mockUpdatedPR := &github.PullRequest{
@@ -552,6 +975,134 @@ func Test_UpdatePullRequest_Draft(t *testing.T) {
 	}
 }
 
+func Test_ListPullRequestReviewComments(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListPullRequestReviewComments(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_review_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "pullNumber")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockComments := []*github.PullRequestComment{
+		{
+			ID:                  github.Ptr(int64(101)),
+			PullRequestReviewID: github.Ptr(int64(9)),
+			Path:                github.Ptr("main.go"),
+			Line:                github.Ptr(12),
+			Side:                github.Ptr("RIGHT"),
+			DiffHunk:            github.Ptr("@@ -10,3 +10,4 @@ func main() {"),
+			Body:                github.Ptr("Should this handle errors?"),
+			User:                &github.User{Login: github.Ptr("reviewer")},
+			HTMLURL:             github.Ptr("https://github.com/owner/repo/pull/42#discussion_r101"),
+		},
+		{
+			ID:                  github.Ptr(int64(102)),
+			InReplyTo:           github.Ptr(int64(101)),
+			PullRequestReviewID: github.Ptr(int64(10)),
+			Path:                github.Ptr("main.go"),
+			Line:                github.Ptr(12),
+			Side:                github.Ptr("RIGHT"),
+			DiffHunk:            github.Ptr("@@ -10,3 +10,4 @@ func main() {"),
+			Body:                github.Ptr("Good catch, fixed."),
+			User:                &github.User{Login: github.Ptr("author")},
+			HTMLURL:             github.Ptr("https://github.com/owner/repo/pull/42#discussion_r102"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "lists review comments with pagination",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsCommentsByOwnerByRepoByPullNumber: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockComments),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"page":       float64(2),
+				"perPage":    float64(10),
+			},
+		},
+		{
+			name: "list fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsCommentsByOwnerByRepoByPullNumber: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull request review comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalPullRequestReviewComment
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			require.Len(t, returned, 2)
+
+			assert.Equal(t, int64(101), returned[0].ID)
+			assert.Zero(t, returned[0].InReplyToID)
+			assert.Equal(t, "main.go", returned[0].Path)
+			require.NotNil(t, returned[0].Line)
+			assert.Equal(t, 12, *returned[0].Line)
+			assert.Equal(t, "@@ -10,3 +10,4 @@ func main() {", returned[0].DiffHunk)
+			assert.Equal(t, "reviewer", returned[0].User.Login)
+
+			// The reply links back to the first comment of the thread
+			assert.Equal(t, int64(101), returned[1].InReplyToID)
+			assert.Equal(t, "Good catch, fixed.", returned[1].Body)
+			assert.Equal(t, "author", returned[1].User.Login)
+		})
+	}
+}
+
 func Test_ListPullRequests(t *testing.T) {
 	// Verify tool definition once
 	serverTool := ListPullRequests(translations.NullTranslationHelper)
@@ -685,6 +1236,140 @@ func Test_ListPullRequests(t *testing.T) {
 	}
 }
 
+func Test_ListPullRequestCommits(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListPullRequestCommits(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_pull_request_commits", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA:     github.Ptr("abc123"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Add feature"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Mona Lisa"),
+					Email: github.Ptr("mona@example.com"),
+					Date:  &github.Timestamp{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+				},
+				Verification: &github.SignatureVerification{
+					Verified: github.Ptr(true),
+					Reason:   github.Ptr("valid"),
+				},
+			},
+			Author: &github.User{Login: github.Ptr("monalisa")},
+		},
+		{
+			SHA:     github.Ptr("def456"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Fix tests"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Someone"),
+					Email: github.Ptr("someone@example.com"),
+				},
+				Verification: &github.SignatureVerification{
+					Verified: github.Ptr(false),
+					Reason:   github.Ptr("unsigned"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful commits list",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsCommitsByOwnerByRepoByPullNumber: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "5",
+				}).andThen(mockResponse(t, http.StatusOK, mockCommits)),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"page":       float64(2),
+				"perPage":    float64(5),
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsCommitsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list pull request commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var commits []MinimalPullRequestCommit
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &commits))
+			assert.Equal(t, []MinimalPullRequestCommit{
+				{
+					SHA:     "abc123",
+					Message: "Add feature",
+					Author: &MinimalCommitAuthor{
+						Name:  "Mona Lisa",
+						Email: "mona@example.com",
+						Date:  "2026-01-02T03:04:05Z",
+					},
+					AuthorLogin:        "monalisa",
+					Verified:           true,
+					VerificationReason: "valid",
+					HTMLURL:            "https://github.com/owner/repo/commit/abc123",
+				},
+				{
+					SHA:     "def456",
+					Message: "Fix tests",
+					Author: &MinimalCommitAuthor{
+						Name:  "Someone",
+						Email: "someone@example.com",
+					},
+					Verified:           false,
+					VerificationReason: "unsigned",
+					HTMLURL:            "https://github.com/owner/repo/commit/def456",
+				},
+			}, commits)
+		})
+	}
+}
+
 func Test_MergePullRequest(t *testing.T) {
 	// Verify tool definition once
 	serverTool := MergePullRequest(translations.NullTranslationHelper)
@@ -812,6 +1497,7 @@ func Test_SearchPullRequests(t *testing.T) {
 	assert.Contains(t, schema.Properties, "repo")
 	assert.Contains(t, schema.Properties, "sort")
 	assert.Contains(t, schema.Properties, "order")
+	assert.Contains(t, schema.Properties, "fields")
 	assert.Contains(t, schema.Properties, "perPage")
 	assert.Contains(t, schema.Properties, "page")
 	assert.ElementsMatch(t, schema.Required, []string{"query"})
@@ -1432,6 +2118,7 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 		expectError          bool
 		expectedUpdateResult *github.PullRequestBranchUpdateResponse
 		expectedErrMsg       string
+		expectedReport       *pullRequestBranchUpdateResult
 	}{
 		{
 			name: "successful branch update",
@@ -1482,6 +2169,59 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to update pull request branch",
 		},
+		{
+			name: "expected head SHA does not match",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposPullsUpdateBranchByOwnerByRepoByPullNumber: expectRequestBody(t, map[string]any{
+					"expected_head_sha": "abcd1234",
+				}).andThen(
+					mockResponse(t, http.StatusUnprocessableEntity, `{"message": "expected head sha didn't match current head ref."}`),
+				),
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.PullRequest{
+					Number:         github.Ptr(42),
+					MergeableState: github.Ptr("behind"),
+					Head:           &github.PullRequestBranch{SHA: github.Ptr("ef567890")},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"expectedHeadSha": "abcd1234",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update pull request branch",
+			expectedReport: &pullRequestBranchUpdateResult{
+				Status:         "head_changed",
+				Message:        "expected head sha didn't match current head ref.",
+				HeadSHA:        "ef567890",
+				MergeableState: "behind",
+			},
+		},
+		{
+			name: "merge conflict",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutReposPullsUpdateBranchByOwnerByRepoByPullNumber: mockResponse(t, http.StatusUnprocessableEntity, `{"message": "merge conflict between base and head"}`),
+				GetReposPullsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, &github.PullRequest{
+					Number:         github.Ptr(42),
+					MergeableState: github.Ptr("dirty"),
+					Head:           &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+				}),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update pull request branch",
+			expectedReport: &pullRequestBranchUpdateResult{
+				Status:         "conflicts",
+				Message:        "merge conflict between base and head",
+				HeadSHA:        "abcd1234",
+				MergeableState: "dirty",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1506,6 +2246,15 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				if tc.expectedReport != nil {
+					_, reportJSON, found := strings.Cut(errorContent.Text, "\n\n")
+					require.True(t, found)
+					var report pullRequestBranchUpdateResult
+					require.NoError(t, json.Unmarshal([]byte(reportJSON), &report))
+					assert.NotEmpty(t, report.Suggestion)
+					report.Suggestion = ""
+					assert.Equal(t, *tc.expectedReport, report)
+				}
 				return
 			}
 
@@ -1516,6 +2265,9 @@ func Test_UpdatePullRequestBranch(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			assert.Contains(t, textContent.Text, "is in progress")
+			var report pullRequestBranchUpdateResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &report))
+			assert.Equal(t, "updating", report.Status)
 		})
 	}
 }
@@ -2157,6 +2909,210 @@ func Test_CreatePullRequest(t *testing.T) {
 	}
 }
 
+func Test_CreatePullRequestWithChanges(t *testing.T) {
+	// Verify tool definition once
+	serverTool := CreatePullRequestWithChanges(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_pull_request_with_changes", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "baseBranch")
+	assert.Contains(t, schema.Properties, "headBranch")
+	assert.Contains(t, schema.Properties, "message")
+	assert.Contains(t, schema.Properties, "files")
+	assert.Contains(t, schema.Properties, "title")
+	assert.Contains(t, schema.Properties, "body")
+	assert.Contains(t, schema.Properties, "draft")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "baseBranch", "headBranch", "message", "files", "title"})
+
+	mockBaseRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("base123"),
+		},
+	}
+	mockBaseCommit := &github.Commit{
+		SHA: github.Ptr("base123"),
+		Tree: &github.Tree{
+			SHA: github.Ptr("basetree123"),
+		},
+	}
+	mockTree := &github.Tree{
+		SHA: github.Ptr("newtree123"),
+	}
+	mockCommit := &github.Commit{
+		SHA:     github.Ptr("newcommit123"),
+		Message: github.Ptr("Update docs"),
+	}
+	mockHeadRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/docs-update"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("newcommit123"),
+		},
+	}
+	mockPR := &github.PullRequest{
+		ID:      github.Ptr(int64(1001)),
+		Number:  github.Ptr(42),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+	}
+
+	requestArgs := map[string]any{
+		"owner":      "owner",
+		"repo":       "repo",
+		"baseBranch": "main",
+		"headBranch": "docs-update",
+		"message":    "Update docs",
+		"files": []any{
+			map[string]any{
+				"path":    "README.md",
+				"content": "# Updated",
+			},
+		},
+		"title": "Update docs",
+		"body":  "Refreshes the README",
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedURL    string
+		expectedErrMsg string
+	}{
+		{
+			name: "creates branch, commits files and opens pull request",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/ref/heads/main").andThen(
+					mockResponse(t, http.StatusOK, mockBaseRef),
+				),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockBaseCommit),
+				PostReposGitTreesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"base_tree": "basetree123",
+					"tree": []any{
+						map[string]any{
+							"path":    "README.md",
+							"mode":    "100644",
+							"type":    "blob",
+							"content": "# Updated",
+						},
+					},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, mockTree),
+				),
+				PostReposGitCommitsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"message": "Update docs",
+					"tree":    "newtree123",
+					"parents": []any{"base123"},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, mockCommit),
+				),
+				PostReposGitRefsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"ref": "refs/heads/docs-update",
+					"sha": "newcommit123",
+				}).andThen(
+					mockResponse(t, http.StatusCreated, mockHeadRef),
+				),
+				PostReposPullsByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"title": "Update docs",
+					"body":  "Refreshes the README",
+					"head":  "docs-update",
+					"base":  "main",
+					"draft": false,
+				}).andThen(
+					mockResponse(t, http.StatusCreated, mockPR),
+				),
+			}),
+			requestArgs: requestArgs,
+			expectedURL: "https://github.com/owner/repo/pull/42",
+		},
+		{
+			name: "deletes the new branch when opening the pull request fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef:           mockResponse(t, http.StatusOK, mockBaseRef),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockBaseCommit),
+				PostReposGitTreesByOwnerByRepo:             mockResponse(t, http.StatusCreated, mockTree),
+				PostReposGitCommitsByOwnerByRepo:           mockResponse(t, http.StatusCreated, mockCommit),
+				PostReposGitRefsByOwnerByRepo:              mockResponse(t, http.StatusCreated, mockHeadRef),
+				PostReposPullsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"message":"Validation failed"}`))
+				}),
+				DeleteReposGitRefsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/git/refs/heads/docs-update").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs:    requestArgs,
+			expectError:    true,
+			expectedErrMsg: "failed to create pull request (branch docs-update was deleted)",
+		},
+		{
+			name: "branch already exists",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef:           mockResponse(t, http.StatusOK, mockBaseRef),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: mockResponse(t, http.StatusOK, mockBaseCommit),
+				PostReposGitTreesByOwnerByRepo:             mockResponse(t, http.StatusCreated, mockTree),
+				PostReposGitCommitsByOwnerByRepo:           mockResponse(t, http.StatusCreated, mockCommit),
+				PostReposGitRefsByOwnerByRepo: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"message":"Reference already exists"}`))
+				}),
+			}),
+			requestArgs:    requestArgs,
+			expectError:    true,
+			expectedErrMsg: "failed to create branch docs-update",
+		},
+		{
+			name:         "empty files list",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"baseBranch": "main",
+				"headBranch": "docs-update",
+				"message":    "Update docs",
+				"files":      []any{},
+				"title":      "Update docs",
+			},
+			expectError:    true,
+			expectedErrMsg: "files parameter must be a non-empty array",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedURL, returned.URL)
+			assert.Equal(t, "1001", returned.ID)
+		})
+	}
+}
+
 // Test_CreatePullRequest_InsidersMode_UIGate verifies the insiders mode UI gate
 // behavior: UI clients get a form message, non-UI clients execute directly.
 func Test_CreatePullRequest_InsidersMode_UIGate(t *testing.T) {
@@ -2629,6 +3585,181 @@ func TestCreatePendingPullRequestReview(t *testing.T) {
 	}
 }
 
+func Test_SubmitPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	serverTool := SubmitPullRequestReview(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "submit_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "pullNumber")
+	assert.Contains(t, schema.Properties, "reviewId")
+	assert.Contains(t, schema.Properties, "event")
+	assert.Contains(t, schema.Properties, "body")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "pullNumber", "event"})
+
+	mockReviews := []*github.PullRequestReview{
+		{ID: github.Ptr(int64(1)), State: github.Ptr("COMMENTED")},
+		{ID: github.Ptr(int64(2)), State: github.Ptr("PENDING")},
+	}
+	submittedReview := func(event string) *github.PullRequestReview {
+		return &github.PullRequestReview{
+			ID:      github.Ptr(int64(2)),
+			State:   github.Ptr(event),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-2"),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "approve without body",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsReviewsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, mockReviews),
+				PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewID: expectPath(t, "/repos/owner/repo/pulls/42/reviews/2/events").andThen(
+					expectRequestBody(t, map[string]any{
+						"event": "APPROVE",
+					}).andThen(
+						mockResponse(t, http.StatusOK, submittedReview("APPROVED")),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+			},
+		},
+		{
+			name: "request changes with body",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsReviewsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, mockReviews),
+				PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewID: expectRequestBody(t, map[string]any{
+					"event": "REQUEST_CHANGES",
+					"body":  "Please add tests",
+				}).andThen(
+					mockResponse(t, http.StatusOK, submittedReview("CHANGES_REQUESTED")),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "REQUEST_CHANGES",
+				"body":       "Please add tests",
+			},
+		},
+		{
+			name: "comment on explicit review id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewID: expectPath(t, "/repos/owner/repo/pulls/42/reviews/7/events").andThen(
+					expectRequestBody(t, map[string]any{
+						"event": "COMMENT",
+						"body":  "A few thoughts inline",
+					}).andThen(
+						mockResponse(t, http.StatusOK, submittedReview("COMMENTED")),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewId":   float64(7),
+				"event":      "COMMENT",
+				"body":       "A few thoughts inline",
+			},
+		},
+		{
+			name: "pending review on a later page",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsReviewsByOwnerByRepoByPullNumber: func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Query().Get("page") == "2" {
+						mockResponse(t, http.StatusOK, mockReviews[1:])(w, r)
+						return
+					}
+					w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/pulls/42/reviews?page=2&per_page=100>; rel="next"`)
+					mockResponse(t, http.StatusOK, mockReviews[:1])(w, r)
+				},
+				PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewID: expectPath(t, "/repos/owner/repo/pulls/42/reviews/2/events").andThen(
+					mockResponse(t, http.StatusOK, submittedReview("APPROVED")),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+			},
+		},
+		{
+			name: "no pending review",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposPullsReviewsByOwnerByRepoByPullNumber: mockResponse(t, http.StatusOK, mockReviews[:1]),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+			},
+			expectError:    true,
+			expectedErrMsg: "no pending review found for the current user on pull request #42",
+		},
+		{
+			name:         "invalid event",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "DISMISS",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid event",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned MinimalResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, "2", returned.ID)
+			assert.Equal(t, "https://github.com/owner/repo/pull/42#pullrequestreview-2", returned.URL)
+		})
+	}
+}
+
 func TestAddPullRequestReviewCommentToPendingReview(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// DefaultRepoMetadataCacheTTL is how long cached repository metadata stays fresh.
+const DefaultRepoMetadataCacheTTL = 5 * time.Minute
+
+// RepoMetadataCache caches rarely changing repository metadata, such as the
+// repository itself (and with it the default branch) and the workflow list,
+// for the lifetime of a session, along with the authenticated user. Entries
+// are keyed on the request's token (see cacheTokenKey) and owner/repo, so that
+// metadata fetched with one token is never served to another, and expire after
+// a short TTL. A nil *RepoMetadataCache is valid and caches nothing.
+type RepoMetadataCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]repoMetadataCacheEntry
+}
+
+type repoMetadataCacheEntry struct {
+	value     any
+	expiresAt time.Time
+}
+
+// NewRepoMetadataCache creates an empty cache whose entries expire after ttl.
+func NewRepoMetadataCache(ttl time.Duration) *RepoMetadataCache {
+	return &RepoMetadataCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]repoMetadataCacheEntry),
+	}
+}
+
+// cacheTokenKey identifies the token that authenticates the request in cache
+// keys. The token itself is never stored, only its hash; without a token in ctx
+// the server-wide client's identity is keyed as "default".
+func cacheTokenKey(ctx context.Context) string {
+	if tokenInfo, ok := ghcontext.GetTokenInfo(ctx); ok && tokenInfo.Token != "" {
+		sum := sha256.Sum256([]byte(tokenInfo.Token))
+		return hex.EncodeToString(sum[:])
+	}
+	return "default"
+}
+
+// repoMetadataCacheKey builds a cache key for kind of metadata in owner/repo, as
+// seen by the request's token. Owner and repository names are case-insensitive
+// on GitHub.
+func repoMetadataCacheKey(ctx context.Context, owner, repo, kind string) string {
+	return cacheTokenKey(ctx) + ":" + strings.ToLower(owner+"/"+repo) + "#" + kind
+}
+
+func (c *RepoMetadataCache) get(key string) (any, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *RepoMetadataCache) set(key string, value any) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = repoMetadataCacheEntry{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+func (c *RepoMetadataCache) delete(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// repoMetadataCacheFromContext returns the session's repository metadata cache,
+// or nil when the dependencies in ctx do not provide one.
+func repoMetadataCacheFromContext(ctx context.Context) *RepoMetadataCache {
+	deps, ok := DepsFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return deps.GetRepoMetadataCache(ctx)
+}
+
+// getRepositoryCached returns owner/repo, served from the session cache when
+// possible. The returned response is nil on a cache hit. refresh bypasses the
+// cache and replaces any cached entry.
+func getRepositoryCached(ctx context.Context, client *github.Client, owner, repo string, refresh bool) (*github.Repository, *github.Response, error) {
+	cache := repoMetadataCacheFromContext(ctx)
+	key := repoMetadataCacheKey(ctx, owner, repo, "repository")
+	if !refresh {
+		if cached, ok := cache.get(key); ok {
+			return cached.(*github.Repository), nil, nil
+		}
+	}
+
+	repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, resp, err
+	}
+	cache.set(key, repository)
+	return repository, resp, nil
+}
+
+// listWorkflowsCached lists one page of owner/repo's workflows, served from the
+// session cache when possible. The returned response is nil on a cache hit.
+// refresh bypasses the cache and replaces any cached entry.
+func listWorkflowsCached(ctx context.Context, client *github.Client, owner, repo string, opts *github.ListOptions, refresh bool) (*github.Workflows, *github.Response, error) {
+	cache := repoMetadataCacheFromContext(ctx)
+	key := repoMetadataCacheKey(ctx, owner, repo, fmt.Sprintf("workflows?page=%d&per_page=%d", opts.Page, opts.PerPage))
+	if !refresh {
+		if cached, ok := cache.get(key); ok {
+			return cached.(*github.Workflows), nil, nil
+		}
+	}
+
+	workflows, resp, err := client.Actions.ListWorkflows(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	cache.set(key, workflows)
+	return workflows, resp, nil
+}
+
+// authenticatedUserCacheKey builds the cache key for the user authenticated by
+// the request's token.
+func authenticatedUserCacheKey(ctx context.Context) string {
+	return cacheTokenKey(ctx) + ":user"
+}
+
+// getAuthenticatedUserCached returns the user authenticated by client, served
+// from the session cache when possible. The returned response is nil on a cache
+// hit. Any tool call that fails with an authentication or permission error
+// drops the cached entry, see dropAuthenticatedUserOnAuthError.
+func getAuthenticatedUserCached(ctx context.Context, client *github.Client) (*github.User, *github.Response, error) {
+	cache := repoMetadataCacheFromContext(ctx)
+	key := authenticatedUserCacheKey(ctx)
+	if cached, ok := cache.get(key); ok {
+		return cached.(*github.User), nil, nil
+	}
+
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, resp, err
+	}
+	cache.set(key, user)
+	return user, resp, nil
+}
+
+// dropAuthenticatedUserOnAuthError forgets the cached user for the request's
+// token when a tool result reports an authentication or permission error, so
+// that a revoked or downgraded token is not reported as still valid.
+func dropAuthenticatedUserOnAuthError(ctx context.Context, result *mcp.CallToolResult) {
+	if result == nil || !result.IsError {
+		return
+	}
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok || structured["error_kind"] != ghErrors.ErrorKindForbidden {
+		return
+	}
+	repoMetadataCacheFromContext(ctx).delete(authenticatedUserCacheKey(ctx))
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ActionsList_ListWorkflows_SessionCache(t *testing.T) {
+	toolDef := ActionsList(translations.NullTranslationHelper)
+
+	calls := 0
+	mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposActionsWorkflowsByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			mockResponse(t, http.StatusOK, &github.Workflows{
+				TotalCount: github.Ptr(1),
+				Workflows: []*github.Workflow{
+					{ID: github.Ptr(int64(1)), Name: github.Ptr("CI"), Path: github.Ptr(".github/workflows/ci.yml")},
+				},
+			})(w, nil)
+		},
+	})
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewRepoMetadataCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	deps := BaseDeps{
+		Client:            github.NewClient(mockedClient),
+		RepoMetadataCache: cache,
+	}
+	handler := toolDef.Handler(deps)
+
+	listWorkflows := func(args map[string]any) {
+		t.Helper()
+		request := createMCPRequest(args)
+		result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var response github.Workflows
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		require.Len(t, response.Workflows, 1)
+		assert.Equal(t, "CI", response.Workflows[0].GetName())
+	}
+	args := map[string]any{
+		"method": "list_workflows",
+		"owner":  "owner",
+		"repo":   "repo",
+	}
+
+	listWorkflows(args)
+	assert.Equal(t, 1, calls)
+
+	// A second call within the TTL, even with different owner casing, is served from the cache
+	listWorkflows(map[string]any{
+		"method": "list_workflows",
+		"owner":  "Owner",
+		"repo":   "repo",
+	})
+	assert.Equal(t, 1, calls)
+
+	// refresh bypasses the cache
+	listWorkflows(map[string]any{
+		"method":  "list_workflows",
+		"owner":   "owner",
+		"repo":    "repo",
+		"refresh": true,
+	})
+	assert.Equal(t, 2, calls)
+
+	// Entries expire after the TTL
+	now = now.Add(2 * time.Minute)
+	listWorkflows(args)
+	assert.Equal(t, 3, calls)
+}
+
+func Test_getRepositoryCached(t *testing.T) {
+	calls := 0
+	client := github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetReposByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			mockResponse(t, http.StatusOK, &github.Repository{DefaultBranch: github.Ptr("main")})(w, nil)
+		},
+	}))
+
+	t.Run("cached within a session", func(t *testing.T) {
+		ctx := ContextWithDeps(context.Background(), BaseDeps{RepoMetadataCache: NewRepoMetadataCache(time.Minute)})
+		calls = 0
+
+		for range 2 {
+			repository, _, err := getRepositoryCached(ctx, client, "owner", "repo", false)
+			require.NoError(t, err)
+			assert.Equal(t, "main", repository.GetDefaultBranch())
+		}
+		assert.Equal(t, 1, calls)
+
+		_, _, err := getRepositoryCached(ctx, client, "owner", "repo", true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("cached per token", func(t *testing.T) {
+		ctx := ContextWithDeps(context.Background(), BaseDeps{RepoMetadataCache: NewRepoMetadataCache(time.Minute)})
+		ctxA := ghcontext.WithTokenInfo(ctx, &ghcontext.TokenInfo{Token: "token-a"})
+		ctxB := ghcontext.WithTokenInfo(ctx, &ghcontext.TokenInfo{Token: "token-b"})
+		calls = 0
+
+		// A repository fetched with one token is not served to another
+		for _, ctx := range []context.Context{ctxA, ctxA, ctxB, ctx} {
+			_, _, err := getRepositoryCached(ctx, client, "owner", "repo", false)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("no cache configured", func(t *testing.T) {
+		ctx := ContextWithDeps(context.Background(), BaseDeps{})
+		calls = 0
+
+		for range 2 {
+			_, _, err := getRepositoryCached(ctx, client, "owner", "repo", false)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func Test_GetMe_SessionCachePerToken(t *testing.T) {
+	calls := map[string]int{}
+	revoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path+" "+r.Header.Get("Authorization")]++
+		if revoked {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Bad credentials"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"login": "octocat", "total_count": 0, "items": []}`))
+	}))
+	defer server.Close()
+
+	baseURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client := github.NewClient(nil)
+	client.BaseURL = baseURL
+
+	deps := BaseDeps{
+		Client:            client,
+		RepoMetadataCache: NewRepoMetadataCache(time.Minute),
+	}
+	getMeTool := GetMe(translations.NullTranslationHelper)
+	searchTool := FindSimilarIssues(translations.NullTranslationHelper)
+	getMe := getMeTool.Handler(deps)
+	searchIssues := searchTool.Handler(deps)
+
+	call := func(handler mcp.ToolHandler, token string, args map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		ctx := ghcontext.WithTokenInfo(ContextWithDeps(context.Background(), deps), &ghcontext.TokenInfo{Token: token})
+		request := createMCPRequest(args)
+		result, err := handler(ctx, &request)
+		require.NoError(t, err)
+		return result
+	}
+
+	// Two calls with the same token hit the API once
+	require.False(t, call(getMe, "token-a", nil).IsError)
+	require.False(t, call(getMe, "token-a", nil).IsError)
+	assert.Equal(t, 1, calls["/user Bearer token-a"])
+
+	// Another token is cached separately
+	require.False(t, call(getMe, "token-b", nil).IsError)
+	assert.Equal(t, 1, calls["/user Bearer token-b"])
+
+	// An auth error from any tool drops the cached identity for that token only
+	revoked = true
+	searchArgs := map[string]any{"owner": "owner", "repo": "repo", "title": "Crash on startup"}
+	require.True(t, call(searchIssues, "token-a", searchArgs).IsError)
+	assert.True(t, call(getMe, "token-a", nil).IsError)
+	assert.Equal(t, 2, calls["/user Bearer token-a"])
+
+	revoked = false
+	require.False(t, call(getMe, "token-b", nil).IsError)
+	assert.Equal(t, 1, calls["/user Bearer token-b"])
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -118,6 +121,10 @@ func GetCommit(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+// maxCommitFileDetails caps how many commits list_commits fetches individually to
+// include their files when includeFiles is set.
+const maxCommitFileDetails = 10
+
 // ListCommits creates a tool to get commits of a branch in a repository.
 func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -140,6 +147,10 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Repository name",
 					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch name, tag name, or commit SHA to list commits of. It is resolved to a commit SHA before listing. If not provided, uses the default branch of the repository. Takes precedence over sha.",
+					},
 					"sha": {
 						Type:        "string",
 						Description: "Commit SHA, branch or tag name to list commits of. If not provided, uses the default branch of the repository. If a commit SHA is provided, will list commits up to that SHA.",
@@ -148,6 +159,28 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Author username or email address to filter commits by",
 					},
+					"path": {
+						Type:        "string",
+						Description: "Only list commits that touch this file or directory path",
+					},
+					"since": {
+						Type:        "string",
+						Description: "Only list commits after this date (ISO 8601 timestamp, e.g. 2024-01-01T00:00:00Z)",
+					},
+					"until": {
+						Type:        "string",
+						Description: "Only list commits before this date (ISO 8601 timestamp, e.g. 2024-01-31T23:59:59Z)",
+					},
+					"maxResults": {
+						Type:        "number",
+						Description: "Maximum number of commits to return. Caps the page size when smaller than perPage.",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"includeFiles": {
+						Type:        "boolean",
+						Description: fmt.Sprintf("Include stats and changed files with patches for each commit. This fetches every commit individually, so only the first %d commits include them. Default is false (commit metadata only).", maxCommitFileDetails),
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"owner", "repo"},
 			}),
@@ -162,6 +195,10 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			sha, err := OptionalParam[string](args, "sha")
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -170,6 +207,29 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			path, err := OptionalParam[string](args, "path")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			since, err := OptionalParam[string](args, "since")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			until, err := OptionalParam[string](args, "until")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxResults, err := OptionalIntParam(args, "maxResults")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxResults < 0 {
+				return utils.NewToolResultError("maxResults must be a positive number"), nil, nil
+			}
+			includeFiles, err := OptionalBoolParamWithDefault(args, "includeFiles", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 			pagination, err := OptionalPaginationParams(args)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -179,19 +239,47 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if perPage == 0 {
 				perPage = 30
 			}
+			if maxResults > 0 && maxResults < perPage {
+				perPage = maxResults
+			}
+
+			var sinceTime, untilTime time.Time
+			if since != "" {
+				sinceTime, err = parseISOTimestamp(since)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("invalid since timestamp: %v", err)), nil, nil
+				}
+			}
+			if until != "" {
+				untilTime, err = parseISOTimestamp(until)
+				if err != nil {
+					return utils.NewToolResultError(fmt.Sprintf("invalid until timestamp: %v", err)), nil, nil
+				}
+			}
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if ref != "" {
+				sha, err = resolveRef(ctx, client, owner, repo, ref)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
 			opts := &github.CommitsListOptions{
 				SHA:    sha,
 				Author: author,
+				Path:   path,
+				Since:  sinceTime,
+				Until:  untilTime,
 				ListOptions: github.ListOptions{
 					Page:    pagination.Page,
 					PerPage: perPage,
 				},
 			}
 
-			client, err := deps.GetClient(ctx)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
 			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -210,10 +298,34 @@ func ListCommits(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list commits", resp, body), nil, nil
 			}
 
+			if maxResults > 0 && len(commits) > maxResults {
+				commits = commits[:maxResults]
+			}
+
 			// Convert to minimal commits
 			minimalCommits := make([]MinimalCommit, len(commits))
 			for i, commit := range commits {
-				minimalCommits[i] = convertToMinimalCommit(commit, false)
+				if !includeFiles || i >= maxCommitFileDetails {
+					minimalCommits[i] = convertToMinimalCommit(commit, false)
+					continue
+				}
+
+				// The list endpoint never includes files, so fetch each commit individually,
+				// up to maxCommitFileDetails of them.
+				fullCommit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, commit.GetSHA(), nil)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get files for commit: %s", commit.GetSHA()),
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				minimalCommits[i] = convertToMinimalCommit(fullCommit, true)
+				for j, file := range fullCommit.Files {
+					minimalCommits[i].Files[j].Patch = file.GetPatch()
+				}
 			}
 
 			r, err := json.Marshal(minimalCommits)
@@ -362,6 +474,11 @@ If the SHA is not provided, the tool will attempt to acquire it by fetching the
 						Type:        "string",
 						Description: "The blob SHA of the file being replaced.",
 					},
+					"autoResolveConflict": {
+						Type:        "boolean",
+						Description: "If the provided SHA is stale because the file changed concurrently, refresh it and retry the update once instead of failing. This overwrites the concurrent change. Default is false.",
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"owner", "repo", "path", "content", "message", "branch"},
 			},
@@ -411,6 +528,10 @@ If the SHA is not provided, the tool will attempt to acquire it by fetching the
 			if sha != "" {
 				opts.SHA = github.Ptr(sha)
 			}
+			autoResolveConflict, err := OptionalBoolParamWithDefault(args, "autoResolveConflict", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			// Create or update the file
 			client, err := deps.GetClient(ctx)
@@ -441,8 +562,13 @@ If the SHA is not provided, the tool will attempt to acquire it by fetching the
 							// SHA matches current - proceed
 							opts.SHA = github.Ptr(sha)
 						case http.StatusOK:
-							// SHA is stale - reject with current SHA so user can check diff
+							if autoResolveConflict {
+								// SHA is stale but caller opted in to refreshing it: write with
+								// the provided SHA and only refresh once GitHub reports the conflict
+								break
+							}
 							currentSHA := strings.Trim(resp.Header.Get("ETag"), `"`)
+							// SHA is stale - reject with current SHA so user can check diff
 							return utils.NewToolResultError(fmt.Sprintf(
 								"SHA mismatch: provided SHA %s is stale. Current file SHA is %s. "+
 									"Use get_file_contents or compare commits to review changes before updating.",
@@ -472,6 +598,21 @@ If the SHA is not provided, the tool will attempt to acquire it by fetching the
 			}
 
 			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			if err != nil && autoResolveConflict && isSHAConflictError(err) {
+				// The file changed between our SHA lookup and the write. Refresh the SHA and retry once.
+				currentSHA, shaErr := getCurrentFileSHA(ctx, client, contentURL)
+				if shaErr != nil {
+					return utils.NewToolResultErrorFromErr("failed to refresh file SHA after conflict", shaErr), nil, nil
+				}
+				opts.SHA = ToStringPtr(currentSHA)
+				fileContent, resp, err = client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+				if err != nil && isSHAConflictError(err) {
+					return utils.NewToolResultError(fmt.Sprintf(
+						"failed to create/update file: %s still conflicts after refreshing its SHA and retrying once. "+
+							"The file is being modified concurrently; use get_file_contents to review the latest version before retrying.",
+						path)), nil, nil
+				}
+			}
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					"failed to create/update file",
@@ -619,6 +760,259 @@ func CreateRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// RepositorySettings is the output type of update_repository_settings.
+type RepositorySettings struct {
+	FullName            string `json:"full_name"`
+	HTMLURL             string `json:"html_url"`
+	Description         string `json:"description,omitempty"`
+	Homepage            string `json:"homepage,omitempty"`
+	DefaultBranch       string `json:"default_branch"`
+	Private             bool   `json:"private"`
+	Archived            bool   `json:"archived"`
+	HasIssues           bool   `json:"has_issues"`
+	HasWiki             bool   `json:"has_wiki"`
+	AllowMergeCommit    bool   `json:"allow_merge_commit"`
+	AllowSquashMerge    bool   `json:"allow_squash_merge"`
+	AllowRebaseMerge    bool   `json:"allow_rebase_merge"`
+	DeleteBranchOnMerge bool   `json:"delete_branch_on_merge"`
+}
+
+// UpdateRepositorySettings creates a tool to change the settings of a repository, including archiving it.
+func UpdateRepositorySettings(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "update_repository_settings",
+			Description: t("TOOL_UPDATE_REPOSITORY_SETTINGS_DESCRIPTION", "Update the settings of a GitHub repository. Only the provided settings are changed. Set archived to true to archive the repository or to false to unarchive it; an archived repository must be unarchived before other settings can be changed."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_UPDATE_REPOSITORY_SETTINGS_USER_TITLE", "Update repository settings"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Short description of the repository",
+					},
+					"homepage": {
+						Type:        "string",
+						Description: "URL with more information about the repository",
+					},
+					"default_branch": {
+						Type:        "string",
+						Description: "Name of an existing branch to make the default branch",
+					},
+					"private": {
+						Type:        "boolean",
+						Description: "Whether the repository is private",
+					},
+					"archived": {
+						Type:        "boolean",
+						Description: "Whether the repository is archived (read-only)",
+					},
+					"has_issues": {
+						Type:        "boolean",
+						Description: "Whether issues are enabled",
+					},
+					"has_wiki": {
+						Type:        "boolean",
+						Description: "Whether the wiki is enabled",
+					},
+					"allow_merge_commit": {
+						Type:        "boolean",
+						Description: "Whether pull requests can be merged with a merge commit",
+					},
+					"allow_squash_merge": {
+						Type:        "boolean",
+						Description: "Whether pull requests can be squash-merged",
+					},
+					"allow_rebase_merge": {
+						Type:        "boolean",
+						Description: "Whether pull requests can be rebase-merged",
+					},
+					"delete_branch_on_merge": {
+						Type:        "boolean",
+						Description: "Whether head branches are deleted automatically after pull requests are merged",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			update := &github.Repository{}
+			updateNeeded := false
+
+			stringSettings := []struct {
+				name  string
+				field **string
+			}{
+				{"description", &update.Description},
+				{"homepage", &update.Homepage},
+				{"default_branch", &update.DefaultBranch},
+			}
+			for _, s := range stringSettings {
+				if v, ok, err := OptionalParamOK[string](args, s.name); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				} else if ok {
+					*s.field = github.Ptr(v)
+					updateNeeded = true
+				}
+			}
+
+			boolSettings := []struct {
+				name  string
+				field **bool
+			}{
+				{"private", &update.Private},
+				{"archived", &update.Archived},
+				{"has_issues", &update.HasIssues},
+				{"has_wiki", &update.HasWiki},
+				{"allow_merge_commit", &update.AllowMergeCommit},
+				{"allow_squash_merge", &update.AllowSquashMerge},
+				{"allow_rebase_merge", &update.AllowRebaseMerge},
+				{"delete_branch_on_merge", &update.DeleteBranchOnMerge},
+			}
+			for _, s := range boolSettings {
+				if v, ok, err := OptionalParamOK[bool](args, s.name); err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				} else if ok {
+					*s.field = github.Ptr(v)
+					updateNeeded = true
+				}
+			}
+
+			if !updateNeeded {
+				return utils.NewToolResultError("No update parameters provided."), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			updated, resp, err := client.Repositories.Edit(ctx, owner, repo, update)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return MarshalledTextResult(RepositorySettings{
+				FullName:            updated.GetFullName(),
+				HTMLURL:             updated.GetHTMLURL(),
+				Description:         updated.GetDescription(),
+				Homepage:            updated.GetHomepage(),
+				DefaultBranch:       updated.GetDefaultBranch(),
+				Private:             updated.GetPrivate(),
+				Archived:            updated.GetArchived(),
+				HasIssues:           updated.GetHasIssues(),
+				HasWiki:             updated.GetHasWiki(),
+				AllowMergeCommit:    updated.GetAllowMergeCommit(),
+				AllowSquashMerge:    updated.GetAllowSquashMerge(),
+				AllowRebaseMerge:    updated.GetAllowRebaseMerge(),
+				DeleteBranchOnMerge: updated.GetDeleteBranchOnMerge(),
+			}), nil, nil
+		},
+	)
+}
+
+// DeleteRepository creates a tool to permanently delete a repository. The caller must repeat
+// the full repository name in the confirm argument, and the tool is only available when the
+// experiments feature flag is enabled.
+func DeleteRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "delete_repository",
+			Description: t("TOOL_DELETE_REPOSITORY_DESCRIPTION", "Permanently delete a GitHub repository, including its issues, pull requests and wiki. This cannot be undone. The confirm argument must be set to the full repository name ('owner/repo')."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_REPOSITORY_USER_TITLE", "Delete repository"),
+				ReadOnlyHint:    false,
+				DestructiveHint: github.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"confirm": {
+						Type:        "string",
+						Description: "Full name of the repository to delete ('owner/repo'), repeated to confirm the deletion",
+					},
+				},
+				Required: []string{"owner", "repo", "confirm"},
+			},
+		},
+		[]scopes.Scope{scopes.DeleteRepo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			confirm, err := RequiredParam[string](args, "confirm")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			fullName := owner + "/" + repo
+			if confirm != fullName {
+				return utils.NewToolResultError(fmt.Sprintf("confirmation %q does not match repository %q; set confirm to %q to delete it", confirm, fullName, fullName)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.Delete(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete repository %s", fullName),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully deleted repository %s", fullName)), nil, nil
+		},
+	)
+	tool.FeatureFlagEnable = FeatureFlagExperiments
+	return tool
+}
+
 // GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
 func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -648,11 +1042,29 @@ func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool
 					},
 					"ref": {
 						Type:        "string",
-						Description: "Accepts optional git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or `refs/pull/{pr_number}/head`",
+						Description: "Accepts optional branch names, tag names, commit SHAs, or git refs such as `refs/tags/{tag}`, `refs/heads/{branch}` or `refs/pull/{pr_number}/head`",
 					},
 					"sha": {
 						Type:        "string",
-						Description: "Accepts optional commit SHA. If specified, it will be used instead of ref",
+						Description: "Accepts optional commit SHA, which may be abbreviated. If specified, it will be used instead of ref",
+					},
+					"startLine": {
+						Type:        "number",
+						Description: "Optional 1-indexed line to start reading from. Only applies to text files. If omitted, reads from the first line",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"endLine": {
+						Type:        "number",
+						Description: "Optional 1-indexed line to stop reading at (inclusive). Only applies to text files. If omitted, reads to the last line",
+						Minimum:     jsonschema.Ptr(1.0),
+					},
+					"chunked": {
+						Type:        "boolean",
+						Description: "Split large text files into chunks and return only the first. Use get_result_chunk with the returned cursor to read the rest",
+					},
+					"returnPermalink": {
+						Type:        "boolean",
+						Description: "Also return a permalink to the file pinned to the resolved commit SHA, anchored to the requested lines. Use this when citing code",
 					},
 				},
 				Required: []string{"owner", "repo"},
@@ -686,11 +1098,40 @@ func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
+			startLine, err := OptionalIntParam(args, "startLine")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			endLine, err := OptionalIntParam(args, "endLine")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			chunked, err := OptionalParam[bool](args, "chunked")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			returnPermalink, err := OptionalParam[bool](args, "returnPermalink")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if startLine < 0 || endLine < 0 {
+				return utils.NewToolResultError("startLine and endLine must be positive"), nil, nil
+			}
+			lineRangeRequested := startLine > 0 || endLine > 0
+
 			client, err := deps.GetClient(ctx)
 			if err != nil {
 				return utils.NewToolResultError("failed to get GitHub client"), nil, nil
 			}
 
+			// Expand abbreviated commit SHAs so the content is pinned to an exact commit
+			if sha != "" && !looksLikeSHA(sha) {
+				sha, err = resolveRef(ctx, client, owner, repo, sha)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+			}
+
 			rawOpts, fallbackUsed, err := resolveGitReference(ctx, client, owner, repo, ref, sha)
 			if err != nil {
 				return utils.NewToolResultError(fmt.Sprintf("failed to resolve git reference: %s", err)), nil, nil
@@ -731,9 +1172,29 @@ func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool
 					successNote = fmt.Sprintf(" Note: the provided ref '%s' does not exist, default branch '%s' was used instead.", originalRef, rawOpts.Ref)
 				}
 
+				// Branch refs move, so permalinks are pinned to the commit the ref resolved to
+				permalinkNote := func(from, to int) (string, error) {
+					if !returnPermalink {
+						return "", nil
+					}
+					if rawOpts.SHA == "" {
+						return "", fmt.Errorf("failed to resolve a commit SHA for the permalink")
+					}
+					permalink, err := contentPermalink(fileContent.GetHTMLURL(), owner, repo, rawOpts.SHA, path, from, to)
+					if err != nil {
+						return "", err
+					}
+					return " Permalink: " + permalink, nil
+				}
+
 				// For files >= 1MB, return a ResourceLink instead of content
 				const maxContentSize = 1024 * 1024 // 1MB
 				if fileSize >= maxContentSize {
+					note, err := permalinkNote(0, 0)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					successNote += note
 					size := int64(fileSize)
 					resourceLink := &mcp.ResourceLink{
 						URI:   resourceURI,
@@ -760,22 +1221,57 @@ func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool
 				contentType := http.DetectContentType(contentBytes)
 
 				// Determine if content is text or binary based on detected content type
-				isTextContent := strings.HasPrefix(contentType, "text/") ||
-					contentType == "application/json" ||
-					contentType == "application/xml" ||
-					strings.HasSuffix(contentType, "+json") ||
-					strings.HasSuffix(contentType, "+xml")
+				isTextContent := isTextContentType(contentType)
 
-				if isTextContent {
-					result := &mcp.ResourceContents{
-						URI:      resourceURI,
-						Text:     content,
-						MIMEType: contentType,
+				if lineRangeRequested {
+					if !isTextContent {
+						return utils.NewToolResultText(fmt.Sprintf("Binary file: %s is a binary file (%s, %d bytes, SHA: %s) and cannot be read by line range. Omit startLine and endLine to download it.%s",
+							path, contentType, fileSize, fileSHA, successNote)), nil, nil
 					}
-					return utils.NewToolResultResource(fmt.Sprintf("successfully downloaded text file (SHA: %s)%s", fileSHA, successNote), result), nil, nil
-				}
 
-				// Binary content - encode as base64 blob
+					window, from, to, total, err := lineWindow(content, startLine, endLine)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					note, err := permalinkNote(from, to)
+					if err != nil {
+						return utils.NewToolResultError(err.Error()), nil, nil
+					}
+					result := &mcp.ResourceContents{
+						URI:      resourceURI,
+						Text:     window,
+						MIMEType: contentType,
+					}
+					return utils.NewToolResultResource(fmt.Sprintf("successfully downloaded lines %d-%d of %d from text file (SHA: %s)%s%s", from, to, total, fileSHA, successNote, note), result), nil, nil
+				}
+
+				note, err := permalinkNote(0, 0)
+				if err != nil {
+					return utils.NewToolResultError(err.Error()), nil, nil
+				}
+				successNote += note
+
+				if isTextContent {
+					message := fmt.Sprintf("successfully downloaded text file (SHA: %s)%s", fileSHA, successNote)
+					if chunked {
+						chunk, err := resultChunkStoreFromContext(ctx).chunk(content)
+						if err != nil {
+							return nil, nil, err
+						}
+						if chunk.NextCursor != "" {
+							content = chunk.Content
+							message += fmt.Sprintf(" Showing chunk 1 of %d; call get_result_chunk with cursor %q to read the rest.", chunk.TotalChunks, chunk.NextCursor)
+						}
+					}
+					result := &mcp.ResourceContents{
+						URI:      resourceURI,
+						Text:     content,
+						MIMEType: contentType,
+					}
+					return utils.NewToolResultResource(message, result), nil, nil
+				}
+
+				// Binary content - encode as base64 blob
 				blobContent := base64.StdEncoding.EncodeToString(contentBytes)
 				result := &mcp.ResourceContents{
 					URI:      resourceURI,
@@ -797,13 +1293,122 @@ func GetFileContents(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// maxReadmeContentBytes caps how much README content get_repository_readme returns.
+const maxReadmeContentBytes = 100 * 1024
+
+// ReadmeResponse is the output of get_repository_readme.
+type ReadmeResponse struct {
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	SHA       string `json:"sha"`
+	Size      int    `json:"size"`
+	HTMLURL   string `json:"html_url,omitempty"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// GetReadme creates a tool to get the README of a GitHub repository.
+func GetReadme(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_repository_readme",
+			Description: t("TOOL_GET_REPOSITORY_README_DESCRIPTION", fmt.Sprintf("Get the README of a GitHub repository, whatever its file name or case (README.md, readme.rst, ...). Returns the raw content, truncated to %d bytes.", maxReadmeContentBytes)),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_README_USER_TITLE", "Get repository README"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner (username or organization)",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"ref": {
+						Type:        "string",
+						Description: "Branch, tag or commit SHA to read the README from. Defaults to the repository's default branch",
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			ref, err := OptionalParam[string](args, "ref")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			readme, resp, err := client.Repositories.GetReadme(ctx, owner, repo, &github.RepositoryContentGetOptions{Ref: ref})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get README",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			content, err := readme.GetContent()
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("failed to decode README content: %s", err)), nil, nil
+			}
+
+			response := ReadmeResponse{
+				Name:    readme.GetName(),
+				Path:    readme.GetPath(),
+				SHA:     readme.GetSHA(),
+				Size:    readme.GetSize(),
+				HTMLURL: readme.GetHTMLURL(),
+			}
+			response.Content, response.Truncated = truncateText(content, maxReadmeContentBytes)
+
+			return MarshalledTextResult(response), nil, nil
+		},
+	)
+}
+
+// ForkResult is the output type of fork_repository.
+type ForkResult struct {
+	ID             int64  `json:"id"`
+	FullName       string `json:"full_name"`
+	URL            string `json:"url"`
+	DefaultBranch  string `json:"default_branch,omitempty"`
+	AlreadyExisted bool   `json:"already_existed"`
+	// Ready is only set when the caller asked to wait for the fork to become usable.
+	Ready *bool `json:"ready,omitempty"`
+}
+
+const (
+	defaultForkReadyTimeoutSeconds = 30
+	maxForkReadyTimeoutSeconds     = 120
+)
+
 // ForkRepository creates a tool to fork a repository.
 func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
 			Name:        "fork_repository",
-			Description: t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization"),
+			Description: t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization. If the fork already exists it is returned instead of creating a new one. Forking happens asynchronously; set waitForReady to wait until the fork can be used."),
 			Icons:       octicons.Icons("repo-forked"),
 			Annotations: &mcp.ToolAnnotations{
 				Title:        t("TOOL_FORK_REPOSITORY_USER_TITLE", "Fork repository"),
@@ -824,6 +1429,20 @@ func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Organization to fork to",
 					},
+					"defaultBranchOnly": {
+						Type:        "boolean",
+						Description: "Only fork the default branch",
+					},
+					"waitForReady": {
+						Type:        "boolean",
+						Description: "Wait until the fork's default branch is available before returning",
+					},
+					"timeoutSeconds": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of seconds to wait when waitForReady is set (default %d, max %d)", defaultForkReadyTimeoutSeconds, maxForkReadyTimeoutSeconds),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxForkReadyTimeoutSeconds)),
+					},
 				},
 				Required: []string{"owner", "repo"},
 			},
@@ -842,8 +1461,25 @@ func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			defaultBranchOnly, err := OptionalParam[bool](args, "defaultBranchOnly")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			waitForReady, err := OptionalParam[bool](args, "waitForReady")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			timeoutSeconds, err := OptionalIntParamWithDefault(args, "timeoutSeconds", defaultForkReadyTimeoutSeconds)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if timeoutSeconds < 1 || timeoutSeconds > maxForkReadyTimeoutSeconds {
+				return utils.NewToolResultError(fmt.Sprintf("timeoutSeconds must be between 1 and %d", maxForkReadyTimeoutSeconds)), nil, nil
+			}
 
-			opts := &github.RepositoryCreateForkOptions{}
+			opts := &github.RepositoryCreateForkOptions{
+				DefaultBranchOnly: defaultBranchOnly,
+			}
 			if org != "" {
 				opts.Organization = org
 			}
@@ -852,41 +1488,331 @@ func ForkRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
+
+			requestTime := time.Now()
 			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+				// Check if it's an acceptedError. An acceptedError indicates that the fork is being
+				// created asynchronously; the response body still describes the fork.
+				var acceptedErr *github.AcceptedError
+				if resp == nil || resp.StatusCode != http.StatusAccepted || !errors.As(err, &acceptedErr) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to fork repository",
+						resp,
+						err,
+					), nil, nil
+				}
+				forkedRepo = &github.Repository{}
+				if err := json.Unmarshal(acceptedErr.Raw, forkedRepo); err != nil || forkedRepo.GetFullName() == "" {
 					return utils.NewToolResultText("Fork is in progress"), nil, nil
 				}
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := ForkResult{
+				ID:            forkedRepo.GetID(),
+				FullName:      forkedRepo.GetFullName(),
+				URL:           forkedRepo.GetHTMLURL(),
+				DefaultBranch: forkedRepo.GetDefaultBranch(),
+				// GitHub returns the existing fork instead of creating a new one, so a fork
+				// created well before this request must have existed already.
+				AlreadyExisted: forkedRepo.CreatedAt != nil && forkedRepo.CreatedAt.Before(requestTime.Add(-time.Minute)),
+			}
+
+			if waitForReady {
+				ready := waitForForkReady(ctx, client, forkedRepo, time.Duration(timeoutSeconds)*time.Second)
+				result.Ready = &ready
+			}
+
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// waitForForkReady polls the fork's default branch until it is available or the timeout
+// elapses, and reports whether the fork became ready.
+func waitForForkReady(ctx context.Context, client *github.Client, fork *github.Repository, timeout time.Duration) bool {
+	forkOwner := fork.GetOwner().GetLogin()
+	forkName := fork.GetName()
+	branch := fork.GetDefaultBranch()
+	if forkOwner == "" || forkName == "" || branch == "" {
+		return false
+	}
+
+	pollConfig := getPollConfig(ctx)
+	deadline := time.Now().Add(timeout)
+	for {
+		_, resp, err := client.Repositories.GetBranch(ctx, forkOwner, forkName, branch, 0)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err == nil {
+			return true
+		}
+		if time.Now().Add(pollConfig.Delay).After(deadline) {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(pollConfig.Delay):
+		}
+	}
+}
+
+// MinimalFork is the trimmed output type for a repository fork.
+type MinimalFork struct {
+	FullName        string `json:"full_name"`
+	Owner           string `json:"owner"`
+	StargazersCount int    `json:"stargazers_count"`
+	PushedAt        string `json:"pushed_at,omitempty"`
+	HTMLURL         string `json:"html_url"`
+}
+
+// ListForks creates a tool to list the forks of a repository.
+func ListForks(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_forks",
+			Description: t("TOOL_LIST_FORKS_DESCRIPTION", "List forks of a GitHub repository"),
+			Icons:       octicons.Icons("repo-forked"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_FORKS_USER_TITLE", "List repository forks"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"sort": {
+						Type:        "string",
+						Description: "Sort order for forks. Default is newest.",
+						Enum:        []any{"newest", "oldest", "stargazers"},
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			sort, err := OptionalParam[string](args, "sort")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.RepositoryListForksOptions{
+				Sort: sort,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			forks, resp, err := client.Repositories.ListForks(ctx, owner, repo, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list forks for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalForks := make([]MinimalFork, 0, len(forks))
+			for _, fork := range forks {
+				minimalFork := MinimalFork{
+					FullName:        fork.GetFullName(),
+					Owner:           fork.GetOwner().GetLogin(),
+					StargazersCount: fork.GetStargazersCount(),
+					HTMLURL:         fork.GetHTMLURL(),
+				}
+				if fork.PushedAt != nil {
+					minimalFork.PushedAt = fork.PushedAt.Format(time.RFC3339)
+				}
+				minimalForks = append(minimalForks, minimalFork)
+			}
+
+			r, err := json.Marshal(minimalForks)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal forks: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// RepositoryInvitation is the trimmed output type for a pending repository invitation.
+type RepositoryInvitation struct {
+	ID          int64        `json:"id"`
+	Repository  string       `json:"repository"`
+	Inviter     *MinimalUser `json:"inviter,omitempty"`
+	Permissions string       `json:"permissions"`
+	CreatedAt   string       `json:"created_at,omitempty"`
+	Expired     bool         `json:"expired"`
+	HTMLURL     string       `json:"html_url,omitempty"`
+}
+
+// ListRepositoryInvitations creates a tool to list the pending repository invitations of the authenticated user.
+func ListRepositoryInvitations(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_repository_invitations",
+			Description: t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List pending repository invitations for the authenticated user, including the repository, who sent the invitation and the permissions it grants"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_REPOSITORY_INVITATIONS_USER_TITLE", "List repository invitations"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type:       "object",
+				Properties: map[string]*jsonschema.Schema{},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Users.ListInvitations(ctx, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to fork repository",
+					"failed to list repository invitations",
 					resp,
 					err,
 				), nil, nil
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusAccepted {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+			result := make([]RepositoryInvitation, 0, len(invitations))
+			for _, inv := range invitations {
+				invitation := RepositoryInvitation{
+					ID:          inv.GetID(),
+					Repository:  inv.GetRepo().GetFullName(),
+					Permissions: inv.GetPermissions(),
+					Expired:     inv.GetExpired(),
+					HTMLURL:     inv.GetHTMLURL(),
+				}
+				if inviter := inv.GetInviter(); inviter != nil {
+					invitation.Inviter = &MinimalUser{
+						Login:      inviter.GetLogin(),
+						ID:         inviter.GetID(),
+						ProfileURL: inviter.GetHTMLURL(),
+						AvatarURL:  inviter.GetAvatarURL(),
+					}
+				}
+				if inv.CreatedAt != nil {
+					invitation.CreatedAt = inv.CreatedAt.Format(time.RFC3339)
 				}
-				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to fork repository", resp, body), nil, nil
+				result = append(result, invitation)
 			}
 
-			// Return minimal response with just essential information
-			minimalResponse := MinimalResponse{
-				ID:  fmt.Sprintf("%d", forkedRepo.GetID()),
-				URL: forkedRepo.GetHTMLURL(),
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// ManageRepositoryInvitation creates a tool to accept or decline a repository invitation.
+func ManageRepositoryInvitation(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "manage_repository_invitation",
+			Description: t("TOOL_MANAGE_REPOSITORY_INVITATION_DESCRIPTION", "Accept or decline a pending repository invitation for the authenticated user. Use list_repository_invitations to find the invitation ID."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_REPOSITORY_INVITATION_USER_TITLE", "Accept or decline repository invitation"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"invitation_id": {
+						Type:        "number",
+						Description: "ID of the repository invitation",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to accept or decline the invitation",
+						Enum:        []any{"accept", "decline"},
+					},
+				},
+				Required: []string{"invitation_id", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			invitationID, err := RequiredBigInt(args, "invitation_id")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
 			}
 
-			r, err := json.Marshal(minimalResponse)
+			client, err := deps.GetClient(ctx)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			return utils.NewToolResultText(string(r)), nil, nil
+			var resp *github.Response
+			switch action {
+			case "accept":
+				resp, err = client.Users.AcceptInvitation(ctx, invitationID)
+			case "decline":
+				resp, err = client.Users.DeclineInvitation(ctx, invitationID)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid action %q: must be 'accept' or 'decline'", action)), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to %s repository invitation %d", action, invitationID),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			past := "accepted"
+			if action == "decline" {
+				past = "declined"
+			}
+			return utils.NewToolResultText(fmt.Sprintf("Successfully %s repository invitation %d", past, invitationID)), nil, nil
 		},
 	)
 }
@@ -1144,7 +2070,7 @@ func CreateBranch(t translations.TranslationHelperFunc) inventory.ServerTool {
 
 			if fromBranch == "" {
 				// Get default branch if from_branch not specified
-				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				repository, resp, err := getRepositoryCached(ctx, client, owner, repo, false)
 				if err != nil {
 					return ghErrors.NewGitHubAPIErrorResponse(ctx,
 						"failed to get repository",
@@ -1152,7 +2078,9 @@ func CreateBranch(t translations.TranslationHelperFunc) inventory.ServerTool {
 						err,
 					), nil, nil
 				}
-				defer func() { _ = resp.Body.Close() }()
+				if resp != nil {
+					defer func() { _ = resp.Body.Close() }()
+				}
 
 				fromBranch = *repository.DefaultBranch
 			}
@@ -1242,6 +2170,11 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Commit message",
 					},
+					"autoResolveConflict": {
+						Type:        "boolean",
+						Description: "If the branch moved while pushing because of a concurrent push, rebuild the commit on top of the new branch head and retry once instead of failing. Default is false.",
+						Default:     json.RawMessage(`false`),
+					},
 				},
 				Required: []string{"owner", "repo", "branch", "files", "message"},
 			},
@@ -1264,6 +2197,10 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			autoResolveConflict, err := OptionalBoolParamWithDefault(args, "autoResolveConflict", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
 			// Parse files parameter - this should be an array of objects with path and content
 			filesObj, ok := args["files"].([]any)
@@ -1345,78 +2282,95 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 			}
 
 			// Create tree entries for all files (or remaining files if empty repo)
-			var entries []*github.TreeEntry
+			entries, err := treeEntriesFromFiles(filesObj)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
 
-			for _, file := range filesObj {
-				fileMap, ok := file.(map[string]any)
-				if !ok {
-					return utils.NewToolResultError("each file must be an object with path and content"), nil, nil
+			// Create the commit and move the branch to it. If the branch moved concurrently and the
+			// caller opted in, rebuild the commit on top of the new branch head and retry once.
+			var updatedRef *github.Reference
+			for attempt := 0; ; attempt++ {
+				// Create a new tree with the file entries (baseCommit is now guaranteed to exist)
+				newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to create tree",
+						resp,
+						err,
+					), nil, nil
 				}
-
-				path, ok := fileMap["path"].(string)
-				if !ok || path == "" {
-					return utils.NewToolResultError("each file must have a path"), nil, nil
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
 				}
 
-				content, ok := fileMap["content"].(string)
-				if !ok {
-					return utils.NewToolResultError("each file must have content"), nil, nil
+				// Create a new commit (baseCommit always has a value now)
+				commit := github.Commit{
+					Message: github.Ptr(message),
+					Tree:    newTree,
+					Parents: []*github.Commit{{SHA: baseCommit.SHA}},
+				}
+				newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to create commit",
+						resp,
+						err,
+					), nil, nil
+				}
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
 				}
 
-				// Create a tree entry for the file
-				entries = append(entries, &github.TreeEntry{
-					Path:    github.Ptr(path),
-					Mode:    github.Ptr("100644"), // Regular file mode
-					Type:    github.Ptr("blob"),
-					Content: github.Ptr(content),
+				// Update the reference to point to the new commit
+				updatedRef, resp, err = client.Git.UpdateRef(ctx, owner, repo, *ref.Ref, github.UpdateRef{
+					SHA:   *newCommit.SHA,
+					Force: github.Ptr(false),
 				})
-			}
-
-			// Create a new tree with the file entries (baseCommit is now guaranteed to exist)
-			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to create tree",
-					resp,
-					err,
-				), nil, nil
-			}
-			if resp != nil && resp.Body != nil {
-				defer func() { _ = resp.Body.Close() }()
-			}
-
-			// Create a new commit (baseCommit always has a value now)
-			commit := github.Commit{
-				Message: github.Ptr(message),
-				Tree:    newTree,
-				Parents: []*github.Commit{{SHA: baseCommit.SHA}},
-			}
-			newCommit, resp, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to create commit",
-					resp,
-					err,
-				), nil, nil
-			}
-			if resp != nil && resp.Body != nil {
-				defer func() { _ = resp.Body.Close() }()
-			}
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
+				}
+				if err == nil {
+					break
+				}
+				if !autoResolveConflict || !isSHAConflictError(err) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to update reference",
+						resp,
+						err,
+					), nil, nil
+				}
+				if attempt > 0 {
+					return utils.NewToolResultError(fmt.Sprintf(
+						"failed to update reference: branch %s still conflicts after refreshing its head and retrying once. "+
+							"The branch is being updated concurrently; review the latest commits before retrying.",
+						branch)), nil, nil
+				}
 
-			// Update the reference to point to the new commit
-			ref.Object.SHA = newCommit.SHA
-			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, *ref.Ref, github.UpdateRef{
-				SHA:   *newCommit.SHA,
-				Force: github.Ptr(false),
-			})
-			if err != nil {
-				return ghErrors.NewGitHubAPIErrorResponse(ctx,
-					"failed to update reference",
-					resp,
-					err,
-				), nil, nil
+				// Refresh the branch head and rebuild on top of it
+				ref, resp, err = client.Git.GetRef(ctx, owner, repo, *ref.Ref)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to refresh branch reference",
+						resp,
+						err,
+					), nil, nil
+				}
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
+				}
+				baseCommit, resp, err = client.Git.GetCommit(ctx, owner, repo, *ref.Object.SHA)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to get refreshed base commit",
+						resp,
+						err,
+					), nil, nil
+				}
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
+				}
 			}
-			defer func() { _ = resp.Body.Close() }()
 
 			r, err := json.Marshal(updatedRef)
 			if err != nil {
@@ -1428,6 +2382,17 @@ func PushFiles(t translations.TranslationHelperFunc) inventory.ServerTool {
 	)
 }
 
+const (
+	defaultTagCommitDateLookups = 10
+	maxTagCommitDateLookups     = 30
+)
+
+// TagWithCommitDate is a repository tag, optionally annotated with the date of its commit.
+type TagWithCommitDate struct {
+	*github.RepositoryTag
+	CommitDate string `json:"commit_date,omitempty"`
+}
+
 // ListTags creates a tool to list tags in a GitHub repository.
 func ListTags(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -1450,6 +2415,20 @@ func ListTags(t translations.TranslationHelperFunc) inventory.ServerTool {
 						Type:        "string",
 						Description: "Repository name",
 					},
+					"prefix": {
+						Type:        "string",
+						Description: "Only return tags whose name starts with this prefix, e.g. 'v'. Applied to the requested page of tags",
+					},
+					"includeCommitDate": {
+						Type:        "boolean",
+						Description: "Add the date of each tag's commit as commit_date, so tags can be ordered chronologically. Costs one extra request per tag, up to maxTags",
+					},
+					"maxTags": {
+						Type:        "number",
+						Description: fmt.Sprintf("Maximum number of tags to look up commit dates for when includeCommitDate is set (default %d, max %d). Remaining tags are returned without a commit date", defaultTagCommitDateLookups, maxTagCommitDateLookups),
+						Minimum:     jsonschema.Ptr(1.0),
+						Maximum:     jsonschema.Ptr(float64(maxTagCommitDateLookups)),
+					},
 				},
 				Required: []string{"owner", "repo"},
 			}),
@@ -1464,6 +2443,21 @@ func ListTags(t translations.TranslationHelperFunc) inventory.ServerTool {
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
 			}
+			prefix, err := OptionalParam[string](args, "prefix")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeCommitDate, err := OptionalParam[bool](args, "includeCommitDate")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			maxTags, err := OptionalIntParamWithDefault(args, "maxTags", defaultTagCommitDateLookups)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if maxTags < 1 || maxTags > maxTagCommitDateLookups {
+				return utils.NewToolResultError(fmt.Sprintf("maxTags must be between 1 and %d", maxTagCommitDateLookups)), nil, nil
+			}
 			pagination, err := OptionalPaginationParams(args)
 			if err != nil {
 				return utils.NewToolResultError(err.Error()), nil, nil
@@ -1497,7 +2491,35 @@ func ListTags(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list tags", resp, body), nil, nil
 			}
 
-			r, err := json.Marshal(tags)
+			result := make([]TagWithCommitDate, 0, len(tags))
+			for _, tag := range tags {
+				if strings.HasPrefix(tag.GetName(), prefix) {
+					result = append(result, TagWithCommitDate{RepositoryTag: tag})
+				}
+			}
+
+			if includeCommitDate {
+				for i := range result[:min(maxTags, len(result))] {
+					sha := result[i].GetCommit().GetSHA()
+					if sha == "" {
+						continue
+					}
+					commit, resp, err := client.Git.GetCommit(ctx, owner, repo, sha)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to get commit for tag %s", result[i].GetName()),
+							resp,
+							err,
+						), nil, nil
+					}
+					_ = resp.Body.Close()
+					if date := commit.GetCommitter().Date; date != nil {
+						result[i].CommitDate = date.Format(time.RFC3339)
+					}
+				}
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1823,6 +2845,164 @@ func GetReleaseByTag(t translations.TranslationHelperFunc) inventory.ServerTool
 	)
 }
 
+// ListReleaseAssets creates a tool to list the assets attached to a release.
+func ListReleaseAssets(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_release_assets",
+			Description: t("TOOL_LIST_RELEASE_ASSETS_DESCRIPTION", "List the assets attached to a release in a GitHub repository. Use get_release_by_tag or list_releases to find the release ID."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_RELEASE_ASSETS_USER_TITLE", "List release assets"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"releaseID": {
+						Type:        "number",
+						Description: "The ID of the release",
+					},
+				},
+				Required: []string{"owner", "repo", "releaseID"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			releaseID, err := RequiredBigInt(args, "releaseID")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			assets, resp, err := client.Repositories.ListReleaseAssets(ctx, owner, repo, releaseID, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list assets for release %d", releaseID),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			minimalAssets := make([]MinimalReleaseAsset, 0, len(assets))
+			for _, asset := range assets {
+				minimalAssets = append(minimalAssets, MinimalReleaseAsset{
+					ID:                 asset.GetID(),
+					Name:               asset.GetName(),
+					Label:              asset.GetLabel(),
+					ContentType:        asset.GetContentType(),
+					Size:               asset.GetSize(),
+					DownloadCount:      asset.GetDownloadCount(),
+					BrowserDownloadURL: asset.GetBrowserDownloadURL(),
+				})
+			}
+
+			r, err := json.Marshal(minimalAssets)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal release assets: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// DeleteRelease creates a tool to delete a release from a GitHub repository.
+func DeleteRelease(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "delete_release",
+			Description: t("TOOL_DELETE_RELEASE_DESCRIPTION", "Delete a release from a GitHub repository. The Git tag the release points to is not deleted; use delete_tag to remove it."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:           t("TOOL_DELETE_RELEASE_USER_TITLE", "Delete release"),
+				ReadOnlyHint:    false,
+				DestructiveHint: github.Ptr(true),
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"releaseID": {
+						Type:        "number",
+						Description: "The ID of the release to delete",
+					},
+				},
+				Required: []string{"owner", "repo", "releaseID"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			releaseID, err := RequiredBigInt(args, "releaseID")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.DeleteRelease(ctx, owner, repo, releaseID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete release %d from %s/%s", releaseID, owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return utils.NewToolResultText(fmt.Sprintf("Successfully deleted release %d from %s/%s", releaseID, owner, repo)), nil, nil
+		},
+	)
+}
+
 // ListStarredRepositories creates a tool to list starred repositories for the authenticated user or a specified user.
 func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -1955,6 +3135,144 @@ func ListStarredRepositories(t translations.TranslationHelperFunc) inventory.Ser
 	)
 }
 
+// MinimalStargazer is the trimmed output type for a repository stargazer.
+type MinimalStargazer struct {
+	Login     string `json:"login"`
+	StarredAt string `json:"starred_at,omitempty"`
+}
+
+// ListStargazers creates a tool to list the users who starred a repository.
+func ListStargazers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataStargazers,
+		mcp.Tool{
+			Name:        "list_stargazers",
+			Description: t("TOOL_LIST_STARGAZERS_DESCRIPTION", "List the users who have starred a repository"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_STARGAZERS_USER_TITLE", "List stargazers"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"includeTimestamps": {
+						Type:        "boolean",
+						Description: "Include when each user starred the repository (starred_at). Default is false.",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includeTimestamps, err := OptionalBoolParamWithDefault(args, "includeTimestamps", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			var stargazers []MinimalStargazer
+			var resp *github.Response
+			if includeTimestamps {
+				// go-github always requests the star+json media type, which adds starred_at
+				var starred []*github.Stargazer
+				starred, resp, err = client.Activity.ListStargazers(ctx, owner, repo, opts)
+				if err == nil {
+					stargazers = make([]MinimalStargazer, 0, len(starred))
+					for _, s := range starred {
+						stargazer := MinimalStargazer{Login: s.GetUser().GetLogin()}
+						if s.StarredAt != nil {
+							stargazer.StarredAt = s.StarredAt.Format(time.RFC3339)
+						}
+						stargazers = append(stargazers, stargazer)
+					}
+				}
+			} else {
+				// Use the default media type, which returns plain user objects
+				u := fmt.Sprintf("repos/%s/%s/stargazers", url.PathEscape(owner), url.PathEscape(repo))
+				values := url.Values{}
+				if opts.Page != 0 {
+					values.Set("page", strconv.Itoa(opts.Page))
+				}
+				if opts.PerPage != 0 {
+					values.Set("per_page", strconv.Itoa(opts.PerPage))
+				}
+				if len(values) > 0 {
+					u += "?" + values.Encode()
+				}
+				var req *http.Request
+				req, err = client.NewRequest(http.MethodGet, u, nil)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to create request: %w", err)
+				}
+				var users []*github.User
+				resp, err = client.Do(ctx, req, &users)
+				if err == nil {
+					stargazers = make([]MinimalStargazer, 0, len(users))
+					for _, u := range users {
+						stargazers = append(stargazers, MinimalStargazer{Login: u.GetLogin()})
+					}
+				}
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list stargazers for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(stargazers)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal stargazers: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// StarResult is returned by star_repository and unstar_repository. Changed
+// is false when the repository was already in the requested state.
+type StarResult struct {
+	Owner   string `json:"owner"`
+	Repo    string `json:"repo"`
+	Starred bool   `json:"starred"`
+	Changed bool   `json:"changed"`
+	Message string `json:"message"`
+}
+
 // StarRepository creates a tool to star a repository.
 func StarRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
@@ -1998,7 +3316,26 @@ func StarRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			resp, err := client.Activity.Star(ctx, owner, repo)
+			starred, resp, err := client.Activity.IsStarred(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to check star status of repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			if starred {
+				return MarshalledTextResult(StarResult{
+					Owner:   owner,
+					Repo:    repo,
+					Starred: true,
+					Changed: false,
+					Message: fmt.Sprintf("Repository %s/%s is already starred", owner, repo),
+				}), nil, nil
+			}
+
+			resp, err = client.Activity.Star(ctx, owner, repo)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					fmt.Sprintf("failed to star repository %s/%s", owner, repo),
@@ -2016,7 +3353,13 @@ func StarRepository(t translations.TranslationHelperFunc) inventory.ServerTool {
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to star repository", resp, body), nil, nil
 			}
 
-			return utils.NewToolResultText(fmt.Sprintf("Successfully starred repository %s/%s", owner, repo)), nil, nil
+			return MarshalledTextResult(StarResult{
+				Owner:   owner,
+				Repo:    repo,
+				Starred: true,
+				Changed: true,
+				Message: fmt.Sprintf("Successfully starred repository %s/%s", owner, repo),
+			}), nil, nil
 		},
 	)
 }
@@ -2063,7 +3406,26 @@ func UnstarRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			resp, err := client.Activity.Unstar(ctx, owner, repo)
+			starred, resp, err := client.Activity.IsStarred(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to check star status of repository %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			_ = resp.Body.Close()
+			if !starred {
+				return MarshalledTextResult(StarResult{
+					Owner:   owner,
+					Repo:    repo,
+					Starred: false,
+					Changed: false,
+					Message: fmt.Sprintf("Repository %s/%s is already not starred", owner, repo),
+				}), nil, nil
+			}
+
+			resp, err = client.Activity.Unstar(ctx, owner, repo)
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
 					fmt.Sprintf("failed to unstar repository %s/%s", owner, repo),
@@ -2081,7 +3443,13 @@ func UnstarRepository(t translations.TranslationHelperFunc) inventory.ServerTool
 				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to unstar repository", resp, body), nil, nil
 			}
 
-			return utils.NewToolResultText(fmt.Sprintf("Successfully unstarred repository %s/%s", owner, repo)), nil, nil
+			return MarshalledTextResult(StarResult{
+				Owner:   owner,
+				Repo:    repo,
+				Starred: false,
+				Changed: true,
+				Message: fmt.Sprintf("Successfully unstarred repository %s/%s", owner, repo),
+			}), nil, nil
 		},
 	)
 }
@@ -3,8 +3,10 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
@@ -175,6 +177,55 @@ func looksLikeSHA(s string) bool {
 	return true
 }
 
+// isTextContentType returns true if a detected content type (see http.DetectContentType)
+// describes text rather than binary content.
+func isTextContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/") ||
+		contentType == "application/json" ||
+		contentType == "application/xml" ||
+		strings.HasSuffix(contentType, "+json") ||
+		strings.HasSuffix(contentType, "+xml")
+}
+
+// truncateText cuts text to at most maxBytes bytes without splitting a UTF-8 rune,
+// reporting whether anything was cut.
+func truncateText(text string, maxBytes int) (string, bool) {
+	if len(text) <= maxBytes {
+		return text, false
+	}
+	return strings.ToValidUTF8(text[:maxBytes], ""), true
+}
+
+// resolveRef resolves a branch name, tag name, fully-qualified ref, or (possibly abbreviated)
+// commit SHA to the full SHA of the commit it points to. Annotated tags are peeled to their commit.
+// An empty ref resolves to the head of the repository's default branch.
+func resolveRef(ctx context.Context, client *github.Client, owner, repo, ref string) (string, error) {
+	if looksLikeSHA(ref) {
+		return ref, nil
+	}
+
+	commitish := ref
+	switch {
+	case commitish == "":
+		commitish = "HEAD"
+	case strings.HasPrefix(commitish, "refs/heads/"):
+		commitish = strings.TrimPrefix(commitish, "refs/heads/")
+	case strings.HasPrefix(commitish, "refs/tags/"):
+		commitish = strings.TrimPrefix(commitish, "refs/tags/")
+	}
+
+	sha, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, commitish, "")
+	if err != nil {
+		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to resolve ref", resp, err)
+		return "", fmt.Errorf("could not resolve ref %q to a commit: %w", ref, err)
+	}
+	if resp != nil && resp.Body != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+
+	return sha, nil
+}
+
 // resolveGitReference takes a user-provided ref and sha and resolves them into a
 // definitive commit SHA and its corresponding fully-qualified reference.
 //
@@ -303,7 +354,7 @@ func resolveGitReference(ctx context.Context, githubClient *github.Client, owner
 }
 
 func resolveDefaultBranch(ctx context.Context, githubClient *github.Client, owner, repo string) (*github.Reference, error) {
-	repoInfo, resp, err := githubClient.Repositories.Get(ctx, owner, repo)
+	repoInfo, resp, err := getRepositoryCached(ctx, githubClient, owner, repo, false)
 	if err != nil {
 		_, _ = ghErrors.NewGitHubAPIErrorToCtx(ctx, "failed to get repository info", resp, err)
 		return nil, fmt.Errorf("failed to get repository info: %w", err)
@@ -327,3 +378,127 @@ func resolveDefaultBranch(ctx context.Context, githubClient *github.Client, owne
 
 	return defaultRef, nil
 }
+
+// isSHAConflictError reports whether err is a GitHub API error indicating that the
+// provided blob SHA or branch head is stale, i.e. someone else pushed concurrently.
+// The API signals this with a 409 Conflict, or a 422 mentioning the SHA or fast-forward.
+func isSHAConflictError(err error) bool {
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) || ghErr.Response == nil {
+		return false
+	}
+	switch ghErr.Response.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusUnprocessableEntity:
+		msg := strings.ToLower(ghErr.Message)
+		return strings.Contains(msg, "sha") || strings.Contains(msg, "fast forward")
+	default:
+		return false
+	}
+}
+
+// getCurrentFileSHA fetches the current blob SHA of the file at contentURL using a HEAD request.
+// It returns an empty string if the file does not exist.
+func getCurrentFileSHA(ctx context.Context, client *github.Client, contentURL string) (string, error) {
+	req, err := client.NewRequest(http.MethodHead, contentURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(ctx, req, nil)
+	if resp != nil && resp.Body != nil {
+		defer func() { _ = resp.Body.Close() }()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get current file SHA: %w", err)
+	}
+	return strings.Trim(resp.Header.Get("ETag"), `"`), nil
+}
+
+// lineWindow returns the lines of content between startLine and endLine (1-indexed, inclusive),
+// along with the resolved range and the total number of lines.
+// A startLine of 0 means the first line and an endLine of 0 means the last line;
+// endLine is clamped to the total line count.
+func lineWindow(content string, startLine, endLine int) (window string, from, to, total int, err error) {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	total = len(lines)
+
+	from, to = startLine, endLine
+	if from == 0 {
+		from = 1
+	}
+	if to == 0 || to > total {
+		to = total
+	}
+	if from > total {
+		return "", 0, 0, total, fmt.Errorf("startLine %d is beyond the end of the file (%d lines)", startLine, total)
+	}
+	if from > to {
+		return "", 0, 0, total, fmt.Errorf("startLine %d must not be greater than endLine %d", startLine, endLine)
+	}
+
+	return strings.Join(lines[from-1:to], "\n") + "\n", from, to, total, nil
+}
+
+// contentPermalink returns a link to path pinned to commit sha, anchored to the lines
+// from-to when from is set. The web host is taken from the file's html_url, so links
+// on GitHub Enterprise Server point at the right host.
+func contentPermalink(htmlURL, owner, repo, sha, path string, from, to int) (string, error) {
+	u, err := url.Parse(htmlURL)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("failed to build permalink from %q", htmlURL)
+	}
+	// Keep any path prefix the web host is served under
+	prefix := ""
+	repoPath := strings.ToLower("/" + owner + "/" + repo + "/")
+	if idx := strings.Index(strings.ToLower(u.Path), repoPath); idx > 0 {
+		prefix = u.Path[:idx]
+	}
+
+	link := url.URL{
+		Scheme: u.Scheme,
+		Host:   u.Host,
+		Path:   fmt.Sprintf("%s/%s/%s/blob/%s/%s", prefix, owner, repo, sha, path),
+	}
+	switch {
+	case from > 0 && to > from:
+		link.Fragment = fmt.Sprintf("L%d-L%d", from, to)
+	case from > 0:
+		link.Fragment = fmt.Sprintf("L%d", from)
+	}
+	return link.String(), nil
+}
+
+// treeEntriesFromFiles converts a "files" argument (an array of objects with path and
+// content) into blob tree entries suitable for Git.CreateTree.
+func treeEntriesFromFiles(files []any) ([]*github.TreeEntry, error) {
+	var entries []*github.TreeEntry
+	for _, file := range files {
+		fileMap, ok := file.(map[string]any)
+		if !ok {
+			return nil, errors.New("each file must be an object with path and content")
+		}
+
+		path, ok := fileMap["path"].(string)
+		if !ok || path == "" {
+			return nil, errors.New("each file must have a path")
+		}
+
+		content, ok := fileMap["content"].(string)
+		if !ok {
+			return nil, errors.New("each file must have content")
+		}
+
+		// Create a tree entry for the file
+		entries = append(entries, &github.TreeEntry{
+			Path:    github.Ptr(path),
+			Mode:    github.Ptr("100644"), // Regular file mode
+			Type:    github.Ptr("blob"),
+			Content: github.Ptr(content),
+		})
+	}
+	return entries, nil
+}
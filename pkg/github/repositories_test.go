@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
@@ -37,6 +40,9 @@ func Test_GetFileContents(t *testing.T) {
 	assert.Contains(t, schema.Properties, "path")
 	assert.Contains(t, schema.Properties, "ref")
 	assert.Contains(t, schema.Properties, "sha")
+	assert.Contains(t, schema.Properties, "startLine")
+	assert.Contains(t, schema.Properties, "endLine")
+	assert.Contains(t, schema.Properties, "returnPermalink")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
 	// Mock response for raw content
@@ -106,6 +112,154 @@ func Test_GetFileContents(t *testing.T) {
 				MIMEType: "text/plain; charset=utf-8",
 			},
 		},
+		{
+			name: "successful text content fetch with line window",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: mockResponse(t, http.StatusOK, "{\"ref\": \"refs/heads/main\", \"object\": {\"sha\": \"\"}}"),
+				GetReposByOwnerByRepo:            mockResponse(t, http.StatusOK, "{\"name\": \"repo\", \"default_branch\": \"main\"}"),
+				GetReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					encodedContent := base64.StdEncoding.EncodeToString(mockRawContent)
+					fileContent := &github.RepositoryContent{
+						Name:     github.Ptr("README.md"),
+						Path:     github.Ptr("README.md"),
+						SHA:      github.Ptr("abc123"),
+						Type:     github.Ptr("file"),
+						Content:  github.Ptr(encodedContent),
+						Size:     github.Ptr(len(mockRawContent)),
+						Encoding: github.Ptr("base64"),
+					}
+					contentBytes, _ := json.Marshal(fileContent)
+					_, _ = w.Write(contentBytes)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "README.md",
+				"ref":       "refs/heads/main",
+				"startLine": float64(2),
+				"endLine":   float64(3),
+			},
+			expectError: false,
+			expectedResult: mcp.ResourceContents{
+				URI:      "repo://owner/repo/refs/heads/main/contents/README.md",
+				Text:     "\nThis is a test repository.\n",
+				MIMEType: "text/plain; charset=utf-8",
+			},
+			expectedMsg: "successfully downloaded lines 2-3 of 3 from text file (SHA: abc123)",
+		},
+		{
+			name: "text content with permalink pinned to the resolved commit",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: mockResponse(t, http.StatusOK, "{\"ref\": \"refs/heads/main\", \"object\": {\"sha\": \"5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b\"}}"),
+				GetReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, r *http.Request) {
+					// Content is fetched at the resolved commit, not the moving branch
+					assert.Equal(t, "5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b", r.URL.Query().Get("ref"))
+					w.WriteHeader(http.StatusOK)
+					encodedContent := base64.StdEncoding.EncodeToString(mockRawContent)
+					fileContent := &github.RepositoryContent{
+						Name:     github.Ptr("README.md"),
+						Path:     github.Ptr("README.md"),
+						SHA:      github.Ptr("abc123"),
+						Type:     github.Ptr("file"),
+						Content:  github.Ptr(encodedContent),
+						Size:     github.Ptr(len(mockRawContent)),
+						Encoding: github.Ptr("base64"),
+						HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/README.md"),
+					}
+					contentBytes, _ := json.Marshal(fileContent)
+					_, _ = w.Write(contentBytes)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"path":            "README.md",
+				"ref":             "main",
+				"returnPermalink": true,
+			},
+			expectError: false,
+			expectedResult: mcp.ResourceContents{
+				URI:      "repo://owner/repo/sha/5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b/contents/README.md",
+				Text:     "# Test Repository\n\nThis is a test repository.",
+				MIMEType: "text/plain; charset=utf-8",
+			},
+			expectedMsg: "Permalink: https://github.com/owner/repo/blob/5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b/README.md",
+		},
+		{
+			name: "line window permalink uses the enterprise host",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: mockResponse(t, http.StatusOK, "{\"ref\": \"refs/heads/main\", \"object\": {\"sha\": \"5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b\"}}"),
+				GetReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, r *http.Request) {
+					// Content is fetched at the resolved commit, not the moving branch
+					assert.Equal(t, "5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b", r.URL.Query().Get("ref"))
+					w.WriteHeader(http.StatusOK)
+					encodedContent := base64.StdEncoding.EncodeToString(mockRawContent)
+					fileContent := &github.RepositoryContent{
+						Name:     github.Ptr("README.md"),
+						Path:     github.Ptr("README.md"),
+						SHA:      github.Ptr("abc123"),
+						Type:     github.Ptr("file"),
+						Content:  github.Ptr(encodedContent),
+						Size:     github.Ptr(len(mockRawContent)),
+						Encoding: github.Ptr("base64"),
+						HTMLURL:  github.Ptr("https://ghes.example.com/owner/repo/blob/main/README.md"),
+					}
+					contentBytes, _ := json.Marshal(fileContent)
+					_, _ = w.Write(contentBytes)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"path":            "README.md",
+				"ref":             "main",
+				"startLine":       float64(2),
+				"endLine":         float64(3),
+				"returnPermalink": true,
+			},
+			expectError: false,
+			expectedResult: mcp.ResourceContents{
+				URI:      "repo://owner/repo/sha/5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b/contents/README.md",
+				Text:     "\nThis is a test repository.\n",
+				MIMEType: "text/plain; charset=utf-8",
+			},
+			expectedMsg: "Permalink: https://ghes.example.com/owner/repo/blob/5f3c1a0b9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b/README.md#L2-L3",
+		},
+		{
+			name: "line window on binary file returns binary indicator",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposGitRefByOwnerByRepoByRef: mockResponse(t, http.StatusOK, "{\"ref\": \"refs/heads/main\", \"object\": {\"sha\": \"\"}}"),
+				GetReposByOwnerByRepo:            mockResponse(t, http.StatusOK, "{\"name\": \"repo\", \"default_branch\": \"main\"}"),
+				GetReposContentsByOwnerByRepoByPath: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					pngContent := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01")
+					encodedContent := base64.StdEncoding.EncodeToString(pngContent)
+					fileContent := &github.RepositoryContent{
+						Name:     github.Ptr("test.png"),
+						Path:     github.Ptr("test.png"),
+						SHA:      github.Ptr("def456"),
+						Type:     github.Ptr("file"),
+						Content:  github.Ptr(encodedContent),
+						Size:     github.Ptr(len(pngContent)),
+						Encoding: github.Ptr("base64"),
+					}
+					contentBytes, _ := json.Marshal(fileContent)
+					_, _ = w.Write(contentBytes)
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"path":      "test.png",
+				"ref":       "refs/heads/main",
+				"startLine": float64(1),
+				"endLine":   float64(10),
+			},
+			expectError:    false,
+			expectedResult: "Binary file: test.png is a binary file (image/png",
+		},
 		{
 			name: "successful binary file content fetch (PNG)",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -438,7 +592,137 @@ func Test_GetFileContents(t *testing.T) {
 			case mcp.TextContent:
 				textContent := getErrorResult(t, result)
 				require.Equal(t, textContent, expected)
+			case string:
+				textContent := getTextResult(t, result)
+				require.False(t, result.IsError)
+				assert.Contains(t, textContent.Text, expected)
+			}
+		})
+	}
+}
+
+func Test_GetReadme(t *testing.T) {
+	serverTool := GetReadme(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_repository_readme", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "ref")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	readmeContent := func(name, content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Name:     github.Ptr(name),
+			Path:     github.Ptr(name),
+			SHA:      github.Ptr("abc123"),
+			Size:     github.Ptr(len(content)),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/" + name),
+		}
+	}
+	largeContent := strings.Repeat("a", maxReadmeContentBytes+10)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedReadme ReadmeResponse
+		expectedErrMsg string
+	}{
+		{
+			name: "markdown README",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: expectPath(t, "/repos/owner/repo/readme").andThen(
+					mockResponse(t, http.StatusOK, readmeContent("README.md", "# Project\n")),
+				),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo"},
+			expectedReadme: ReadmeResponse{
+				Name:    "README.md",
+				Path:    "README.md",
+				SHA:     "abc123",
+				Size:    10,
+				HTMLURL: "https://github.com/owner/repo/blob/main/README.md",
+				Content: "# Project\n",
+			},
+		},
+		{
+			name: "lowercase reStructuredText readme at ref",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: expectQueryParams(t, map[string]string{"ref": "v1.0.0"}).andThen(
+					mockResponse(t, http.StatusOK, readmeContent("readme.rst", "Project\n=======\n")),
+				),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo", "ref": "v1.0.0"},
+			expectedReadme: ReadmeResponse{
+				Name:    "readme.rst",
+				Path:    "readme.rst",
+				SHA:     "abc123",
+				Size:    16,
+				HTMLURL: "https://github.com/owner/repo/blob/main/readme.rst",
+				Content: "Project\n=======\n",
+			},
+		},
+		{
+			name: "large README is truncated",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: mockResponse(t, http.StatusOK, readmeContent("README", largeContent)),
+			}),
+			requestArgs: map[string]any{"owner": "owner", "repo": "repo"},
+			expectedReadme: ReadmeResponse{
+				Name:      "README",
+				Path:      "README",
+				SHA:       "abc123",
+				Size:      len(largeContent),
+				HTMLURL:   "https://github.com/owner/repo/blob/main/README",
+				Content:   largeContent[:maxReadmeContentBytes],
+				Truncated: true,
+			},
+		},
+		{
+			name: "no README",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReadmeByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs:    map[string]any{"owner": "owner", "repo": "repo"},
+			expectError:    true,
+			expectedErrMsg: "failed to get README",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
 			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var readme ReadmeResponse
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &readme))
+			assert.Equal(t, tc.expectedReadme, readme)
 		})
 	}
 }
@@ -457,6 +741,9 @@ func Test_ForkRepository(t *testing.T) {
 	assert.Contains(t, schema.Properties, "owner")
 	assert.Contains(t, schema.Properties, "repo")
 	assert.Contains(t, schema.Properties, "organization")
+	assert.Contains(t, schema.Properties, "defaultBranchOnly")
+	assert.Contains(t, schema.Properties, "waitForReady")
+	assert.Contains(t, schema.Properties, "timeoutSeconds")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
 	// Setup mock forked repo for success case
@@ -471,6 +758,43 @@ func Test_ForkRepository(t *testing.T) {
 		DefaultBranch: github.Ptr("main"),
 		Fork:          github.Ptr(true),
 		ForksCount:    github.Ptr(0),
+		CreatedAt:     &github.Timestamp{Time: time.Now()},
+	}
+	mockOrgFork := &github.Repository{
+		ID:       github.Ptr(int64(654321)),
+		Name:     github.Ptr("repo"),
+		FullName: github.Ptr("my-org/repo"),
+		Owner: &github.User{
+			Login: github.Ptr("my-org"),
+		},
+		HTMLURL:       github.Ptr("https://github.com/my-org/repo"),
+		DefaultBranch: github.Ptr("main"),
+		Fork:          github.Ptr(true),
+		CreatedAt:     &github.Timestamp{Time: time.Now()},
+	}
+	mockExistingFork := &github.Repository{
+		ID:       github.Ptr(int64(123456)),
+		Name:     github.Ptr("repo"),
+		FullName: github.Ptr("new-owner/repo"),
+		Owner: &github.User{
+			Login: github.Ptr("new-owner"),
+		},
+		HTMLURL:       github.Ptr("https://github.com/new-owner/repo"),
+		DefaultBranch: github.Ptr("main"),
+		Fork:          github.Ptr(true),
+		CreatedAt:     &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+	}
+
+	branchChecks := 0
+	readyOnSecondCheck := func(w http.ResponseWriter, _ *http.Request) {
+		branchChecks++
+		if branchChecks < 2 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Branch not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"name": "main"}`))
 	}
 
 	tests := []struct {
@@ -478,7 +802,7 @@ func Test_ForkRepository(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
-		expectedRepo   *github.Repository
+		expectedResult ForkResult
 		expectedErrMsg string
 	}{
 		{
@@ -490,8 +814,102 @@ func Test_ForkRepository(t *testing.T) {
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:  false,
-			expectedRepo: mockForkedRepo,
+			expectError: false,
+			expectedResult: ForkResult{
+				ID:            123456,
+				FullName:      "new-owner/repo",
+				URL:           "https://github.com/new-owner/repo",
+				DefaultBranch: "main",
+			},
+		},
+		{
+			name: "fork into organization",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposForksByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"organization": "my-org",
+				}).andThen(
+					mockResponse(t, http.StatusAccepted, mockOrgFork),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"organization": "my-org",
+			},
+			expectedResult: ForkResult{
+				ID:            654321,
+				FullName:      "my-org/repo",
+				URL:           "https://github.com/my-org/repo",
+				DefaultBranch: "main",
+			},
+		},
+		{
+			name: "fork default branch only",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposForksByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"default_branch_only": true,
+				}).andThen(
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"defaultBranchOnly": true,
+			},
+			expectedResult: ForkResult{
+				ID:            123456,
+				FullName:      "new-owner/repo",
+				URL:           "https://github.com/new-owner/repo",
+				DefaultBranch: "main",
+			},
+		},
+		{
+			name: "existing fork is reported",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposForksByOwnerByRepo: mockResponse(t, http.StatusAccepted, mockExistingFork),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: ForkResult{
+				ID:             123456,
+				FullName:       "new-owner/repo",
+				URL:            "https://github.com/new-owner/repo",
+				DefaultBranch:  "main",
+				AlreadyExisted: true,
+			},
+		},
+		{
+			name: "wait for fork to become ready",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposForksByOwnerByRepo:           mockResponse(t, http.StatusAccepted, mockForkedRepo),
+				GetReposBranchesByOwnerByRepoByBranch: expectPath(t, "/repos/new-owner/repo/branches/main").andThen(readyOnSecondCheck),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"waitForReady": true,
+			},
+			expectedResult: ForkResult{
+				ID:            123456,
+				FullName:      "new-owner/repo",
+				URL:           "https://github.com/new-owner/repo",
+				DefaultBranch: "main",
+				Ready:         github.Ptr(true),
+			},
+		},
+		{
+			name: "invalid timeout",
+			requestArgs: map[string]any{
+				"owner":          "owner",
+				"repo":           "repo",
+				"waitForReady":   true,
+				"timeoutSeconds": float64(600),
+			},
+			expectError:    true,
+			expectedErrMsg: "timeoutSeconds must be between 1 and 120",
 		},
 		{
 			name: "repository fork fails",
@@ -522,8 +940,9 @@ func Test_ForkRepository(t *testing.T) {
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
-			// Call handler
-			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			// Call handler without delays between readiness checks
+			ctx := ContextWithPollConfig(ContextWithDeps(context.Background(), deps), PollConfig{Delay: 0})
+			result, err := handler(ctx, &request)
 
 			// Verify results
 			if tc.expectError {
@@ -540,36 +959,369 @@ func Test_ForkRepository(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
-			assert.Contains(t, textContent.Text, "Fork is in progress")
+			var returned ForkResult
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
 		})
 	}
 }
 
-func Test_CreateBranch(t *testing.T) {
+func Test_ListForks(t *testing.T) {
 	// Verify tool definition once
-	serverTool := CreateBranch(translations.NullTranslationHelper)
+	serverTool := ListForks(translations.NullTranslationHelper)
 	tool := serverTool.Tool
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	schema, ok := tool.InputSchema.(*jsonschema.Schema)
 	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
 
-	assert.Equal(t, "create_branch", tool.Name)
+	assert.Equal(t, "list_forks", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, schema.Properties, "owner")
 	assert.Contains(t, schema.Properties, "repo")
-	assert.Contains(t, schema.Properties, "branch")
-	assert.Contains(t, schema.Properties, "from_branch")
-	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "branch"})
+	assert.Contains(t, schema.Properties, "sort")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
-	// Setup mock repository for default branch test
-	mockRepo := &github.Repository{
-		DefaultBranch: github.Ptr("main"),
+	pushedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockForks := []*github.Repository{
+		{
+			FullName:        github.Ptr("alice/repo"),
+			Owner:           &github.User{Login: github.Ptr("alice")},
+			StargazersCount: github.Ptr(12),
+			PushedAt:        &github.Timestamp{Time: pushedAt},
+			HTMLURL:         github.Ptr("https://github.com/alice/repo"),
+		},
+		{
+			FullName: github.Ptr("bob/repo"),
+			Owner:    &github.User{Login: github.Ptr("bob")},
+			HTMLURL:  github.Ptr("https://github.com/bob/repo"),
+		},
 	}
 
-	// Setup mock reference for from_branch tests
-	mockSourceRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedForks  []MinimalFork
+		expectedErrMsg string
+	}{
+		{
+			name: "successful forks listing with sort",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposForksByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"sort":     "stargazers",
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockForks),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"sort":    "stargazers",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectedForks: []MinimalFork{
+				{
+					FullName:        "alice/repo",
+					Owner:           "alice",
+					StargazersCount: 12,
+					PushedAt:        "2024-05-01T12:00:00Z",
+					HTMLURL:         "https://github.com/alice/repo",
+				},
+				{
+					FullName: "bob/repo",
+					Owner:    "bob",
+					HTMLURL:  "https://github.com/bob/repo",
+				},
+			},
+		},
+		{
+			name: "forks listing fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposForksByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list forks for owner/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returnedForks []MinimalFork
+			err = json.Unmarshal([]byte(textContent.Text), &returnedForks)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedForks, returnedForks)
+		})
+	}
+}
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListRepositoryInvitations(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.Empty(t, schema.Required)
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockInvitations := []*github.RepositoryInvitation{
+		{
+			ID:   github.Ptr(int64(42)),
+			Repo: &github.Repository{FullName: github.Ptr("octo-org/widgets")},
+			Inviter: &github.User{
+				Login:     github.Ptr("octocat"),
+				ID:        github.Ptr(int64(1)),
+				HTMLURL:   github.Ptr("https://github.com/octocat"),
+				AvatarURL: github.Ptr("https://avatars.githubusercontent.com/u/1"),
+			},
+			Permissions: github.Ptr("write"),
+			CreatedAt:   &github.Timestamp{Time: createdAt},
+			HTMLURL:     github.Ptr("https://github.com/octo-org/widgets/invitations"),
+		},
+	}
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]any
+		expectError         bool
+		expectedInvitations []RepositoryInvitation
+		expectedErrMsg      string
+	}{
+		{
+			name: "successful invitations listing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserRepositoryInvitations: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockInvitations),
+				),
+			}),
+			requestArgs: map[string]any{},
+			expectedInvitations: []RepositoryInvitation{
+				{
+					ID:         42,
+					Repository: "octo-org/widgets",
+					Inviter: &MinimalUser{
+						Login:      "octocat",
+						ID:         1,
+						ProfileURL: "https://github.com/octocat",
+						AvatarURL:  "https://avatars.githubusercontent.com/u/1",
+					},
+					Permissions: "write",
+					CreatedAt:   "2024-05-01T12:00:00Z",
+					HTMLURL:     "https://github.com/octo-org/widgets/invitations",
+				},
+			},
+		},
+		{
+			name: "invitations listing fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUserRepositoryInvitations: mockResponse(t, http.StatusUnauthorized, `{"message": "Requires authentication"}`),
+			}),
+			requestArgs:    map[string]any{},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository invitations",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []RepositoryInvitation
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedInvitations, returned)
+		})
+	}
+}
+
+func Test_ManageRepositoryInvitation(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManageRepositoryInvitation(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "manage_repository_invitation", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "invitation_id")
+	assert.Contains(t, schema.Properties, "action")
+	assert.ElementsMatch(t, schema.Required, []string{"invitation_id", "action"})
+
+	noContent := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "accept invitation",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchUserRepositoryInvitationsByInvitationID: expectPath(t, "/user/repository_invitations/42").andThen(noContent),
+			}),
+			requestArgs: map[string]any{
+				"invitation_id": float64(42),
+				"action":        "accept",
+			},
+			expectedText: "Successfully accepted repository invitation 42",
+		},
+		{
+			name: "decline invitation",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteUserRepositoryInvitationsByInvitationID: expectPath(t, "/user/repository_invitations/42").andThen(noContent),
+			}),
+			requestArgs: map[string]any{
+				"invitation_id": float64(42),
+				"action":        "decline",
+			},
+			expectedText: "Successfully declined repository invitation 42",
+		},
+		{
+			name: "invalid action",
+			requestArgs: map[string]any{
+				"invitation_id": float64(42),
+				"action":        "ignore",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid action \"ignore\": must be 'accept' or 'decline'",
+		},
+		{
+			name: "invitation not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchUserRepositoryInvitationsByInvitationID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"invitation_id": float64(7),
+				"action":        "accept",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to accept repository invitation 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_CreateBranch(t *testing.T) {
+	// Verify tool definition once
+	serverTool := CreateBranch(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "create_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "branch")
+	assert.Contains(t, schema.Properties, "from_branch")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "branch"})
+
+	// Setup mock repository for default branch test
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	// Setup mock reference for from_branch tests
+	mockSourceRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
 		Object: &github.GitObject{
 			SHA: github.Ptr("abc123def456"),
 		},
@@ -864,8 +1616,14 @@ func Test_ListCommits(t *testing.T) {
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, schema.Properties, "owner")
 	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "ref")
 	assert.Contains(t, schema.Properties, "sha")
 	assert.Contains(t, schema.Properties, "author")
+	assert.Contains(t, schema.Properties, "path")
+	assert.Contains(t, schema.Properties, "since")
+	assert.Contains(t, schema.Properties, "until")
+	assert.Contains(t, schema.Properties, "maxResults")
+	assert.Contains(t, schema.Properties, "includeFiles")
 	assert.Contains(t, schema.Properties, "page")
 	assert.Contains(t, schema.Properties, "perPage")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
@@ -945,12 +1703,37 @@ func Test_ListCommits(t *testing.T) {
 		},
 	}
 
+	// Full commit as returned by the single commit endpoint, including patches
+	mockCommitWithPatch := &github.RepositoryCommit{
+		SHA:     github.Ptr("abc123def456"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		Commit: &github.Commit{
+			Message: github.Ptr("First commit"),
+		},
+		Stats: &github.CommitStats{
+			Additions: github.Ptr(1),
+			Deletions: github.Ptr(1),
+			Total:     github.Ptr(2),
+		},
+		Files: []*github.CommitFile{
+			{
+				Filename:  github.Ptr("src/main.go"),
+				Status:    github.Ptr("modified"),
+				Additions: github.Ptr(1),
+				Deletions: github.Ptr(1),
+				Changes:   github.Ptr(2),
+				Patch:     github.Ptr("@@ -1 +1 @@\n-old\n+new"),
+			},
+		},
+	}
+
 	tests := []struct {
 		name            string
 		mockedClient    *http.Client
 		requestArgs     map[string]any
 		expectError     bool
 		expectedCommits []*github.RepositoryCommit
+		expectFiles     bool
 		expectedErrMsg  string
 	}{
 		{
@@ -987,30 +1770,123 @@ func Test_ListCommits(t *testing.T) {
 			expectedCommits: mockCommits,
 		},
 		{
-			name: "successful commits fetch with pagination",
+			name: "successful commits fetch with ref resolved to commit SHA",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/v1.0.0").andThen(
+					mockResponse(t, http.StatusOK, "0123456789abcdef0123456789abcdef01234567"),
+				),
 				GetReposCommitsByOwnerByRepo: expectQueryParams(t, map[string]string{
-					"page":     "2",
-					"per_page": "10",
+					"sha":      "0123456789abcdef0123456789abcdef01234567",
+					"page":     "1",
+					"per_page": "30",
 				}).andThen(
 					mockResponse(t, http.StatusOK, mockCommits),
 				),
 			}),
 			requestArgs: map[string]any{
-				"owner":   "owner",
-				"repo":    "repo",
-				"page":    float64(2),
-				"perPage": float64(10),
+				"owner": "owner",
+				"repo":  "repo",
+				"ref":   "refs/tags/v1.0.0",
 			},
 			expectError:     false,
 			expectedCommits: mockCommits,
 		},
 		{
-			name: "commits fetch fails",
+			name: "successful commits fetch with path and time filters",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
-				GetReposCommitsByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
-					w.WriteHeader(http.StatusNotFound)
-					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				GetReposCommitsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"path":     "src/main.go",
+					"since":    "2024-01-01T00:00:00Z",
+					"until":    "2024-01-31T23:59:59Z",
+					"page":     "1",
+					"per_page": "30",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockCommits[:1]),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "src/main.go",
+				"since": "2024-01-01T00:00:00Z",
+				"until": "2024-01-31T23:59:59Z",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits[:1],
+		},
+		{
+			name: "maxResults caps page size and result count",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": "1",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockCommits),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"maxResults": float64(1),
+			},
+			expectError:     false,
+			expectedCommits: mockCommits[:1],
+		},
+		{
+			name: "includeFiles fetches each commit with patches",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: mockResponse(t, http.StatusOK, mockCommits[:1]),
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/abc123def456").andThen(
+					mockResponse(t, http.StatusOK, mockCommitWithPatch),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":        "owner",
+				"repo":         "repo",
+				"includeFiles": true,
+			},
+			expectError:     false,
+			expectedCommits: []*github.RepositoryCommit{mockCommitWithPatch},
+			expectFiles:     true,
+		},
+		{
+			name: "invalid since timestamp",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: mockResponse(t, http.StatusOK, mockCommits),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "yesterday",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid since timestamp",
+		},
+		{
+			name: "successful commits fetch with pagination",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"page":     "2",
+					"per_page": "10",
+				}).andThen(
+					mockResponse(t, http.StatusOK, mockCommits),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "commits fetch fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
 				},
 			}),
 			requestArgs: map[string]any{
@@ -1067,7 +1943,17 @@ func Test_ListCommits(t *testing.T) {
 					assert.Equal(t, tc.expectedCommits[i].Author.GetLogin(), commit.Author.Login)
 				}
 
-				// Files and stats are never included in list_commits
+				if tc.expectFiles {
+					require.Len(t, commit.Files, len(tc.expectedCommits[i].Files))
+					for j, file := range commit.Files {
+						assert.Equal(t, tc.expectedCommits[i].Files[j].GetFilename(), file.Filename)
+						assert.Equal(t, tc.expectedCommits[i].Files[j].GetPatch(), file.Patch)
+					}
+					assert.NotNil(t, commit.Stats)
+					continue
+				}
+
+				// Files and stats are only included when includeFiles is set
 				assert.Nil(t, commit.Files)
 				assert.Nil(t, commit.Stats)
 			}
@@ -1075,6 +1961,58 @@ func Test_ListCommits(t *testing.T) {
 	}
 }
 
+func Test_ListCommits_IncludeFilesCap(t *testing.T) {
+	serverTool := ListCommits(translations.NullTranslationHelper)
+
+	commits := make([]*github.RepositoryCommit, maxCommitFileDetails+5)
+	for i := range commits {
+		commits[i] = &github.RepositoryCommit{
+			SHA:    github.Ptr(fmt.Sprintf("sha%d", i)),
+			Commit: &github.Commit{Message: github.Ptr(fmt.Sprintf("Commit %d", i))},
+		}
+	}
+
+	var detailCalls atomic.Int32
+	deps := BaseDeps{
+		Client: github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+			GetReposCommitsByOwnerByRepo: mockResponse(t, http.StatusOK, commits),
+			GetReposCommitsByOwnerByRepoByRef: func(w http.ResponseWriter, r *http.Request) {
+				detailCalls.Add(1)
+				sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+				mockResponse(t, http.StatusOK, &github.RepositoryCommit{
+					SHA:   github.Ptr(sha),
+					Stats: &github.CommitStats{Total: github.Ptr(1)},
+					Files: []*github.CommitFile{{Filename: github.Ptr("main.go"), Patch: github.Ptr("@@ -1 +1 @@")}},
+				})(w, r)
+			},
+		})),
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"owner":        "owner",
+		"repo":         "repo",
+		"includeFiles": true,
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var returned []MinimalCommit
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &returned))
+	require.Len(t, returned, len(commits))
+	assert.Equal(t, int32(maxCommitFileDetails), detailCalls.Load())
+	for i, commit := range returned {
+		assert.Equal(t, fmt.Sprintf("sha%d", i), commit.SHA)
+		if i < maxCommitFileDetails {
+			require.Len(t, commit.Files, 1)
+			assert.Equal(t, "@@ -1 +1 @@", commit.Files[0].Patch)
+			continue
+		}
+		assert.Nil(t, commit.Files)
+	}
+}
+
 func Test_CreateOrUpdateFile(t *testing.T) {
 	// Verify tool definition once
 	serverTool := CreateOrUpdateFile(translations.NullTranslationHelper)
@@ -1093,6 +2031,7 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 	assert.Contains(t, schema.Properties, "message")
 	assert.Contains(t, schema.Properties, "branch")
 	assert.Contains(t, schema.Properties, "sha")
+	assert.Contains(t, schema.Properties, "autoResolveConflict")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "path", "content", "message", "branch"})
 
 	// Setup mock file content response
@@ -1360,6 +2299,77 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			expectError:    false,
 			expectedErrMsg: "Warning: File updated without SHA validation. Previous file SHA was existing123",
 		},
+		{
+			name: "auto resolve conflict - retries once after 409",
+			mockedClient: func() *http.Client {
+				putCalls := 0
+				return MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+					"HEAD /repos/{owner}/{repo}/contents/{path:.*}": func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("ETag", `"newsha999888"`)
+						w.WriteHeader(http.StatusOK)
+					},
+					"PUT /repos/{owner}/{repo}/contents/{path:.*}": func(w http.ResponseWriter, r *http.Request) {
+						putCalls++
+						if putCalls == 1 {
+							// The stale SHA is not swapped for the current one before writing
+							expectRequestBody(t, map[string]any{
+								"message": "Update example file",
+								"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==",
+								"branch":  "main",
+								"sha":     "oldsha123456",
+							}).andThen(func(w http.ResponseWriter, _ *http.Request) {
+								w.WriteHeader(http.StatusConflict)
+								_, _ = w.Write([]byte(`{"message": "docs/example.md does not match newsha999888"}`))
+							})(w, r)
+							return
+						}
+						expectRequestBody(t, map[string]any{
+							"message": "Update example file",
+							"content": "IyBVcGRhdGVkIEV4YW1wbGUKClRoaXMgZmlsZSBoYXMgYmVlbiB1cGRhdGVkLg==",
+							"branch":  "main",
+							"sha":     "newsha999888",
+						}).andThen(
+							mockResponse(t, http.StatusOK, mockFileResponse),
+						)(w, r)
+					},
+				})
+			}(),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"path":                "docs/example.md",
+				"content":             "# Updated Example\n\nThis file has been updated.",
+				"message":             "Update example file",
+				"branch":              "main",
+				"sha":                 "oldsha123456",
+				"autoResolveConflict": true,
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "auto resolve conflict - fails with clear error when conflict persists",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				"HEAD /repos/{owner}/{repo}/contents/{path:.*}": func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				},
+				"PUT /repos/{owner}/{repo}/contents/{path:.*}": func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"message": "docs/example.md does not match"}`))
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":               "owner",
+				"repo":                "repo",
+				"path":                "docs/example.md",
+				"content":             "# Example",
+				"message":             "Create example file",
+				"branch":              "main",
+				"autoResolveConflict": true,
+			},
+			expectError:    true,
+			expectedErrMsg: "still conflicts after refreshing its SHA and retrying once",
+		},
 		{
 			name: "no sha provided - file doesn't exist, no warning",
 			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
@@ -1494,135 +2504,460 @@ func Test_CreateRepository(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]any
 		expectError    bool
-		expectedRepo   *github.Repository
+		expectedRepo   *github.Repository
+		expectedErrMsg string
+	}{
+		{
+			name: "successful repository creation with all parameters",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					EndpointPattern("POST /user/repos"),
+					expectRequestBody(t, map[string]any{
+						"name":        "test-repo",
+						"description": "Test repository",
+						"private":     true,
+						"auto_init":   true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"name":        "test-repo",
+				"description": "Test repository",
+				"private":     true,
+				"autoInit":    true,
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "successful repository creation in organization",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					EndpointPattern("POST /orgs/testorg/repos"),
+					expectRequestBody(t, map[string]any{
+						"name":        "test-repo",
+						"description": "Test repository",
+						"private":     false,
+						"auto_init":   true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"name":         "test-repo",
+				"description":  "Test repository",
+				"organization": "testorg",
+				"private":      false,
+				"autoInit":     true,
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "successful repository creation with minimal parameters",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					EndpointPattern("POST /user/repos"),
+					expectRequestBody(t, map[string]any{
+						"name":        "test-repo",
+						"auto_init":   false,
+						"description": "",
+						"private":     false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]any{
+				"name": "test-repo",
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "repository creation fails",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					EndpointPattern("POST /user/repos"),
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"name": "invalid-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			// Verify results
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the minimal result
+			var returnedRepo MinimalResponse
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
+			assert.NoError(t, err)
+
+			// Verify repository details
+			assert.Equal(t, tc.expectedRepo.GetHTMLURL(), returnedRepo.URL)
+		})
+	}
+}
+
+func Test_UpdateRepositorySettings(t *testing.T) {
+	// Verify tool definition once
+	serverTool := UpdateRepositorySettings(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "update_repository_settings", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	for _, p := range []string{"owner", "repo", "description", "homepage", "default_branch", "private", "archived", "has_issues", "has_wiki", "allow_merge_commit", "allow_squash_merge", "allow_rebase_merge", "delete_branch_on_merge"} {
+		assert.Contains(t, schema.Properties, p)
+	}
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedSettings RepositorySettings
+		expectedErrMsg   string
+	}{
+		{
+			name: "archive repository",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"archived": true,
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Repository{
+						FullName:      github.Ptr("owner/repo"),
+						HTMLURL:       github.Ptr("https://github.com/owner/repo"),
+						DefaultBranch: github.Ptr("main"),
+						Archived:      github.Ptr(true),
+						HasIssues:     github.Ptr(true),
+					}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"archived": true,
+			},
+			expectedSettings: RepositorySettings{
+				FullName:      "owner/repo",
+				HTMLURL:       "https://github.com/owner/repo",
+				DefaultBranch: "main",
+				Archived:      true,
+				HasIssues:     true,
+			},
+		},
+		{
+			name: "unarchive repository",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"archived": false,
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Repository{
+						FullName:      github.Ptr("owner/repo"),
+						HTMLURL:       github.Ptr("https://github.com/owner/repo"),
+						DefaultBranch: github.Ptr("main"),
+						Archived:      github.Ptr(false),
+					}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"archived": false,
+			},
+			expectedSettings: RepositorySettings{
+				FullName:      "owner/repo",
+				HTMLURL:       "https://github.com/owner/repo",
+				DefaultBranch: "main",
+			},
+		},
+		{
+			name: "change default branch and merge settings",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"default_branch":         "trunk",
+					"allow_merge_commit":     false,
+					"delete_branch_on_merge": true,
+				}).andThen(
+					mockResponse(t, http.StatusOK, &github.Repository{
+						FullName:            github.Ptr("owner/repo"),
+						HTMLURL:             github.Ptr("https://github.com/owner/repo"),
+						DefaultBranch:       github.Ptr("trunk"),
+						AllowSquashMerge:    github.Ptr(true),
+						DeleteBranchOnMerge: github.Ptr(true),
+					}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"default_branch":         "trunk",
+				"allow_merge_commit":     false,
+				"delete_branch_on_merge": true,
+			},
+			expectedSettings: RepositorySettings{
+				FullName:            "owner/repo",
+				HTMLURL:             "https://github.com/owner/repo",
+				DefaultBranch:       "trunk",
+				AllowSquashMerge:    true,
+				DeleteBranchOnMerge: true,
+			},
+		},
+		{
+			name: "no settings provided",
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "No update parameters provided.",
+		},
+		{
+			name: "wrong parameter type",
+			requestArgs: map[string]any{
+				"owner":    "owner",
+				"repo":     "repo",
+				"archived": "yes",
+			},
+			expectError:    true,
+			expectedErrMsg: "parameter archived is not of type bool",
+		},
+		{
+			name: "update fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PatchReposByOwnerByRepo: mockResponse(t, http.StatusForbidden, `{"message": "Repository was archived so is read-only."}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"description": "new description",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update repository owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned RepositorySettings
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedSettings, returned)
+		})
+	}
+}
+
+func Test_DeleteRepository(t *testing.T) {
+	// Verify tool definition once
+	serverTool := DeleteRepository(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "delete_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.Equal(t, FeatureFlagExperiments, serverTool.FeatureFlagEnable)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "confirm")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "confirm"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
 		expectedErrMsg string
 	}{
 		{
-			name: "successful repository creation with all parameters",
-			mockedClient: NewMockedHTTPClient(
-				WithRequestMatchHandler(
-					EndpointPattern("POST /user/repos"),
-					expectRequestBody(t, map[string]any{
-						"name":        "test-repo",
-						"description": "Test repository",
-						"private":     true,
-						"auto_init":   true,
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
-					),
-				),
-			),
-			requestArgs: map[string]any{
-				"name":        "test-repo",
-				"description": "Test repository",
-				"private":     true,
-				"autoInit":    true,
-			},
-			expectError:  false,
-			expectedRepo: mockRepo,
-		},
-		{
-			name: "successful repository creation in organization",
-			mockedClient: NewMockedHTTPClient(
-				WithRequestMatchHandler(
-					EndpointPattern("POST /orgs/testorg/repos"),
-					expectRequestBody(t, map[string]any{
-						"name":        "test-repo",
-						"description": "Test repository",
-						"private":     false,
-						"auto_init":   true,
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
-					),
+			name: "successful deletion",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposByOwnerByRepo: expectPath(t, "/repos/owner/repo").andThen(
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
 				),
-			),
+			}),
 			requestArgs: map[string]any{
-				"name":         "test-repo",
-				"description":  "Test repository",
-				"organization": "testorg",
-				"private":      false,
-				"autoInit":     true,
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": "owner/repo",
 			},
-			expectError:  false,
-			expectedRepo: mockRepo,
+			expectedText: "Successfully deleted repository owner/repo",
 		},
 		{
-			name: "successful repository creation with minimal parameters",
-			mockedClient: NewMockedHTTPClient(
-				WithRequestMatchHandler(
-					EndpointPattern("POST /user/repos"),
-					expectRequestBody(t, map[string]any{
-						"name":        "test-repo",
-						"auto_init":   false,
-						"description": "",
-						"private":     false,
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockRepo),
-					),
-				),
-			),
+			name: "confirmation does not match",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposByOwnerByRepo: func(_ http.ResponseWriter, _ *http.Request) {
+					t.Fatal("delete endpoint should not be called")
+				},
+			}),
 			requestArgs: map[string]any{
-				"name": "test-repo",
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": "owner/other-repo",
 			},
-			expectError:  false,
-			expectedRepo: mockRepo,
+			expectError:    true,
+			expectedErrMsg: `confirmation "owner/other-repo" does not match repository "owner/repo"`,
 		},
 		{
-			name: "repository creation fails",
-			mockedClient: NewMockedHTTPClient(
-				WithRequestMatchHandler(
-					EndpointPattern("POST /user/repos"),
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Repository creation failed"}`))
-					}),
-				),
-			),
+			name: "deletion fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposByOwnerByRepo: mockResponse(t, http.StatusForbidden, `{"message": "Must have admin rights to Repository."}`),
+			}),
 			requestArgs: map[string]any{
-				"name": "invalid-repo",
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": "owner/repo",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to create repository",
+			expectedErrMsg: "failed to delete repository owner/repo",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
 			deps := BaseDeps{
 				Client: client,
 			}
 			handler := serverTool.Handler(deps)
 
-			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
-			// Call handler
 			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
 
-			// Verify results
+			require.NoError(t, err)
 			if tc.expectError {
-				require.NoError(t, err)
 				require.True(t, result.IsError)
 				errorContent := getErrorResult(t, result)
 				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
 				return
 			}
 
-			require.NoError(t, err)
 			require.False(t, result.IsError)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
 
-			// Unmarshal and verify the minimal result
-			var returnedRepo MinimalResponse
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
-			assert.NoError(t, err)
+func Test_DeleteRepository_Availability(t *testing.T) {
+	experimentsEnabled := func(_ context.Context, flag string) (bool, error) {
+		return flag == FeatureFlagExperiments, nil
+	}
 
-			// Verify repository details
-			assert.Equal(t, tc.expectedRepo.GetHTMLURL(), returnedRepo.URL)
+	tests := []struct {
+		name          string
+		readOnly      bool
+		checker       inventory.FeatureFlagChecker
+		expectPresent bool
+	}{
+		{
+			name:          "available with experiments flag",
+			checker:       experimentsEnabled,
+			expectPresent: true,
+		},
+		{
+			name:          "hidden without experiments flag",
+			expectPresent: false,
+		},
+		{
+			name:          "hidden in read-only mode",
+			readOnly:      true,
+			checker:       experimentsEnabled,
+			expectPresent: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inv, err := inventory.NewBuilder().
+				SetTools([]inventory.ServerTool{DeleteRepository(translations.NullTranslationHelper)}).
+				WithToolsets([]string{"all"}).
+				WithReadOnly(tc.readOnly).
+				WithFeatureChecker(tc.checker).
+				Build()
+			require.NoError(t, err)
+
+			names := make([]string, 0)
+			for _, tool := range inv.AvailableTools(context.Background()) {
+				names = append(names, tool.Tool.Name)
+			}
+			if tc.expectPresent {
+				assert.Contains(t, names, "delete_repository")
+			} else {
+				assert.NotContains(t, names, "delete_repository")
+			}
 		})
 	}
 }
@@ -1643,6 +2978,7 @@ func Test_PushFiles(t *testing.T) {
 	assert.Contains(t, schema.Properties, "branch")
 	assert.Contains(t, schema.Properties, "files")
 	assert.Contains(t, schema.Properties, "message")
+	assert.Contains(t, schema.Properties, "autoResolveConflict")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "branch", "files", "message"})
 
 	// Setup mock objects
@@ -1765,6 +3101,99 @@ func Test_PushFiles(t *testing.T) {
 			expectError: false,
 			expectedRef: mockUpdatedRef,
 		},
+		{
+			name: "auto resolve conflict - rebuilds on new branch head after non fast-forward",
+			mockedClient: func() *http.Client {
+				patchCalls := 0
+				return NewMockedHTTPClient(
+					WithRequestMatch(
+						GetReposGitRefByOwnerByRepoByRef,
+						mockRef,
+					),
+					WithRequestMatch(
+						GetReposGitCommitsByOwnerByRepoByCommitSHA,
+						mockCommit,
+					),
+					WithRequestMatch(
+						PostReposGitTreesByOwnerByRepo,
+						mockTree,
+					),
+					WithRequestMatch(
+						PostReposGitCommitsByOwnerByRepo,
+						mockNewCommit,
+					),
+					WithRequestMatchHandler(
+						PatchReposGitRefsByOwnerByRepoByRef,
+						func(w http.ResponseWriter, r *http.Request) {
+							patchCalls++
+							if patchCalls == 1 {
+								w.WriteHeader(http.StatusUnprocessableEntity)
+								_, _ = w.Write([]byte(`{"message": "Update is not a fast forward"}`))
+								return
+							}
+							mockResponse(t, http.StatusOK, mockUpdatedRef)(w, r)
+						},
+					),
+				)
+			}(),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []any{
+					map[string]any{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message":             "Update README",
+				"autoResolveConflict": true,
+			},
+			expectError: false,
+			expectedRef: mockUpdatedRef,
+		},
+		{
+			name: "non fast-forward without auto resolve fails",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatch(
+					GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				WithRequestMatch(
+					GetReposGitCommitsByOwnerByRepoByCommitSHA,
+					mockCommit,
+				),
+				WithRequestMatch(
+					PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				WithRequestMatch(
+					PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				WithRequestMatchHandler(
+					PatchReposGitRefsByOwnerByRepoByRef,
+					func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Update is not a fast forward"}`))
+					},
+				),
+			),
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []any{
+					map[string]any{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update README",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update reference",
+		},
 		{
 			name:         "fails when files parameter is invalid",
 			mockedClient: NewMockedHTTPClient(
@@ -2688,6 +4117,9 @@ func Test_ListTags(t *testing.T) {
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, schema.Properties, "owner")
 	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "prefix")
+	assert.Contains(t, schema.Properties, "includeCommitDate")
+	assert.Contains(t, schema.Properties, "maxTags")
 	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
 
 	// Setup mock tags for success case
@@ -2805,6 +4237,118 @@ func Test_ListTags(t *testing.T) {
 	}
 }
 
+func Test_ListTags_PrefixAndCommitDate(t *testing.T) {
+	serverTool := ListTags(translations.NullTranslationHelper)
+
+	mockTags := []*github.RepositoryTag{
+		{Name: github.Ptr("v2.0.0"), Commit: &github.Commit{SHA: github.Ptr("sha-200")}},
+		{Name: github.Ptr("nightly"), Commit: &github.Commit{SHA: github.Ptr("sha-nightly")}},
+		{Name: github.Ptr("v1.1.0"), Commit: &github.Commit{SHA: github.Ptr("sha-110")}},
+		{Name: github.Ptr("v1.0.0"), Commit: &github.Commit{SHA: github.Ptr("sha-100")}},
+	}
+	commitDates := map[string]string{
+		"sha-200":     "2024-03-01T00:00:00Z",
+		"sha-nightly": "2024-04-01T00:00:00Z",
+		"sha-110":     "2024-02-01T00:00:00Z",
+		"sha-100":     "2024-01-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name                string
+		requestArgs         map[string]any
+		expectError         bool
+		expectedErrMsg      string
+		expectedNames       []string
+		expectedDates       []string
+		expectedCommitCalls int
+	}{
+		{
+			name: "prefix filter",
+			requestArgs: map[string]any{
+				"owner":  "owner",
+				"repo":   "repo",
+				"prefix": "v",
+			},
+			expectedNames: []string{"v2.0.0", "v1.1.0", "v1.0.0"},
+			expectedDates: []string{"", "", ""},
+		},
+		{
+			name: "commit dates limited by maxTags",
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"prefix":            "v",
+				"includeCommitDate": true,
+				"maxTags":           float64(2),
+			},
+			expectedNames:       []string{"v2.0.0", "v1.1.0", "v1.0.0"},
+			expectedDates:       []string{"2024-03-01T00:00:00Z", "2024-02-01T00:00:00Z", ""},
+			expectedCommitCalls: 2,
+		},
+		{
+			name: "maxTags out of range",
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"includeCommitDate": true,
+				"maxTags":           float64(500),
+			},
+			expectError:    true,
+			expectedErrMsg: "maxTags must be between 1 and 30",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			commitCalls := 0
+			mockedClient := MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTagsByOwnerByRepo: mockResponse(t, http.StatusOK, mockTags),
+				GetReposGitCommitsByOwnerByRepoByCommitSHA: func(w http.ResponseWriter, r *http.Request) {
+					commitCalls++
+					sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+					mockResponse(t, http.StatusOK, map[string]any{
+						"sha":       sha,
+						"committer": map[string]any{"date": commitDates[sha]},
+					})(w, r)
+				},
+			})
+			client := github.NewClient(mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []TagWithCommitDate
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+
+			names := make([]string, 0, len(returned))
+			dates := make([]string, 0, len(returned))
+			for _, tag := range returned {
+				names = append(names, tag.GetName())
+				dates = append(dates, tag.CommitDate)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+			assert.Equal(t, tc.expectedDates, dates)
+			assert.Equal(t, tc.expectedCommitCalls, commitCalls)
+		})
+	}
+}
+
 func Test_GetTag(t *testing.T) {
 	// Verify tool definition once
 	serverTool := GetTag(translations.NullTranslationHelper)
@@ -3322,6 +4866,219 @@ func Test_GetReleaseByTag(t *testing.T) {
 	}
 }
 
+func Test_ListReleaseAssets(t *testing.T) {
+	serverTool := ListReleaseAssets(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_release_assets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "releaseID")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "releaseID"})
+
+	mockAssets := []*github.ReleaseAsset{
+		{
+			ID:                 github.Ptr(int64(1)),
+			Name:               github.Ptr("app-linux-amd64.tar.gz"),
+			Label:              github.Ptr("Linux (amd64)"),
+			ContentType:        github.Ptr("application/gzip"),
+			Size:               github.Ptr(2048),
+			DownloadCount:      github.Ptr(42),
+			BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/app-linux-amd64.tar.gz"),
+		},
+		{
+			ID:                 github.Ptr(int64(2)),
+			Name:               github.Ptr("checksums.txt"),
+			ContentType:        github.Ptr("text/plain"),
+			Size:               github.Ptr(128),
+			BrowserDownloadURL: github.Ptr("https://github.com/owner/repo/releases/download/v1.0.0/checksums.txt"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedAssets []MinimalReleaseAsset
+		expectedErrMsg string
+	}{
+		{
+			name: "successful assets listing",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReleasesAssetsByOwnerByRepoByReleaseID: expectPath(t, "/repos/owner/repo/releases/123/assets").andThen(
+					mockResponse(t, http.StatusOK, mockAssets),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"releaseID": float64(123),
+			},
+			expectedAssets: []MinimalReleaseAsset{
+				{
+					ID:                 1,
+					Name:               "app-linux-amd64.tar.gz",
+					Label:              "Linux (amd64)",
+					ContentType:        "application/gzip",
+					Size:               2048,
+					DownloadCount:      42,
+					BrowserDownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/app-linux-amd64.tar.gz",
+				},
+				{
+					ID:                 2,
+					Name:               "checksums.txt",
+					ContentType:        "text/plain",
+					Size:               128,
+					BrowserDownloadURL: "https://github.com/owner/repo/releases/download/v1.0.0/checksums.txt",
+				},
+			},
+		},
+		{
+			name:         "missing release ID",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: releaseID",
+		},
+		{
+			name: "release not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposReleasesAssetsByOwnerByRepoByReleaseID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"releaseID": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list assets for release 999",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			if tc.expectError {
+				require.NoError(t, err)
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			textContent := getTextResult(t, result)
+
+			var returnedAssets []MinimalReleaseAsset
+			err = json.Unmarshal([]byte(textContent.Text), &returnedAssets)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAssets, returnedAssets)
+		})
+	}
+}
+func Test_DeleteRelease(t *testing.T) {
+	serverTool := DeleteRelease(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "delete_release", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "releaseID")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "releaseID"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful release deletion",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposReleasesByOwnerByRepoByReleaseID: expectPath(t, "/repos/owner/repo/releases/123").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"releaseID": float64(123),
+			},
+			expectedText: "Successfully deleted release 123 from owner/repo",
+		},
+		{
+			name: "release not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteReposReleasesByOwnerByRepoByReleaseID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"releaseID": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to delete release 999 from owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				assert.Contains(t, errorContent.Text, "404")
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
 func Test_looksLikeSHA(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -3409,76 +5166,231 @@ func Test_filterPaths(t *testing.T) {
 			expected:   []string{"folder/foo.txt", "nested/folder/foo.txt"},
 		},
 		{
-			name: "dir name",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("bar.txt"), Type: github.Ptr("blob")},
-				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/folder/baz.txt"), Type: github.Ptr("blob")},
-			},
-			path:       "folder/",
-			maxResults: -1,
-			expected:   []string{"folder/", "nested/folder/"},
+			name: "dir name",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("bar.txt"), Type: github.Ptr("blob")},
+				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/folder/baz.txt"), Type: github.Ptr("blob")},
+			},
+			path:       "folder/",
+			maxResults: -1,
+			expected:   []string{"folder/", "nested/folder/"},
+		},
+		{
+			name: "dir and file match",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("name"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("name"), Type: github.Ptr("blob")},
+			},
+			path:       "name", // No trailing slash can match both files and directories
+			maxResults: -1,
+			expected:   []string{"name/", "name"},
+		},
+		{
+			name: "dir only match",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("name"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("name"), Type: github.Ptr("blob")},
+			},
+			path:       "name/", // Trialing slash ensures only directories are matched
+			maxResults: -1,
+			expected:   []string{"name/"},
+		},
+		{
+			name: "max results limit 2",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
+			},
+			path:       "folder/",
+			maxResults: 2,
+			expected:   []string{"folder/", "nested/folder/"},
+		},
+		{
+			name: "max results limit 1",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
+			},
+			path:       "folder/",
+			maxResults: 1,
+			expected:   []string{"folder/"},
+		},
+		{
+			name: "max results limit 0",
+			tree: []*github.TreeEntry{
+				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
+				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
+			},
+			path:       "folder/",
+			maxResults: 0,
+			expected:   []string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := filterPaths(tc.tree, tc.path, tc.maxResults)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func Test_lineWindow(t *testing.T) {
+	content := "line one\nline two\nline three\nline four\n"
+
+	tests := []struct {
+		name           string
+		startLine      int
+		endLine        int
+		expectedWindow string
+		expectedFrom   int
+		expectedTo     int
+		expectedErrMsg string
+	}{
+		{
+			name:           "middle window",
+			startLine:      2,
+			endLine:        3,
+			expectedWindow: "line two\nline three\n",
+			expectedFrom:   2,
+			expectedTo:     3,
+		},
+		{
+			name:           "only start line reads to end",
+			startLine:      3,
+			expectedWindow: "line three\nline four\n",
+			expectedFrom:   3,
+			expectedTo:     4,
+		},
+		{
+			name:           "only end line reads from start",
+			endLine:        1,
+			expectedWindow: "line one\n",
+			expectedFrom:   1,
+			expectedTo:     1,
+		},
+		{
+			name:           "end line beyond total is clamped",
+			startLine:      4,
+			endLine:        100,
+			expectedWindow: "line four\n",
+			expectedFrom:   4,
+			expectedTo:     4,
+		},
+		{
+			name:           "start line beyond total",
+			startLine:      5,
+			expectedErrMsg: "startLine 5 is beyond the end of the file (4 lines)",
+		},
+		{
+			name:           "start after end",
+			startLine:      3,
+			endLine:        2,
+			expectedErrMsg: "startLine 3 must not be greater than endLine 2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			window, from, to, total, err := lineWindow(content, tc.startLine, tc.endLine)
+			if tc.expectedErrMsg != "" {
+				require.EqualError(t, err, tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWindow, window)
+			assert.Equal(t, tc.expectedFrom, from)
+			assert.Equal(t, tc.expectedTo, to)
+			assert.Equal(t, 4, total)
+		})
+	}
+}
+
+func Test_resolveRef(t *testing.T) {
+	const commitSHA = "0123456789abcdef0123456789abcdef01234567"
+
+	tests := []struct {
+		name           string
+		ref            string
+		mockedClient   *http.Client
+		expectedSHA    string
+		expectedErrMsg string
+	}{
+		{
+			name: "branch name",
+			ref:  "main",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/main").andThen(
+					mockResponse(t, http.StatusOK, commitSHA),
+				),
+			}),
+			expectedSHA: commitSHA,
 		},
 		{
-			name: "dir and file match",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("name"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("name"), Type: github.Ptr("blob")},
-			},
-			path:       "name", // No trailing slash can match both files and directories
-			maxResults: -1,
-			expected:   []string{"name/", "name"},
+			name: "fully-qualified branch ref",
+			ref:  "refs/heads/main",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/main").andThen(
+					mockResponse(t, http.StatusOK, commitSHA),
+				),
+			}),
+			expectedSHA: commitSHA,
 		},
 		{
-			name: "dir only match",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("name"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("name"), Type: github.Ptr("blob")},
-			},
-			path:       "name/", // Trialing slash ensures only directories are matched
-			maxResults: -1,
-			expected:   []string{"name/"},
+			name: "tag name",
+			ref:  "refs/tags/v1.0.0",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/v1.0.0").andThen(
+					mockResponse(t, http.StatusOK, commitSHA),
+				),
+			}),
+			expectedSHA: commitSHA,
 		},
 		{
-			name: "max results limit 2",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
-			},
-			path:       "folder/",
-			maxResults: 2,
-			expected:   []string{"folder/", "nested/folder/"},
+			name:         "raw SHA is returned without API calls",
+			ref:          commitSHA,
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			expectedSHA:  commitSHA,
 		},
 		{
-			name: "max results limit 1",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
-			},
-			path:       "folder/",
-			maxResults: 1,
-			expected:   []string{"folder/"},
+			name: "empty ref resolves default branch head",
+			ref:  "",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: expectPath(t, "/repos/owner/repo/commits/HEAD").andThen(
+					mockResponse(t, http.StatusOK, commitSHA),
+				),
+			}),
+			expectedSHA: commitSHA,
 		},
 		{
-			name: "max results limit 0",
-			tree: []*github.TreeEntry{
-				{Path: github.Ptr("folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/folder"), Type: github.Ptr("tree")},
-				{Path: github.Ptr("nested/nested/folder"), Type: github.Ptr("tree")},
-			},
-			path:       "folder/",
-			maxResults: 0,
-			expected:   []string{},
+			name: "unknown ref",
+			ref:  "does-not-exist",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposCommitsByOwnerByRepoByRef: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					_, _ = w.Write([]byte(`{"message": "No commit found for SHA: does-not-exist"}`))
+				},
+			}),
+			expectedErrMsg: `could not resolve ref "does-not-exist" to a commit`,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result := filterPaths(tc.tree, tc.path, tc.maxResults)
-			assert.Equal(t, tc.expected, result)
+			client := github.NewClient(tc.mockedClient)
+			sha, err := resolveRef(context.Background(), client, "owner", "repo", tc.ref)
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSHA, sha)
 		})
 	}
 }
@@ -3906,6 +5818,147 @@ func Test_ListStarredRepositories(t *testing.T) {
 	}
 }
 
+func Test_ListStargazers(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListStargazers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_stargazers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "includeTimestamps")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	starredAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]any
+		expectError        bool
+		expectedStargazers []MinimalStargazer
+		expectedErrMsg     string
+	}{
+		{
+			name: "plain mode returns logins only",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposStargazersByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					assert.NotContains(t, r.Header.Get("Accept"), "star+json")
+					assert.Equal(t, "2", r.URL.Query().Get("page"))
+					assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(MustMarshal([]*github.User{
+						{Login: github.Ptr("octocat")},
+						{Login: github.Ptr("hubot")},
+					}))
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(5),
+			},
+			expectedStargazers: []MinimalStargazer{
+				{Login: "octocat"},
+				{Login: "hubot"},
+			},
+		},
+		{
+			name: "plain mode escapes the repository name",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposStargazersByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/repos/owner/re%3Fpo/stargazers", r.URL.EscapedPath())
+					assert.Equal(t, url.Values{"page": {"1"}, "per_page": {"30"}}, r.URL.Query())
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(MustMarshal([]*github.User{{Login: github.Ptr("octocat")}}))
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "re?po",
+			},
+			expectedStargazers: []MinimalStargazer{
+				{Login: "octocat"},
+			},
+		},
+		{
+			name: "timestamped mode includes starred_at",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposStargazersByOwnerByRepo: func(w http.ResponseWriter, r *http.Request) {
+					assert.Contains(t, r.Header.Get("Accept"), "application/vnd.github.star+json")
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(MustMarshal([]*github.Stargazer{
+						{
+							StarredAt: &github.Timestamp{Time: starredAt},
+							User:      &github.User{Login: github.Ptr("octocat")},
+						},
+					}))
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner":             "owner",
+				"repo":              "repo",
+				"includeTimestamps": true,
+			},
+			expectedStargazers: []MinimalStargazer{
+				{Login: "octocat", StarredAt: "2024-03-01T12:00:00Z"},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposStargazersByOwnerByRepo: func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				},
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list stargazers for owner/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []MinimalStargazer
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedStargazers, returned)
+		})
+	}
+}
+
 func Test_StarRepository(t *testing.T) {
 	// Verify tool definition once
 	serverTool := StarRepository(translations.NullTranslationHelper)
@@ -3927,10 +5980,17 @@ func Test_StarRepository(t *testing.T) {
 		requestArgs    map[string]any
 		expectError    bool
 		expectedErrMsg string
+		expectedResult StarResult
 	}{
 		{
 			name: "successful star",
 			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+					}),
+				),
 				WithRequestMatchHandler(
 					PutUserStarredByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -3943,10 +6003,52 @@ func Test_StarRepository(t *testing.T) {
 				"repo":  "testrepo",
 			},
 			expectError: false,
+			expectedResult: StarResult{
+				Owner:   "testowner",
+				Repo:    "testrepo",
+				Starred: true,
+				Changed: true,
+				Message: "Successfully starred repository testowner/testrepo",
+			},
+		},
+		{
+			name: "starred repository is left unchanged",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+				WithRequestMatchHandler(
+					PutUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+						t.Fatal("star endpoint should not be called")
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "testowner",
+				"repo":  "testrepo",
+			},
+			expectError: false,
+			expectedResult: StarResult{
+				Owner:   "testowner",
+				Repo:    "testrepo",
+				Starred: true,
+				Changed: false,
+				Message: "Repository testowner/testrepo is already starred",
+			},
 		},
 		{
 			name: "star fails",
 			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+					}),
+				),
 				WithRequestMatchHandler(
 					PutUserStarredByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -3962,6 +6064,24 @@ func Test_StarRepository(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to star repository",
 		},
+		{
+			name: "star status check fails",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnauthorized)
+						_, _ = w.Write([]byte(`{"message": "Requires authentication"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "testowner",
+				"repo":  "testrepo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to check star status of repository testowner/testrepo",
+		},
 	}
 
 	for _, tc := range tests {
@@ -3988,10 +6108,13 @@ func Test_StarRepository(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, result)
+				require.False(t, result.IsError)
 
 				// Parse the result and get the text content
 				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, "Successfully starred repository")
+				var returned StarResult
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, tc.expectedResult, returned)
 			}
 		})
 	}
@@ -4018,10 +6141,17 @@ func Test_UnstarRepository(t *testing.T) {
 		requestArgs    map[string]any
 		expectError    bool
 		expectedErrMsg string
+		expectedResult StarResult
 	}{
 		{
 			name: "successful unstar",
 			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
 				WithRequestMatchHandler(
 					DeleteUserStarredByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -4034,10 +6164,52 @@ func Test_UnstarRepository(t *testing.T) {
 				"repo":  "testrepo",
 			},
 			expectError: false,
+			expectedResult: StarResult{
+				Owner:   "testowner",
+				Repo:    "testrepo",
+				Starred: false,
+				Changed: true,
+				Message: "Successfully unstarred repository testowner/testrepo",
+			},
+		},
+		{
+			name: "not starred repository is left unchanged",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+					}),
+				),
+				WithRequestMatchHandler(
+					DeleteUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+						t.Fatal("unstar endpoint should not be called")
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "testowner",
+				"repo":  "testrepo",
+			},
+			expectError: false,
+			expectedResult: StarResult{
+				Owner:   "testowner",
+				Repo:    "testrepo",
+				Starred: false,
+				Changed: false,
+				Message: "Repository testowner/testrepo is already not starred",
+			},
 		},
 		{
 			name: "unstar fails",
 			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
 				WithRequestMatchHandler(
 					DeleteUserStarredByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -4053,6 +6225,24 @@ func Test_UnstarRepository(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to unstar repository",
 		},
+		{
+			name: "star status check fails",
+			mockedClient: NewMockedHTTPClient(
+				WithRequestMatchHandler(
+					GetUserStarredByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnauthorized)
+						_, _ = w.Write([]byte(`{"message": "Requires authentication"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]any{
+				"owner": "testowner",
+				"repo":  "testrepo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to check star status of repository testowner/testrepo",
+		},
 	}
 
 	for _, tc := range tests {
@@ -4079,10 +6269,13 @@ func Test_UnstarRepository(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, result)
+				require.False(t, result.IsError)
 
 				// Parse the result and get the text content
 				textContent := getTextResult(t, result)
-				assert.Contains(t, textContent.Text, "Successfully unstarred repository")
+				var returned StarResult
+				require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+				assert.Equal(t, tc.expectedResult, returned)
 			}
 		})
 	}
@@ -0,0 +1,211 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	// resultChunkBytes is the maximum size of a single chunk returned in chunked mode.
+	resultChunkBytes = 16 * 1024
+	// resultChunkTTL is how long the remaining chunks of a result stay available.
+	resultChunkTTL = 10 * time.Minute
+	// maxBufferedResults bounds how many chunked results are held at once.
+	maxBufferedResults = 100
+)
+
+// bufferedResult is a large result held server-side so it can be read chunk by chunk.
+type bufferedResult struct {
+	chunks  []string
+	expires time.Time
+}
+
+// resultChunkStore is a short-lived buffer of chunked results keyed by an opaque ID.
+type resultChunkStore struct {
+	mu      sync.Mutex
+	results map[string]*bufferedResult
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+func newResultChunkStore(ttl time.Duration) *resultChunkStore {
+	return &resultChunkStore{
+		results: make(map[string]*bufferedResult),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// defaultResultChunkStore is shared by all tools that support chunked mode.
+var defaultResultChunkStore = newResultChunkStore(resultChunkTTL)
+
+type resultChunkStoreKey struct{}
+
+// contextWithResultChunkStore returns a context that uses store for chunked results.
+// Tests use it to control expiry without touching the shared store.
+func contextWithResultChunkStore(ctx context.Context, store *resultChunkStore) context.Context {
+	return context.WithValue(ctx, resultChunkStoreKey{}, store)
+}
+
+func resultChunkStoreFromContext(ctx context.Context) *resultChunkStore {
+	if store, ok := ctx.Value(resultChunkStoreKey{}).(*resultChunkStore); ok {
+		return store
+	}
+	return defaultResultChunkStore
+}
+
+// splitResultChunks splits content into chunks of at most size bytes without
+// breaking multi-byte characters.
+func splitResultChunks(content string, size int) []string {
+	var chunks []string
+	for len(content) > size {
+		end := size
+		for end > 0 && !utf8.RuneStart(content[end]) {
+			end--
+		}
+		if end == 0 {
+			end = size
+		}
+		chunks = append(chunks, content[:end])
+		content = content[end:]
+	}
+	return append(chunks, content)
+}
+
+// ResultChunk is one chunk of a result returned in chunked mode.
+type ResultChunk struct {
+	Content     string `json:"content"`
+	Index       int    `json:"index"`
+	TotalChunks int    `json:"total_chunks"`
+	NextCursor  string `json:"next_cursor,omitempty"`
+}
+
+// chunk buffers content if it does not fit in a single chunk. It returns the first
+// chunk, with NextCursor set when there is more to read.
+func (s *resultChunkStore) chunk(content string) (ResultChunk, error) {
+	chunks := splitResultChunks(content, resultChunkBytes)
+	if len(chunks) == 1 {
+		return ResultChunk{Content: content, TotalChunks: 1}, nil
+	}
+
+	idBytes := make([]byte, 12)
+	if _, err := rand.Read(idBytes); err != nil {
+		return ResultChunk{}, fmt.Errorf("failed to generate cursor: %w", err)
+	}
+	id := hex.EncodeToString(idBytes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneLocked()
+	s.results[id] = &bufferedResult{chunks: chunks, expires: s.now().Add(s.ttl)}
+
+	return ResultChunk{
+		Content:     chunks[0],
+		TotalChunks: len(chunks),
+		NextCursor:  id + ".1",
+	}, nil
+}
+
+// get returns the chunk a cursor points at.
+func (s *resultChunkStore) get(cursor string) (ResultChunk, error) {
+	id, indexStr, ok := strings.Cut(cursor, ".")
+	index, err := strconv.Atoi(indexStr)
+	if !ok || err != nil || index < 0 {
+		return ResultChunk{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result, ok := s.results[id]
+	if !ok || s.now().After(result.expires) {
+		delete(s.results, id)
+		return ResultChunk{}, fmt.Errorf("cursor %q has expired or does not exist; call the original tool again", cursor)
+	}
+	if index >= len(result.chunks) {
+		return ResultChunk{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	chunk := ResultChunk{
+		Content:     result.chunks[index],
+		Index:       index,
+		TotalChunks: len(result.chunks),
+	}
+	if index+1 < len(result.chunks) {
+		chunk.NextCursor = fmt.Sprintf("%s.%d", id, index+1)
+	}
+	return chunk, nil
+}
+
+// pruneLocked drops expired results, then the ones closest to expiry while the
+// store is full. The caller must hold mu.
+func (s *resultChunkStore) pruneLocked() {
+	now := s.now()
+	for id, result := range s.results {
+		if now.After(result.expires) {
+			delete(s.results, id)
+		}
+	}
+	for len(s.results) >= maxBufferedResults {
+		var oldestID string
+		var oldest time.Time
+		for id, result := range s.results {
+			if oldestID == "" || result.expires.Before(oldest) {
+				oldestID, oldest = id, result.expires
+			}
+		}
+		delete(s.results, oldestID)
+	}
+}
+
+// GetResultChunk creates a tool that reads the remaining chunks of a result
+// returned in chunked mode.
+func GetResultChunk(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataContext,
+		mcp.Tool{
+			Name:        "get_result_chunk",
+			Description: t("TOOL_GET_RESULT_CHUNK_DESCRIPTION", "Get the next chunk of a large result returned in chunked mode, e.g. by get_file_contents or get_job_logs with chunked=true. Pass the cursor from the previous response; cursors expire after a few minutes"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_RESULT_CHUNK_USER_TITLE", "Get result chunk"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"cursor": {
+						Type:        "string",
+						Description: "Cursor of the chunk to get, as returned in next_cursor",
+					},
+				},
+				Required: []string{"cursor"},
+			},
+		},
+		nil,
+		func(ctx context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			cursor, err := RequiredParam[string](args, "cursor")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			chunk, err := resultChunkStoreFromContext(ctx).get(cursor)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			return MarshalledTextResult(chunk), nil, nil
+		},
+	)
+}
@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitResultChunks(t *testing.T) {
+	assert.Equal(t, []string{"short"}, splitResultChunks("short", 10))
+	assert.Equal(t, []string{"abcd", "efgh", "ij"}, splitResultChunks("abcdefghij", 4))
+
+	// Multi-byte characters are never split across chunks
+	chunks := splitResultChunks("aé€b", 3)
+	assert.Equal(t, []string{"aé", "€", "b"}, chunks)
+	assert.Equal(t, "aé€b", strings.Join(chunks, ""))
+}
+
+func Test_resultChunkStore(t *testing.T) {
+	store := newResultChunkStore(time.Minute)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	// Small results are returned whole without buffering
+	small, err := store.chunk("tiny")
+	require.NoError(t, err)
+	assert.Equal(t, ResultChunk{Content: "tiny", TotalChunks: 1}, small)
+	assert.Empty(t, store.results)
+
+	content := strings.Repeat("x", resultChunkBytes*2+10)
+	first, err := store.chunk(content)
+	require.NoError(t, err)
+	assert.Len(t, first.Content, resultChunkBytes)
+	assert.Equal(t, 3, first.TotalChunks)
+	require.NotEmpty(t, first.NextCursor)
+
+	second, err := store.get(first.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, 1, second.Index)
+	assert.Len(t, second.Content, resultChunkBytes)
+
+	third, err := store.get(second.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, 2, third.Index)
+	assert.Len(t, third.Content, 10)
+	assert.Empty(t, third.NextCursor)
+	assert.Equal(t, content, first.Content+second.Content+third.Content)
+
+	_, err = store.get("not-a-cursor")
+	assert.ErrorContains(t, err, "invalid cursor")
+
+	// Chunks can be re-read until the result expires
+	now = now.Add(2 * time.Minute)
+	_, err = store.get(first.NextCursor)
+	assert.ErrorContains(t, err, "has expired or does not exist")
+	assert.Empty(t, store.results)
+}
+
+func Test_GetResultChunk(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetResultChunk(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_result_chunk", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "cursor")
+	assert.ElementsMatch(t, schema.Required, []string{"cursor"})
+
+	store := newResultChunkStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	first, err := store.chunk(strings.Repeat("a", resultChunkBytes) + "tail")
+	require.NoError(t, err)
+
+	deps := BaseDeps{}
+	handler := serverTool.Handler(deps)
+	ctx := contextWithResultChunkStore(ContextWithDeps(context.Background(), deps), store)
+
+	request := createMCPRequest(map[string]any{"cursor": first.NextCursor})
+	result, err := handler(ctx, &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var chunk ResultChunk
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &chunk))
+	assert.Equal(t, ResultChunk{Content: "tail", Index: 1, TotalChunks: 2}, chunk)
+
+	// The cursor stops working once the buffered result expires
+	now = now.Add(2 * time.Minute)
+	result, err = handler(ctx, &request)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, getErrorResult(t, result).Text, "has expired or does not exist")
+}
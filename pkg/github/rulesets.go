@@ -0,0 +1,255 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// MinimalRulesetRule is a single rule of a ruleset, e.g. "pull_request" with its
+// required approving review count.
+type MinimalRulesetRule struct {
+	Type       string         `json:"type"`
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// MinimalRuleset is the part of a repository ruleset that decides what it
+// governs and how. Rules are only returned when getting a single ruleset.
+type MinimalRuleset struct {
+	ID                   int64                               `json:"id"`
+	Name                 string                              `json:"name"`
+	Target               string                              `json:"target,omitempty"`
+	SourceType           string                              `json:"source_type,omitempty"`
+	Source               string                              `json:"source,omitempty"`
+	Enforcement          string                              `json:"enforcement"`
+	CurrentUserCanBypass string                              `json:"current_user_can_bypass,omitempty"`
+	Conditions           *github.RepositoryRulesetConditions `json:"conditions,omitempty"`
+	Rules                []MinimalRulesetRule                `json:"rules,omitempty"`
+	HTMLURL              string                              `json:"html_url,omitempty"`
+}
+
+// convertToMinimalRuleset flattens a ruleset, expanding its rules into a list of
+// rule types and parameters.
+func convertToMinimalRuleset(ruleset *github.RepositoryRuleset) (MinimalRuleset, error) {
+	m := MinimalRuleset{
+		ID:          ruleset.GetID(),
+		Name:        ruleset.Name,
+		Source:      ruleset.Source,
+		Enforcement: string(ruleset.Enforcement),
+		Conditions:  ruleset.Conditions,
+	}
+	if ruleset.Target != nil {
+		m.Target = string(*ruleset.Target)
+	}
+	if ruleset.SourceType != nil {
+		m.SourceType = string(*ruleset.SourceType)
+	}
+	if ruleset.CurrentUserCanBypass != nil {
+		m.CurrentUserCanBypass = string(*ruleset.CurrentUserCanBypass)
+	}
+	if ruleset.Links != nil && ruleset.Links.HTML != nil {
+		m.HTMLURL = ruleset.Links.HTML.GetHRef()
+	}
+
+	if ruleset.Rules != nil {
+		// The rules marshal to the API's list of {type, parameters} objects
+		raw, err := json.Marshal(ruleset.Rules)
+		if err != nil {
+			return MinimalRuleset{}, fmt.Errorf("failed to marshal ruleset rules: %w", err)
+		}
+		if err := json.Unmarshal(raw, &m.Rules); err != nil {
+			return MinimalRuleset{}, fmt.Errorf("failed to unmarshal ruleset rules: %w", err)
+		}
+	}
+	return m, nil
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets that apply to a repository.
+func ListRepositoryRulesets(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "list_repository_rulesets",
+			Description: t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets that apply to a GitHub repository, with their name, target (branch, tag or push), enforcement and conditions. Rulesets, alongside branch protection, govern what can be pushed and merged. Use get_repository_ruleset to see the rules of a ruleset"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_REPOSITORY_RULESETS_USER_TITLE", "List repository rulesets"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: WithPagination(&jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"includesParents": {
+						Type:        "boolean",
+						Description: "Include rulesets configured at the organization or enterprise level that apply to the repository",
+						Default:     json.RawMessage(`true`),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			}),
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includesParents, err := OptionalBoolParamWithDefault(args, "includesParents", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			pagination, err := OptionalPaginationParams(args)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+				IncludesParents: github.Ptr(includesParents),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list repository rulesets",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to list repository rulesets", resp, body), nil, nil
+			}
+
+			result := make([]MinimalRuleset, 0, len(rulesets))
+			for _, ruleset := range rulesets {
+				m, err := convertToMinimalRuleset(ruleset)
+				if err != nil {
+					return nil, nil, err
+				}
+				result = append(result, m)
+			}
+
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
+
+// GetRepositoryRuleset creates a tool to get a single repository ruleset with its rules.
+func GetRepositoryRuleset(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_repository_ruleset",
+			Description: t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION", "Get a ruleset that applies to a GitHub repository, including its rules (e.g. required pull request reviews, required status checks, linear history) and their parameters"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_REPOSITORY_RULESET_USER_TITLE", "Get repository ruleset"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"rulesetId": {
+						Type:        "number",
+						Description: "The ID of the ruleset, as returned by list_repository_rulesets",
+					},
+					"includesParents": {
+						Type:        "boolean",
+						Description: "Also find rulesets configured at the organization or enterprise level that apply to the repository",
+						Default:     json.RawMessage(`true`),
+					},
+				},
+				Required: []string{"owner", "repo", "rulesetId"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			rulesetID, err := RequiredBigInt(args, "rulesetId")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includesParents, err := OptionalBoolParamWithDefault(args, "includesParents", true)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, rulesetID, includesParents)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to get repository ruleset",
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, "failed to get repository ruleset", resp, body), nil, nil
+			}
+
+			result, err := convertToMinimalRuleset(ruleset)
+			if err != nil {
+				return nil, nil, err
+			}
+			return MarshalledTextResult(result), nil, nil
+		},
+	)
+}
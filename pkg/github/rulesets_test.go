@@ -0,0 +1,278 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListRepositoryRulesets(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "includesParents")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []map[string]any{
+		{
+			"id":          42,
+			"name":        "main protection",
+			"target":      "branch",
+			"source_type": "Repository",
+			"source":      "owner/repo",
+			"enforcement": "active",
+		},
+		{
+			"id":          7,
+			"name":        "org release tags",
+			"target":      "tag",
+			"source_type": "Organization",
+			"source":      "owner",
+			"enforcement": "evaluate",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful rulesets list",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepo: expectQueryParams(t, map[string]string{
+					"includes_parents": "false",
+					"page":             "2",
+					"per_page":         "10",
+				}).andThen(mockResponse(t, http.StatusOK, mockRulesets)),
+			}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"includesParents": false,
+				"page":            float64(2),
+				"perPage":         float64(10),
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list repository rulesets",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var rulesets []MinimalRuleset
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &rulesets))
+			require.Len(t, rulesets, 2)
+			assert.Equal(t, MinimalRuleset{
+				ID:          42,
+				Name:        "main protection",
+				Target:      "branch",
+				SourceType:  "Repository",
+				Source:      "owner/repo",
+				Enforcement: "active",
+			}, rulesets[0])
+			assert.Equal(t, "tag", rulesets[1].Target)
+			assert.Equal(t, "Organization", rulesets[1].SourceType)
+			assert.Equal(t, "evaluate", rulesets[1].Enforcement)
+		})
+	}
+}
+
+func Test_GetRepositoryRuleset(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetRepositoryRuleset(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "rulesetId"})
+
+	mockRuleset := map[string]any{
+		"id":                      42,
+		"name":                    "main protection",
+		"target":                  "branch",
+		"source_type":             "Repository",
+		"source":                  "owner/repo",
+		"enforcement":             "active",
+		"current_user_can_bypass": "never",
+		"conditions": map[string]any{
+			"ref_name": map[string]any{
+				"include": []string{"~DEFAULT_BRANCH"},
+				"exclude": []string{},
+			},
+		},
+		"rules": []map[string]any{
+			{"type": "deletion"},
+			{"type": "required_linear_history"},
+			{
+				"type": "pull_request",
+				"parameters": map[string]any{
+					"dismiss_stale_reviews_on_push":     true,
+					"require_code_owner_review":         true,
+					"require_last_push_approval":        false,
+					"required_approving_review_count":   2,
+					"required_review_thread_resolution": true,
+				},
+			},
+			{
+				"type": "required_status_checks",
+				"parameters": map[string]any{
+					"strict_required_status_checks_policy": true,
+					"required_status_checks": []map[string]any{
+						{"context": "ci/build"},
+					},
+				},
+			},
+		},
+		"_links": map[string]any{
+			"html": map[string]any{"href": "https://github.com/owner/repo/rules/42"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful ruleset retrieval",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: expectPath(t, "/repos/owner/repo/rulesets/42").andThen(
+					mockResponse(t, http.StatusOK, mockRuleset),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"rulesetId": float64(42),
+			},
+		},
+		{
+			name:         "missing ruleset id",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: rulesetId",
+		},
+		{
+			name: "ruleset not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposRulesetsByOwnerByRepoByRulesetID: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":     "owner",
+				"repo":      "repo",
+				"rulesetId": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository ruleset",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{Client: github.NewClient(tc.mockedClient)}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			var ruleset MinimalRuleset
+			require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &ruleset))
+
+			assert.Equal(t, int64(42), ruleset.ID)
+			assert.Equal(t, "main protection", ruleset.Name)
+			assert.Equal(t, "branch", ruleset.Target)
+			assert.Equal(t, "active", ruleset.Enforcement)
+			assert.Equal(t, "never", ruleset.CurrentUserCanBypass)
+			assert.Equal(t, "https://github.com/owner/repo/rules/42", ruleset.HTMLURL)
+			require.NotNil(t, ruleset.Conditions)
+			require.NotNil(t, ruleset.Conditions.RefName)
+			assert.Equal(t, []string{"~DEFAULT_BRANCH"}, ruleset.Conditions.RefName.Include)
+
+			// Rules are mapped to their type and parameters
+			require.Len(t, ruleset.Rules, 4)
+			types := make([]string, 0, len(ruleset.Rules))
+			for _, rule := range ruleset.Rules {
+				types = append(types, rule.Type)
+			}
+			assert.ElementsMatch(t, []string{"deletion", "required_linear_history", "pull_request", "required_status_checks"}, types)
+
+			for _, rule := range ruleset.Rules {
+				switch rule.Type {
+				case "deletion", "required_linear_history":
+					assert.Empty(t, rule.Parameters)
+				case "pull_request":
+					assert.Equal(t, float64(2), rule.Parameters["required_approving_review_count"])
+					assert.Equal(t, true, rule.Parameters["require_code_owner_review"])
+					assert.Equal(t, true, rule.Parameters["required_review_thread_resolution"])
+				case "required_status_checks":
+					assert.Equal(t, true, rule.Parameters["strict_required_status_checks_policy"])
+					checks, ok := rule.Parameters["required_status_checks"].([]any)
+					require.True(t, ok)
+					require.Len(t, checks, 1)
+					assert.Equal(t, "ci/build", checks[0].(map[string]any)["context"])
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/scopes"
@@ -55,10 +57,29 @@ func onlyRequiresRepoScopes(acceptedScopes []string) bool {
 //	inventory := github.NewInventory(t).WithFilter(filter).Build()
 func CreateToolScopeFilter(tokenScopes []string) inventory.ToolFilter {
 	return func(_ context.Context, tool *inventory.ServerTool) (bool, error) {
-		// Read-only tools requiring only repo/public_repo work on public repos without any scope
-		if tool.Tool.Annotations != nil && tool.Tool.Annotations.ReadOnlyHint && onlyRequiresRepoScopes(tool.AcceptedScopes) {
-			return true, nil
-		}
-		return scopes.HasRequiredScopes(tokenScopes, tool.AcceptedScopes), nil
+		return hasToolScopes(tool, tokenScopes), nil
+	}
+}
+
+// hasToolScopes reports whether a token with tokenScopes can use tool.
+func hasToolScopes(tool *inventory.ServerTool, tokenScopes []string) bool {
+	// Read-only tools requiring only repo/public_repo work on public repos without any scope
+	if tool.IsReadOnly() && onlyRequiresRepoScopes(tool.AcceptedScopes) {
+		return true
+	}
+	return scopes.HasRequiredScopes(tokenScopes, tool.AcceptedScopes)
+}
+
+// CheckToolScopes returns an error naming the scopes tool requires if a token with
+// tokenScopes cannot use it. It applies the same rules as CreateToolScopeFilter, so
+// callers can fail fast instead of surfacing a 403 from the middle of an operation.
+func CheckToolScopes(tool *inventory.ServerTool, tokenScopes []string) error {
+	if hasToolScopes(tool, tokenScopes) {
+		return nil
+	}
+	required := tool.RequiredScopes
+	if len(required) == 0 {
+		required = tool.AcceptedScopes
 	}
+	return fmt.Errorf("this tool requires '%s' scope, which the token does not have", strings.Join(required, "' or '"))
 }
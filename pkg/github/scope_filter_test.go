@@ -189,3 +189,25 @@ func TestCreateToolScopeFilter_Integration(t *testing.T) {
 	assert.Contains(t, toolNames, "repo_tool")
 	assert.NotContains(t, toolNames, "gist_tool")
 }
+
+func TestCheckToolScopes(t *testing.T) {
+	gistTool := &inventory.ServerTool{
+		Tool:           mcp.Tool{Name: "create_gist"},
+		RequiredScopes: []string{"gist"},
+		AcceptedScopes: []string{"gist"},
+	}
+
+	err := CheckToolScopes(gistTool, []string{"repo"})
+	require.Error(t, err)
+	assert.Equal(t, "this tool requires 'gist' scope, which the token does not have", err.Error())
+
+	assert.NoError(t, CheckToolScopes(gistTool, []string{"repo", "gist"}))
+
+	// Read-only repo tools work on public repositories without any scope
+	readRepoTool := &inventory.ServerTool{
+		Tool:           mcp.Tool{Name: "get_file_contents", Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true}},
+		RequiredScopes: []string{"repo"},
+		AcceptedScopes: []string{"repo"},
+	}
+	assert.NoError(t, CheckToolScopes(readRepoTool, nil))
+}
@@ -46,7 +46,7 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 	}
 	WithPagination(schema)
 
-	return NewTool(
+	tool := NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
 			Name:        "search_repositories",
@@ -164,6 +164,8 @@ func SearchRepositories(t translations.TranslationHelperFunc) inventory.ServerTo
 			return utils.NewToolResultText(string(r)), nil, nil
 		},
 	)
+	tool.SearchQueryArgument = "query"
+	return tool
 }
 
 // SearchCode creates a tool to search for code across GitHub repositories.
@@ -189,7 +191,7 @@ func SearchCode(t translations.TranslationHelperFunc) inventory.ServerTool {
 	}
 	WithPagination(schema)
 
-	return NewTool(
+	tool := NewTool(
 		ToolsetMetadataRepos,
 		mcp.Tool{
 			Name:        "search_code",
@@ -259,6 +261,8 @@ func SearchCode(t translations.TranslationHelperFunc) inventory.ServerTool {
 			return utils.NewToolResultText(string(r)), nil, nil
 		},
 	)
+	tool.SearchQueryArgument = "query"
+	return tool
 }
 
 func userOrOrgHandler(ctx context.Context, accountType string, deps ToolDependencies, args map[string]any) (*mcp.CallToolResult, any, error) {
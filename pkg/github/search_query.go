@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// searchQualifiers lists the qualifiers GitHub search understands for each
+// search type. Qualifiers outside these sets are silently treated as free
+// text by the API, which is rarely what the caller intended.
+var searchQualifiers = map[string][]string{
+	"issues": {
+		"app", "archived", "assignee", "author", "base", "closed", "comments",
+		"commenter", "created", "draft", "has", "head", "in", "interactions",
+		"involves", "is", "label", "language", "linked", "mentions", "merged",
+		"milestone", "no", "org", "parent-issue", "project", "reactions", "reason",
+		"repo", "review", "review-requested", "reviewed-by", "sort", "state",
+		"status", "team", "team-review-requested", "type", "updated", "user",
+		"user-review-requested",
+	},
+	"code": {
+		"content", "enterprise", "extension", "filename", "fork", "in", "is",
+		"language", "org", "path", "repo", "size", "symbol", "user",
+	},
+	"repos": {
+		"archived", "created", "followers", "fork", "forks", "good-first-issues",
+		"has", "help-wanted-issues", "in", "is", "language", "license", "mirror",
+		"org", "pushed", "repo", "size", "sort", "stars", "template", "topic",
+		"topics", "user",
+	},
+	"commits": {
+		"author", "author-date", "author-email", "author-name", "committer",
+		"committer-date", "committer-email", "committer-name", "hash", "is",
+		"merge", "org", "parent", "repo", "sort", "tree", "user",
+	},
+	"users": {
+		"created", "followers", "fullname", "in", "is", "language", "location",
+		"repos", "sort", "sponsorable", "type",
+	},
+}
+
+// searchQueryTypes returns the supported search types in a stable order.
+func searchQueryTypes() []string {
+	types := make([]string, 0, len(searchQualifiers))
+	for k := range searchQualifiers {
+		types = append(types, k)
+	}
+	slices.Sort(types)
+	return types
+}
+
+var searchQualifierKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z-]*$`)
+
+// SearchQueryWarning describes a likely problem with a search query.
+type SearchQueryWarning struct {
+	// Kind is a short machine-readable category, e.g. "unknown_qualifier".
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	// Token is the part of the query the warning refers to, if any.
+	Token string `json:"token,omitempty"`
+}
+
+// SearchQueryValidation is the result of validate_search_query.
+type SearchQueryValidation struct {
+	SearchType string               `json:"search_type"`
+	Query      string               `json:"query"`
+	Valid      bool                 `json:"valid"`
+	Warnings   []SearchQueryWarning `json:"warnings"`
+}
+
+// tokenizeSearchQuery splits a query on whitespace outside of double quotes.
+// It also reports whether a quote was left open.
+func tokenizeSearchQuery(query string) ([]string, bool) {
+	var tokens []string
+	var current strings.Builder
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuote:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, inQuote
+}
+
+// validateSearchQuery checks query for syntax problems and qualifiers that
+// searchType does not support. It does not contact the API, so an empty
+// result means the query looks plausible rather than that it will match.
+func validateSearchQuery(searchType, query string) []SearchQueryWarning {
+	warnings := []SearchQueryWarning{}
+	if strings.TrimSpace(query) == "" {
+		return append(warnings, SearchQueryWarning{Kind: "empty_query", Message: "query is empty"})
+	}
+
+	tokens, unbalancedQuote := tokenizeSearchQuery(query)
+	if unbalancedQuote {
+		warnings = append(warnings, SearchQueryWarning{
+			Kind:    "unbalanced_quotes",
+			Message: "query has an unterminated double quote",
+		})
+	}
+
+	depth := 0
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+		case r == '(' && !inQuote:
+			depth++
+		case r == ')' && !inQuote:
+			depth--
+		}
+		if depth < 0 {
+			break
+		}
+	}
+	if depth != 0 {
+		warnings = append(warnings, SearchQueryWarning{
+			Kind:    "unbalanced_parentheses",
+			Message: "query has unbalanced parentheses",
+		})
+	}
+
+	known := searchQualifiers[searchType]
+	for i, token := range tokens {
+		if token == "AND" || token == "OR" || token == "NOT" {
+			if i == 0 || i == len(tokens)-1 {
+				warnings = append(warnings, SearchQueryWarning{
+					Kind:    "dangling_operator",
+					Message: fmt.Sprintf("boolean operator %s needs a term on both sides", token),
+					Token:   token,
+				})
+			}
+			continue
+		}
+		if strings.HasPrefix(token, `"`) {
+			continue
+		}
+
+		key, value, ok := strings.Cut(strings.TrimLeft(token, "(-"), ":")
+		if !ok || !searchQualifierKeyPattern.MatchString(key) || strings.HasPrefix(value, "//") {
+			continue
+		}
+		if !slices.Contains(known, strings.ToLower(key)) {
+			warnings = append(warnings, SearchQueryWarning{
+				Kind:    "unknown_qualifier",
+				Message: fmt.Sprintf("%q is not a recognized qualifier for %s search and will be treated as text", key, searchType),
+				Token:   token,
+			})
+			continue
+		}
+		if strings.TrimRight(value, ")") == "" {
+			warnings = append(warnings, SearchQueryWarning{
+				Kind:    "missing_value",
+				Message: fmt.Sprintf("qualifier %q has no value", key),
+				Token:   token,
+			})
+		}
+	}
+
+	return warnings
+}
+
+// ValidateSearchQuery creates a tool that checks a search query for obvious
+// mistakes without running it.
+func ValidateSearchQuery(t translations.TranslationHelperFunc) inventory.ServerTool {
+	types := searchQueryTypes()
+	typeEnum := make([]any, len(types))
+	for i, st := range types {
+		typeEnum[i] = st
+	}
+
+	return NewTool(
+		ToolsetMetadataExperiments,
+		mcp.Tool{
+			Name:        "validate_search_query",
+			Description: t("TOOL_VALIDATE_SEARCH_QUERY_DESCRIPTION", "Check a GitHub search query for syntax problems such as unbalanced quotes or parentheses and for qualifiers the chosen search type does not support, without running the search. Use this before a search tool when a query returns unexpected results."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_VALIDATE_SEARCH_QUERY_USER_TITLE", "Validate search query"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"query": {
+						Type:        "string",
+						Description: "Search query to validate",
+					},
+					"search_type": {
+						Type:        "string",
+						Description: "Kind of search the query is intended for",
+						Enum:        typeEnum,
+					},
+				},
+				Required: []string{"query", "search_type"},
+			},
+		},
+		nil,
+		func(_ context.Context, _ ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			query, err := RequiredParam[string](args, "query")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			searchType, err := RequiredParam[string](args, "search_type")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			if _, ok := searchQualifiers[searchType]; !ok {
+				return utils.NewToolResultError(fmt.Sprintf("invalid search_type %q: must be one of %s", searchType, strings.Join(types, ", "))), nil, nil
+			}
+
+			warnings := validateSearchQuery(searchType, query)
+			return MarshalledTextResult(SearchQueryValidation{
+				SearchType: searchType,
+				Query:      query,
+				Valid:      len(warnings) == 0,
+				Warnings:   warnings,
+			}), nil, nil
+		},
+	)
+}
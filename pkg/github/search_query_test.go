@@ -0,0 +1,183 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_validateSearchQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		searchType    string
+		query         string
+		expectedKinds []string
+	}{
+		{
+			name:       "valid issue query",
+			searchType: "issues",
+			query:      `repo:github/github-mcp-server is:open label:"good first issue" -author:bot (crash OR panic)`,
+		},
+		{
+			name:       "valid code query with URL text",
+			searchType: "code",
+			query:      `language:go path:pkg/ "https://api.github.com"`,
+		},
+		{
+			name:          "unknown qualifier",
+			searchType:    "issues",
+			query:         "repo:owner/repo stars:>10 bug",
+			expectedKinds: []string{"unknown_qualifier"},
+		},
+		{
+			name:          "qualifier from another search type",
+			searchType:    "users",
+			query:         "location:berlin label:bug",
+			expectedKinds: []string{"unknown_qualifier"},
+		},
+		{
+			name:          "unbalanced quotes",
+			searchType:    "repos",
+			query:         `"model context protocol`,
+			expectedKinds: []string{"unbalanced_quotes"},
+		},
+		{
+			name:          "unbalanced parentheses",
+			searchType:    "issues",
+			query:         "(crash OR panic",
+			expectedKinds: []string{"unbalanced_parentheses"},
+		},
+		{
+			name:          "missing qualifier value",
+			searchType:    "commits",
+			query:         "author: fix",
+			expectedKinds: []string{"missing_value"},
+		},
+		{
+			name:          "dangling operator",
+			searchType:    "code",
+			query:         "parser OR",
+			expectedKinds: []string{"dangling_operator"},
+		},
+		{
+			name:          "empty query",
+			searchType:    "issues",
+			query:         "  ",
+			expectedKinds: []string{"empty_query"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings := validateSearchQuery(tc.searchType, tc.query)
+			kinds := make([]string, 0, len(warnings))
+			for _, w := range warnings {
+				kinds = append(kinds, w.Kind)
+			}
+			assert.ElementsMatch(t, tc.expectedKinds, kinds)
+		})
+	}
+}
+
+func Test_ValidateSearchQuery(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ValidateSearchQuery(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "validate_search_query", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, ToolsetMetadataExperiments.ID, serverTool.Toolset.ID)
+	assert.Contains(t, schema.Properties, "query")
+	assert.Contains(t, schema.Properties, "search_type")
+	assert.ElementsMatch(t, schema.Required, []string{"query", "search_type"})
+
+	tests := []struct {
+		name             string
+		requestArgs      map[string]any
+		expectError      bool
+		expectedErrMsg   string
+		expectedValid    bool
+		expectedWarnings []SearchQueryWarning
+	}{
+		{
+			name: "valid query",
+			requestArgs: map[string]any{
+				"query":       "repo:owner/repo is:pr is:open review-requested:@me",
+				"search_type": "issues",
+			},
+			expectedValid:    true,
+			expectedWarnings: []SearchQueryWarning{},
+		},
+		{
+			name: "unknown qualifier is flagged",
+			requestArgs: map[string]any{
+				"query":       "language:go stars:>100 parser",
+				"search_type": "code",
+			},
+			expectedValid: false,
+			expectedWarnings: []SearchQueryWarning{
+				{
+					Kind:    "unknown_qualifier",
+					Message: `"stars" is not a recognized qualifier for code search and will be treated as text`,
+					Token:   "stars:>100",
+				},
+			},
+		},
+		{
+			name: "invalid search type",
+			requestArgs: map[string]any{
+				"query":       "bug",
+				"search_type": "gists",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid search_type "gists": must be one of code, commits, issues, repos, users`,
+		},
+		{
+			name: "missing query",
+			requestArgs: map[string]any{
+				"search_type": "issues",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: query",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := BaseDeps{}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned SearchQueryValidation
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.requestArgs["query"], returned.Query)
+			assert.Equal(t, tc.expectedValid, returned.Valid)
+			assert.Equal(t, tc.expectedWarnings, returned.Warnings)
+		})
+	}
+}
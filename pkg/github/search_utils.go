@@ -7,13 +7,100 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"slices"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/utils"
 	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
+// searchResultFields lists the top-level issue and pull request fields that
+// can be requested through the "fields" projection of the search tools.
+var searchResultFields = []string{
+	"id",
+	"node_id",
+	"number",
+	"title",
+	"body",
+	"state",
+	"state_reason",
+	"locked",
+	"draft",
+	"user",
+	"labels",
+	"assignees",
+	"milestone",
+	"comments",
+	"reactions",
+	"author_association",
+	"pull_request",
+	"url",
+	"html_url",
+	"repository_url",
+	"created_at",
+	"updated_at",
+	"closed_at",
+}
+
+// searchFieldsSchema returns the schema for the optional "fields" projection
+// argument shared by the issue and pull request search tools.
+func searchFieldsSchema() *jsonschema.Schema {
+	enum := make([]any, len(searchResultFields))
+	for i, f := range searchResultFields {
+		enum[i] = f
+	}
+	return &jsonschema.Schema{
+		Type:        "array",
+		Description: "Optional list of fields to include for each result item. When omitted, full objects are returned.",
+		Items: &jsonschema.Schema{
+			Type: "string",
+			Enum: enum,
+		},
+	}
+}
+
+// validateSearchFields returns an error naming the first field that is not
+// part of searchResultFields.
+func validateSearchFields(fields []string) error {
+	for _, f := range fields {
+		if !slices.Contains(searchResultFields, f) {
+			return fmt.Errorf("unknown field %q; valid fields are: %s", f, strings.Join(searchResultFields, ", "))
+		}
+	}
+	return nil
+}
+
+// projectSearchResult trims every item of the search result down to the
+// requested fields. Fields that are unset on an item are omitted.
+func projectSearchResult(result *github.IssuesSearchResult, fields []string) (map[string]any, error) {
+	items := make([]map[string]any, 0, len(result.Issues))
+	for _, issue := range result.Issues {
+		raw, err := json.Marshal(issue)
+		if err != nil {
+			return nil, err
+		}
+		var full map[string]any
+		if err := json.Unmarshal(raw, &full); err != nil {
+			return nil, err
+		}
+		item := make(map[string]any, len(fields))
+		for _, f := range fields {
+			if v, ok := full[f]; ok {
+				item[f] = v
+			}
+		}
+		items = append(items, item)
+	}
+	return map[string]any{
+		"total_count":        result.GetTotal(),
+		"incomplete_results": result.GetIncompleteResults(),
+		"items":              items,
+	}, nil
+}
+
 func hasFilter(query, filterType string) bool {
 	// Match filter at start of string, after whitespace, or after non-word characters like '('
 	pattern := fmt.Sprintf(`(^|\s|\W)%s:\S+`, regexp.QuoteMeta(filterType))
@@ -79,6 +166,13 @@ func searchHandler(
 	if err != nil {
 		return utils.NewToolResultError(err.Error()), nil
 	}
+	fields, err := OptionalStringArrayParam(args, "fields")
+	if err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
+	if err := validateSearchFields(fields); err != nil {
+		return utils.NewToolResultError(err.Error()), nil
+	}
 
 	opts := &github.SearchOptions{
 		// Default to "created" if no sort is provided, as it's a common use case.
@@ -108,7 +202,16 @@ func searchHandler(
 		return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil
 	}
 
-	r, err := json.Marshal(result)
+	var out any = result
+	if len(fields) > 0 {
+		projected, err := projectSearchResult(result, fields)
+		if err != nil {
+			return utils.NewToolResultErrorFromErr(errorPrefix+": failed to project fields", err), nil
+		}
+		out = projected
+	}
+
+	r, err := json.Marshal(out)
 	if err != nil {
 		return utils.NewToolResultErrorFromErr(errorPrefix+": failed to marshal response", err), nil
 	}
@@ -0,0 +1,293 @@
+package github
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// redactedSecret replaces each secret found in a tool result.
+const redactedSecret = "[REDACTED]"
+
+// DefaultSecretPatterns are the regular expressions a SecretRedactor always
+// matches. When a pattern has capture groups only the groups are redacted, so
+// that surrounding syntax such as a JSON key stays intact.
+var DefaultSecretPatterns = []string{
+	// GitHub personal access, OAuth, user-to-server, server-to-server and refresh tokens
+	`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36,255}\b`,
+	// GitHub fine-grained personal access tokens
+	`\bgithub_pat_[A-Za-z0-9_]{22,255}\b`,
+	// AWS access key IDs
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	// AWS secret access keys, which are only recognizable by the name they are assigned to
+	`(?i)aws_secret_access_key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`,
+}
+
+// secretRedactionTools are the tools whose results may contain secrets copied from
+// logs, workflow files or repository files, and that a SecretRedactor scrubs.
+// get_result_chunk returns the later chunks of get_job_logs and get_file_contents.
+var secretRedactionTools = []string{
+	"get_job_logs",
+	"get_file_contents",
+	"get_result_chunk",
+	"get_repository_readme",
+	"get_git_blob",
+	"get_gist",
+	"actions_get",
+	"github_api_get",
+}
+
+const (
+	// minHighEntropyLength is the shortest token considered as a generic secret.
+	minHighEntropyLength = 32
+	// minHighEntropyBits is the Shannon entropy per character above which a token
+	// is considered random. Hex digests such as commit SHAs stay below it.
+	minHighEntropyBits = 4.5
+)
+
+// highEntropyCandidate matches tokens that may be generic secrets, e.g. API keys.
+var highEntropyCandidate = regexp.MustCompile(`[A-Za-z0-9+/=_\-]{32,}`)
+
+// SecretRedactor scrubs secrets from the text of tool results, replacing them
+// with [REDACTED].
+type SecretRedactor struct {
+	patterns []*regexp.Regexp
+}
+
+// NewSecretRedactor creates a SecretRedactor matching DefaultSecretPatterns plus
+// extraPatterns, and generic high-entropy strings.
+func NewSecretRedactor(extraPatterns []string) (*SecretRedactor, error) {
+	r := &SecretRedactor{}
+	for _, pattern := range slices.Concat(DefaultSecretPatterns, extraPatterns) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret pattern %q: %w", pattern, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// Redact returns text with every secret replaced by [REDACTED].
+func (r *SecretRedactor) Redact(text string) string {
+	text = r.redactPatterns(text)
+	return highEntropyCandidate.ReplaceAllStringFunc(text, func(token string) string {
+		if isHighEntropy(token) {
+			return redactedSecret
+		}
+		return token
+	})
+}
+
+// redactPatterns returns text with the matches of the configured patterns replaced,
+// leaving generic high-entropy strings alone.
+func (r *SecretRedactor) redactPatterns(text string) string {
+	for _, re := range r.patterns {
+		text = redactMatches(re, text)
+	}
+	return text
+}
+
+// redactMatches replaces the matches of re in text, or only their capture groups
+// if re has any.
+func redactMatches(re *regexp.Regexp, text string) string {
+	if re.NumSubexp() == 0 {
+		return re.ReplaceAllString(text, redactedSecret)
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, match := range re.FindAllStringSubmatchIndex(text, -1) {
+		for g := 1; g*2 < len(match); g++ {
+			start, end := match[g*2], match[g*2+1]
+			if start < last {
+				// Unmatched (-1) or nested inside an already redacted group
+				continue
+			}
+			b.WriteString(text[last:start])
+			b.WriteString(redactedSecret)
+			last = end
+		}
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// isHighEntropy reports whether token looks random: it mixes upper case letters,
+// lower case letters and digits, and its Shannon entropy is high.
+func isHighEntropy(token string) bool {
+	if len(token) < minHighEntropyLength {
+		return false
+	}
+	var upper, lower, digit bool
+	counts := make(map[rune]int)
+	for _, c := range token {
+		upper = upper || unicode.IsUpper(c)
+		lower = lower || unicode.IsLower(c)
+		digit = digit || unicode.IsDigit(c)
+		counts[c]++
+	}
+	if !upper || !lower || !digit {
+		return false
+	}
+	entropy := 0.0
+	for _, n := range counts {
+		p := float64(n) / float64(len(token))
+		entropy -= p * math.Log2(p)
+	}
+	return entropy >= minHighEntropyBits
+}
+
+// PostProcessor returns an inventory.ResultPostProcessor that redacts secrets from
+// the text, embedded text resources and structured content of log and file content
+// tool results.
+func (r *SecretRedactor) PostProcessor() inventory.ResultPostProcessor {
+	return func(toolName string, res *mcp.CallToolResult) *mcp.CallToolResult {
+		if !isSecretRedactionTool(toolName) {
+			return res
+		}
+		redactText := r.Redact
+		if isToolName(toolName, "github_api_get") {
+			redactText = r.redactAPIResponse
+		}
+		for _, content := range res.Content {
+			switch c := content.(type) {
+			case *mcp.TextContent:
+				c.Text = redactText(c.Text)
+			case *mcp.EmbeddedResource:
+				if c.Resource != nil && c.Resource.Text != "" {
+					c.Resource.Text = r.Redact(c.Resource.Text)
+				}
+			}
+		}
+		if res.StructuredContent != nil {
+			res.StructuredContent = r.redactStructured(res.StructuredContent)
+		}
+		return res
+	}
+}
+
+// redactAPIResponse redacts a raw REST API response as returned by github_api_get.
+// File and blob contents are base64 encoded there, so they are decoded to be
+// scrubbed. Responses that are not valid JSON, e.g. because they were truncated,
+// only have the configured patterns redacted, as their base64 content would
+// otherwise be mistaken for generic secrets.
+func (r *SecretRedactor) redactAPIResponse(text string) string {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return r.redactPatterns(text)
+	}
+	redacted, changed := r.redactValue(value)
+	if !changed {
+		return text
+	}
+	encoded, err := marshalUnescaped(redacted)
+	if err != nil {
+		return r.redactPatterns(text)
+	}
+	return encoded
+}
+
+// redactStructured redacts the strings in the structured content of a tool result.
+func (r *SecretRedactor) redactStructured(structured any) any {
+	encoded, err := json.Marshal(structured)
+	if err != nil {
+		return structured
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return structured
+	}
+	redacted, changed := r.redactValue(value)
+	if !changed {
+		return structured
+	}
+	return redacted
+}
+
+// redactValue redacts the strings in a decoded JSON value, decoding base64 file
+// contents first, and reports whether anything was redacted.
+func (r *SecretRedactor) redactValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		redacted := r.Redact(v)
+		return redacted, redacted != v
+	case []any:
+		changed := false
+		for i, item := range v {
+			var itemChanged bool
+			v[i], itemChanged = r.redactValue(item)
+			changed = changed || itemChanged
+		}
+		return v, changed
+	case map[string]any:
+		changed := false
+		for key, item := range v {
+			if content, ok := item.(string); ok && key == "content" && v["encoding"] == "base64" {
+				if redacted, ok := r.redactBase64(content); ok {
+					v[key] = redacted
+					changed = true
+				}
+				continue
+			}
+			var itemChanged bool
+			v[key], itemChanged = r.redactValue(item)
+			changed = changed || itemChanged
+		}
+		return v, changed
+	default:
+		return value, false
+	}
+}
+
+// redactBase64 redacts base64 encoded content, returning the re-encoded content
+// and true if it contained secrets.
+func (r *SecretRedactor) redactBase64(content string) (string, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(content, "\n", ""))
+	if err != nil {
+		return "", false
+	}
+	redacted := r.Redact(string(decoded))
+	if redacted == string(decoded) {
+		return "", false
+	}
+	return base64.StdEncoding.EncodeToString([]byte(redacted)), true
+}
+
+// marshalUnescaped encodes value as JSON without escaping HTML characters, so that
+// unchanged text reads the same as in the original response.
+func marshalUnescaped(value any) (string, error) {
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// isSecretRedactionTool reports whether toolName, possibly with a tool name
+// prefix, is one of secretRedactionTools.
+func isSecretRedactionTool(toolName string) bool {
+	return slices.ContainsFunc(secretRedactionTools, func(name string) bool {
+		return isToolName(toolName, name)
+	})
+}
+
+// isToolName reports whether toolName is name, possibly with a tool name prefix.
+func isToolName(toolName, name string) bool {
+	return toolName == name || strings.HasSuffix(toolName, "_"+name)
+}
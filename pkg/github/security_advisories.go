@@ -3,9 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
@@ -312,6 +315,213 @@ func ListRepositorySecurityAdvisories(t translations.TranslationHelperFunc) inve
 	)
 }
 
+// repositoryAdvisoryVulnerability is a vulnerable package entry in a repository security advisory request.
+type repositoryAdvisoryVulnerability struct {
+	Package                *github.VulnerabilityPackage `json:"package"`
+	VulnerableVersionRange *string                      `json:"vulnerable_version_range,omitempty"`
+	PatchedVersions        *string                      `json:"patched_versions,omitempty"`
+}
+
+// createRepositoryAdvisoryRequest is the request body for creating a repository security advisory.
+type createRepositoryAdvisoryRequest struct {
+	Summary         string                            `json:"summary"`
+	Description     string                            `json:"description"`
+	CVEID           *string                           `json:"cve_id,omitempty"`
+	Severity        *string                           `json:"severity,omitempty"`
+	CWEIDs          []string                          `json:"cwe_ids,omitempty"`
+	Vulnerabilities []repositoryAdvisoryVulnerability `json:"vulnerabilities"`
+}
+
+// advisoryVulnerabilitiesFromArgs converts the vulnerabilities tool argument into request entries.
+func advisoryVulnerabilitiesFromArgs(vulnerabilities []any) ([]repositoryAdvisoryVulnerability, error) {
+	result := make([]repositoryAdvisoryVulnerability, 0, len(vulnerabilities))
+	for _, v := range vulnerabilities {
+		vulnMap, ok := v.(map[string]any)
+		if !ok {
+			return nil, errors.New("each vulnerability must be an object with ecosystem and package")
+		}
+
+		ecosystem, ok := vulnMap["ecosystem"].(string)
+		if !ok || ecosystem == "" {
+			return nil, errors.New("each vulnerability must have an ecosystem")
+		}
+		pkg, ok := vulnMap["package"].(string)
+		if !ok || pkg == "" {
+			return nil, errors.New("each vulnerability must have a package")
+		}
+
+		vuln := repositoryAdvisoryVulnerability{
+			Package: &github.VulnerabilityPackage{
+				Ecosystem: github.Ptr(ecosystem),
+				Name:      github.Ptr(pkg),
+			},
+		}
+		if r, ok := vulnMap["vulnerable_version_range"].(string); ok && r != "" {
+			vuln.VulnerableVersionRange = github.Ptr(r)
+		}
+		if p, ok := vulnMap["patched_versions"].(string); ok && p != "" {
+			vuln.PatchedVersions = github.Ptr(p)
+		}
+		result = append(result, vuln)
+	}
+	return result, nil
+}
+
+// CreateRepositorySecurityAdvisory creates a tool to draft a new repository security advisory.
+func CreateRepositorySecurityAdvisory(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecurityAdvisories,
+		mcp.Tool{
+			Name:        "create_repository_security_advisory",
+			Description: t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_DESCRIPTION", "Create a draft repository security advisory for a GitHub repository. The advisory is created in the draft state and is not published."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_CREATE_REPOSITORY_SECURITY_ADVISORY_USER_TITLE", "Create repository security advisory"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "The owner of the repository.",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "The name of the repository.",
+					},
+					"summary": {
+						Type:        "string",
+						Description: "A short summary of the advisory.",
+					},
+					"description": {
+						Type:        "string",
+						Description: "A detailed description of what the advisory impacts.",
+					},
+					"severity": {
+						Type:        "string",
+						Description: "The severity of the advisory.",
+						Enum:        []any{"critical", "high", "medium", "low"},
+					},
+					"cveId": {
+						Type:        "string",
+						Description: "The CVE ID for the advisory, if one has already been assigned.",
+					},
+					"cweIds": {
+						Type:        "array",
+						Description: "A list of CWE IDs (e.g. CWE-79).",
+						Items:       &jsonschema.Schema{Type: "string"},
+					},
+					"vulnerabilities": {
+						Type:        "array",
+						Description: "The products and version ranges affected by the advisory.",
+						Items: &jsonschema.Schema{
+							Type: "object",
+							Properties: map[string]*jsonschema.Schema{
+								"ecosystem": {
+									Type:        "string",
+									Description: "The package ecosystem.",
+									Enum:        []any{"actions", "composer", "erlang", "go", "maven", "npm", "nuget", "other", "pip", "pub", "rubygems", "rust", "swift"},
+								},
+								"package": {
+									Type:        "string",
+									Description: "The package name.",
+								},
+								"vulnerable_version_range": {
+									Type:        "string",
+									Description: "The range of affected versions (e.g. '< 1.2.3').",
+								},
+								"patched_versions": {
+									Type:        "string",
+									Description: "The versions that patch the vulnerability (e.g. '1.2.3').",
+								},
+							},
+							Required: []string{"ecosystem", "package"},
+						},
+					},
+				},
+				Required: []string{"owner", "repo", "summary", "description", "vulnerabilities"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			summary, err := RequiredParam[string](args, "summary")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			description, err := RequiredParam[string](args, "description")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			severity, err := OptionalParam[string](args, "severity")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			cveID, err := OptionalParam[string](args, "cveId")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			cweIDs, err := OptionalStringArrayParam(args, "cweIds")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			vulnerabilitiesArg, ok := args["vulnerabilities"].([]any)
+			if !ok || len(vulnerabilitiesArg) == 0 {
+				return utils.NewToolResultError("vulnerabilities must be a non-empty array"), nil, nil
+			}
+			vulnerabilities, err := advisoryVulnerabilitiesFromArgs(vulnerabilitiesArg)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			body := &createRepositoryAdvisoryRequest{
+				Summary:         summary,
+				Description:     description,
+				CVEID:           ToStringPtr(cveID),
+				Severity:        ToStringPtr(severity),
+				CWEIDs:          cweIDs,
+				Vulnerabilities: vulnerabilities,
+			}
+
+			// go-github does not wrap the create endpoint, so build the request directly
+			req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/security-advisories", owner, repo), body)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			var advisory github.SecurityAdvisory
+			resp, err := client.Do(ctx, req, &advisory)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create security advisory for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(advisory)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal advisory: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
 func GetGlobalSecurityAdvisory(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataSecurityAdvisories,
@@ -369,6 +579,73 @@ func GetGlobalSecurityAdvisory(t translations.TranslationHelperFunc) inventory.S
 	)
 }
 
+// cveIDPattern matches CVE identifiers such as CVE-2021-44228.
+var cveIDPattern = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+// GetSecurityAdvisoryByCVE creates a tool to look up global security advisories by CVE identifier.
+func GetSecurityAdvisoryByCVE(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataSecurityAdvisories,
+		mcp.Tool{
+			Name:        "get_security_advisory_by_cve",
+			Description: t("TOOL_GET_SECURITY_ADVISORY_BY_CVE_DESCRIPTION", "Get the global security advisories for a CVE identifier. A CVE can map to more than one advisory, so all matches are returned."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_SECURITY_ADVISORY_BY_CVE_USER_TITLE", "Get security advisory by CVE"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"cveId": {
+						Type:        "string",
+						Description: "CVE identifier (format: CVE-YYYY-NNNN).",
+					},
+				},
+				Required: []string{"cveId"},
+			},
+		},
+		[]scopes.Scope{scopes.SecurityEvents},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			cveID, err := RequiredParam[string](args, "cveId")
+			if err != nil {
+				return utils.NewToolResultError(fmt.Sprintf("invalid cveId: %v", err)), nil, nil
+			}
+			cveID = strings.ToUpper(strings.TrimSpace(cveID))
+			if !cveIDPattern.MatchString(cveID) {
+				return utils.NewToolResultError(fmt.Sprintf("invalid cveId %q: expected format CVE-YYYY-NNNN", cveID)), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			advisories, resp, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(ctx, &github.ListGlobalSecurityAdvisoriesOptions{
+				CVEID: &cveID,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get advisories for %s", cveID),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if len(advisories) == 0 {
+				return utils.NewToolResultError(fmt.Sprintf("no global security advisory found for %s", cveID)), nil, nil
+			}
+
+			r, err := json.Marshal(advisories)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal advisories: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
 func ListOrgRepositorySecurityAdvisories(t translations.TranslationHelperFunc) inventory.ServerTool {
 	return NewTool(
 		ToolsetMetadataSecurityAdvisories,
@@ -235,6 +235,123 @@ func Test_GetGlobalSecurityAdvisory(t *testing.T) {
 	}
 }
 
+func Test_GetSecurityAdvisoryByCVE(t *testing.T) {
+	toolDef := GetSecurityAdvisoryByCVE(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_security_advisory_by_cve", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be of type *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "cveId")
+	assert.ElementsMatch(t, schema.Required, []string{"cveId"})
+
+	log4jAdvisory := &github.GlobalSecurityAdvisory{
+		SecurityAdvisory: github.SecurityAdvisory{
+			GHSAID:   github.Ptr("GHSA-jfh8-c2jp-5v3q"),
+			CVEID:    github.Ptr("CVE-2021-44228"),
+			Summary:  github.Ptr("Remote code injection in Log4j"),
+			Severity: github.Ptr("critical"),
+		},
+	}
+	relatedAdvisory := &github.GlobalSecurityAdvisory{
+		SecurityAdvisory: github.SecurityAdvisory{
+			GHSAID:   github.Ptr("GHSA-7rjr-3q55-vv33"),
+			CVEID:    github.Ptr("CVE-2021-44228"),
+			Summary:  github.Ptr("Incomplete fix for Apache Log4j vulnerability"),
+			Severity: github.Ptr("critical"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedGHSAs  []string
+		expectedErrMsg string
+	}{
+		{
+			name: "CVE resolves to an advisory",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetAdvisories: expectQueryParams(t, map[string]string{
+					"cve_id": "CVE-2021-44228",
+				}).andThen(
+					mockResponse(t, http.StatusOK, []*github.GlobalSecurityAdvisory{log4jAdvisory}),
+				),
+			}),
+			requestArgs: map[string]any{
+				"cveId": "cve-2021-44228",
+			},
+			expectedGHSAs: []string{"GHSA-jfh8-c2jp-5v3q"},
+		},
+		{
+			name: "CVE maps to multiple advisories",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetAdvisories: mockResponse(t, http.StatusOK, []*github.GlobalSecurityAdvisory{log4jAdvisory, relatedAdvisory}),
+			}),
+			requestArgs: map[string]any{
+				"cveId": "CVE-2021-44228",
+			},
+			expectedGHSAs: []string{"GHSA-jfh8-c2jp-5v3q", "GHSA-7rjr-3q55-vv33"},
+		},
+		{
+			name: "no advisory for CVE",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetAdvisories: mockResponse(t, http.StatusOK, []*github.GlobalSecurityAdvisory{}),
+			}),
+			requestArgs: map[string]any{
+				"cveId": "CVE-2099-0001",
+			},
+			expectError:    true,
+			expectedErrMsg: "no global security advisory found for CVE-2099-0001",
+		},
+		{
+			name:         "invalid CVE format",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"cveId": "GHSA-jfh8-c2jp-5v3q",
+			},
+			expectError:    true,
+			expectedErrMsg: "expected format CVE-YYYY-NNNN",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned []*github.GlobalSecurityAdvisory
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			ghsas := make([]string, 0, len(returned))
+			for _, advisory := range returned {
+				ghsas = append(ghsas, advisory.GetGHSAID())
+			}
+			assert.Equal(t, tc.expectedGHSAs, ghsas)
+		})
+	}
+}
+
 func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 	// Verify tool definition once
 	toolDef := ListRepositorySecurityAdvisories(translations.NullTranslationHelper)
@@ -370,6 +487,160 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 	}
 }
 
+func Test_CreateRepositorySecurityAdvisory(t *testing.T) {
+	toolDef := CreateRepositorySecurityAdvisory(translations.NullTranslationHelper)
+	tool := toolDef.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_repository_security_advisory", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be of type *jsonschema.Schema")
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "summary")
+	assert.Contains(t, schema.Properties, "description")
+	assert.Contains(t, schema.Properties, "severity")
+	assert.Contains(t, schema.Properties, "cveId")
+	assert.Contains(t, schema.Properties, "cweIds")
+	assert.Contains(t, schema.Properties, "vulnerabilities")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo", "summary", "description", "vulnerabilities"})
+
+	draftAdvisory := &github.SecurityAdvisory{
+		GHSAID:      github.Ptr("GHSA-abcd-efgh-ijkl"),
+		Summary:     github.Ptr("Prototype pollution in parser"),
+		Description: github.Ptr("Crafted input can pollute Object.prototype."),
+		Severity:    github.Ptr("high"),
+		State:       github.Ptr("draft"),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]any
+		expectError      bool
+		expectedAdvisory *github.SecurityAdvisory
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful draft advisory creation",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesByOwnerByRepo: expectRequestBody(t, map[string]any{
+					"summary":     "Prototype pollution in parser",
+					"description": "Crafted input can pollute Object.prototype.",
+					"severity":    "high",
+					"cwe_ids":     []any{"CWE-1321"},
+					"vulnerabilities": []any{
+						map[string]any{
+							"package": map[string]any{
+								"ecosystem": "npm",
+								"name":      "parser",
+							},
+							"vulnerable_version_range": "< 2.0.1",
+							"patched_versions":         "2.0.1",
+						},
+					},
+				}).andThen(
+					mockResponse(t, http.StatusCreated, draftAdvisory),
+				),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Prototype pollution in parser",
+				"description": "Crafted input can pollute Object.prototype.",
+				"severity":    "high",
+				"cweIds":      []any{"CWE-1321"},
+				"vulnerabilities": []any{
+					map[string]any{
+						"ecosystem":                "npm",
+						"package":                  "parser",
+						"vulnerable_version_range": "< 2.0.1",
+						"patched_versions":         "2.0.1",
+					},
+				},
+			},
+			expectedAdvisory: draftAdvisory,
+		},
+		{
+			name:         "vulnerability missing package",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Prototype pollution in parser",
+				"description": "Crafted input can pollute Object.prototype.",
+				"vulnerabilities": []any{
+					map[string]any{"ecosystem": "npm"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "each vulnerability must have a package",
+		},
+		{
+			name:         "empty vulnerabilities",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"owner":           "owner",
+				"repo":            "repo",
+				"summary":         "Prototype pollution in parser",
+				"description":     "Crafted input can pollute Object.prototype.",
+				"vulnerabilities": []any{},
+			},
+			expectError:    true,
+			expectedErrMsg: "vulnerabilities must be a non-empty array",
+		},
+		{
+			name: "advisory creation fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PostReposSecurityAdvisoriesByOwnerByRepo: mockResponse(t, http.StatusForbidden, `{"message": "Resource not accessible by integration"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner":       "owner",
+				"repo":        "repo",
+				"summary":     "Prototype pollution in parser",
+				"description": "Crafted input can pollute Object.prototype.",
+				"vulnerabilities": []any{
+					map[string]any{"ecosystem": "npm", "package": "parser"},
+				},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create security advisory for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{Client: client}
+			handler := toolDef.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned github.SecurityAdvisory
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedAdvisory.GetGHSAID(), returned.GetGHSAID())
+			assert.Equal(t, tc.expectedAdvisory.GetSummary(), returned.GetSummary())
+			assert.Equal(t, "draft", returned.GetState())
+		})
+	}
+}
+
 func Test_ListOrgRepositorySecurityAdvisories(t *testing.T) {
 	// Verify tool definition once
 	toolDef := ListOrgRepositorySecurityAdvisories(translations.NullTranslationHelper)
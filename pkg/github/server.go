@@ -8,11 +8,13 @@ import (
 	"strings"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	gherrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/inventory"
 	"github.com/github/github-mcp-server/pkg/octicons"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -105,12 +107,23 @@ func NewMCPServer(ctx context.Context, cfg *MCPServerConfig, deps ToolDependenci
 
 	// Add middlewares. Order matters - for example, the error context middleware should be applied last so that it runs FIRST (closest to the handler) to ensure all errors are captured,
 	// and any middleware that needs to read or modify the context should be before it.
+	// Tool call middleware is also applied by batch_call to its sub-calls.
+	toolCallMiddleware := []mcp.Middleware{
+		applyDefaultPageSize(inv),
+		scopePreflight(inv, cfg.TokenScopes),
+	}
 	ghServer.AddReceivingMiddleware(middleware...)
 	ghServer.AddReceivingMiddleware(InjectDepsMiddleware(deps))
+	ghServer.AddReceivingMiddleware(InjectInventoryMiddleware(inv))
+	ghServer.AddReceivingMiddleware(injectToolCallMiddleware(toolCallMiddleware...))
+	ghServer.AddReceivingMiddleware(toolCallMiddleware...)
 	ghServer.AddReceivingMiddleware(addGitHubAPIErrorToContext)
 
 	if unrecognized := inv.UnrecognizedToolsets(); len(unrecognized) > 0 {
-		cfg.Logger.Warn("Warning: unrecognized toolsets ignored", "toolsets", strings.Join(unrecognized, ", "))
+		cfg.Logger.Warn("Warning: unrecognized toolsets ignored", "toolsets", strings.Join(unrecognized, ", "), "error", inv.UnrecognizedToolsetsError())
+	}
+	if dependencies := inv.DependencyEnabledToolsets(); len(dependencies) > 0 {
+		cfg.Logger.Info("enabled toolsets required by selected toolsets", "toolsets", dependencies)
 	}
 
 	// Register GitHub tools/resources/prompts from the inventory.
@@ -176,6 +189,78 @@ func addGitHubAPIErrorToContext(next mcp.MethodHandler) mcp.MethodHandler {
 	}
 }
 
+// applyDefaultPageSize fills in the configured default perPage for tool calls that
+// omit it, so list tools page by the server's default instead of GitHub's.
+func applyDefaultPageSize(inv *inventory.Inventory) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+			callReq, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || !ok || callReq.Params == nil {
+				return next(ctx, method, req)
+			}
+			pageSize := inv.PageSize(callReq.Params.Name)
+			if pageSize == 0 {
+				return next(ctx, method, req)
+			}
+			tool, _, err := inv.FindToolByName(callReq.Params.Name)
+			if err != nil {
+				return next(ctx, method, req)
+			}
+			if schema, ok := tool.Tool.InputSchema.(*jsonschema.Schema); !ok || schema.Properties["perPage"] == nil {
+				return next(ctx, method, req)
+			}
+
+			args := map[string]any{}
+			if len(callReq.Params.Arguments) > 0 {
+				if err := json.Unmarshal(callReq.Params.Arguments, &args); err != nil || args == nil {
+					// Leave malformed arguments for the tool handler to report
+					return next(ctx, method, req)
+				}
+			}
+			if _, ok := args["perPage"]; ok {
+				return next(ctx, method, req)
+			}
+			args["perPage"] = pageSize
+			arguments, err := json.Marshal(args)
+			if err != nil {
+				return nil, fmt.Errorf("failed to apply default page size: %w", err)
+			}
+			callReq.Params.Arguments = arguments
+			return next(ctx, method, req)
+		}
+	}
+}
+
+// scopePreflight rejects tool calls the token lacks the OAuth scopes for with a clear
+// error, rather than letting them fail with a 403 part-way through. Scopes found in the
+// request context take precedence over those captured when the server was created; if
+// neither is known (e.g. fine-grained PATs), calls are passed through unchecked.
+func scopePreflight(inv *inventory.Inventory, tokenScopes []string) mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+			callReq, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || !ok || callReq.Params == nil {
+				return next(ctx, method, req)
+			}
+			activeScopes, ok := ghcontext.GetTokenScopes(ctx)
+			if !ok {
+				if tokenScopes == nil {
+					return next(ctx, method, req)
+				}
+				activeScopes = tokenScopes
+			}
+			tool, _, err := inv.ToolByCanonicalOrAlias(callReq.Params.Name)
+			if err != nil {
+				return next(ctx, method, req)
+			}
+			if err := CheckToolScopes(tool, activeScopes); err != nil {
+				return utils.NewToolResultError(err.Error()), nil
+			}
+			return next(ctx, method, req)
+		}
+	}
+}
+
 // NewServer creates a new GitHub MCP server with the specified GH client and logger.
 func NewServer(version string, opts *mcp.ServerOptions) *mcp.Server {
 	if opts == nil {
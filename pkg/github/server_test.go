@@ -9,10 +9,12 @@ import (
 	"testing"
 	"time"
 
+	ghcontext "github.com/github/github-mcp-server/pkg/context"
 	"github.com/github/github-mcp-server/pkg/lockdown"
 	"github.com/github/github-mcp-server/pkg/raw"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gogithub "github.com/google/go-github/v82/github"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,10 +57,11 @@ func (s stubDeps) GetRawClient(ctx context.Context) (*raw.Client, error) {
 func (s stubDeps) GetRepoAccessCache(_ context.Context) (*lockdown.RepoAccessCache, error) {
 	return s.repoAccessCache, nil
 }
-func (s stubDeps) GetT() translations.TranslationHelperFunc          { return s.t }
-func (s stubDeps) GetFlags(_ context.Context) FeatureFlags           { return s.flags }
-func (s stubDeps) GetContentWindowSize() int                         { return s.contentWindowSize }
-func (s stubDeps) IsFeatureEnabled(_ context.Context, _ string) bool { return false }
+func (s stubDeps) GetRepoMetadataCache(_ context.Context) *RepoMetadataCache { return nil }
+func (s stubDeps) GetT() translations.TranslationHelperFunc                  { return s.t }
+func (s stubDeps) GetFlags(_ context.Context) FeatureFlags                   { return s.flags }
+func (s stubDeps) GetContentWindowSize() int                                 { return s.contentWindowSize }
+func (s stubDeps) IsFeatureEnabled(_ context.Context, _ string) bool         { return false }
 
 // Helper functions to create stub client functions for error testing
 func stubClientFnFromHTTP(httpClient *http.Client) func(context.Context) (*gogithub.Client, error) {
@@ -150,6 +153,136 @@ func TestNewMCPServer_CreatesSuccessfully(t *testing.T) {
 	// is already tested in pkg/github/*_test.go.
 }
 
+func TestScopePreflight(t *testing.T) {
+	t.Parallel()
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"gists"}).
+		Build()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		configured    []string
+		contextScopes []string
+		expectBlocked bool
+	}{
+		{
+			name:          "missing scope is rejected up front",
+			configured:    []string{"repo"},
+			expectBlocked: true,
+		},
+		{
+			name:       "present scope is allowed",
+			configured: []string{"repo", "gist"},
+		},
+		{
+			name:          "request scopes take precedence",
+			configured:    []string{"repo"},
+			contextScopes: []string{"gist"},
+		},
+		{
+			name: "unknown scopes are not checked",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			handler := scopePreflight(inv, tc.configured)(func(_ context.Context, _ string, _ mcp.Request) (mcp.Result, error) {
+				called = true
+				return &mcp.CallToolResult{}, nil
+			})
+
+			ctx := context.Background()
+			if tc.contextScopes != nil {
+				ctx = ghcontext.WithTokenScopes(ctx, tc.contextScopes)
+			}
+			request := createMCPRequest(map[string]any{"files": map[string]any{}})
+			request.Params.Name = "create_gist"
+			result, err := handler(ctx, "tools/call", &request)
+			require.NoError(t, err)
+
+			if !tc.expectBlocked {
+				assert.True(t, called)
+				return
+			}
+			assert.False(t, called)
+			callResult, ok := result.(*mcp.CallToolResult)
+			require.True(t, ok)
+			require.True(t, callResult.IsError)
+			assert.Equal(t, "this tool requires 'gist' scope, which the token does not have", getErrorResult(t, callResult).Text)
+		})
+	}
+}
+
+func TestApplyDefaultPageSize(t *testing.T) {
+	t.Parallel()
+
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"repos"}).
+		WithDefaultPageSize(10).
+		WithToolPageSizes(map[string]int{"list_branches": 5}).
+		Build()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		tool            string
+		endpoint        string
+		args            map[string]any
+		expectedPerPage string
+	}{
+		{
+			name:            "omitted perPage uses the configured default",
+			tool:            "list_tags",
+			endpoint:        GetReposTagsByOwnerByRepo,
+			args:            map[string]any{"owner": "owner", "repo": "repo"},
+			expectedPerPage: "10",
+		},
+		{
+			name:            "explicit perPage wins",
+			tool:            "list_tags",
+			endpoint:        GetReposTagsByOwnerByRepo,
+			args:            map[string]any{"owner": "owner", "repo": "repo", "perPage": float64(50)},
+			expectedPerPage: "50",
+		},
+		{
+			name:            "per-tool override",
+			tool:            "list_branches",
+			endpoint:        GetReposBranchesByOwnerByRepo,
+			args:            map[string]any{"owner": "owner", "repo": "repo"},
+			expectedPerPage: "5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := gogithub.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				tc.endpoint: expectQueryParams(t, map[string]string{
+					"page":     "1",
+					"per_page": tc.expectedPerPage,
+				}).andThen(
+					mockResponse(t, http.StatusOK, []any{}),
+				),
+			}))
+			deps := BaseDeps{Client: client}
+
+			tool, _, err := inv.FindToolByName(tc.tool)
+			require.NoError(t, err)
+			handler := applyDefaultPageSize(inv)(func(ctx context.Context, _ string, req mcp.Request) (mcp.Result, error) {
+				return tool.Handler(deps)(ctx, req.(*mcp.CallToolRequest))
+			})
+
+			request := createMCPRequest(tc.args)
+			request.Params.Name = tc.tool
+			result, err := handler(ContextWithDeps(context.Background(), deps), "tools/call", &request)
+			require.NoError(t, err)
+			require.False(t, result.(*mcp.CallToolResult).IsError)
+		})
+	}
+}
+
 // TestResolveEnabledToolsets verifies the toolset resolution logic.
 func TestResolveEnabledToolsets(t *testing.T) {
 	t.Parallel()
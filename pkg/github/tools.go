@@ -123,6 +123,12 @@ var (
 		Description: "GitHub Stargazers related tools",
 		Icon:        "star",
 	}
+	ToolsetMetadataExperiments = inventory.ToolsetMetadata{
+		ID:           "experiments",
+		Description:  "Experimental tools that may change or be removed without notice",
+		Icon:         "beaker",
+		Experimental: true,
+	}
 	ToolsetMetadataDynamic = inventory.ToolsetMetadata{
 		ID:          "dynamic",
 		Description: "Discover GitHub MCP tools that can help achieve tasks by enabling additional sets of tools, you can control the enablement of any toolset to access its tools when this toolset is enabled.",
@@ -163,56 +169,107 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetMe(t),
 		GetTeams(t),
 		GetTeamMembers(t),
+		ListToolsets(t),
+		GetToolSchema(t),
+		GetResultChunk(t),
 
 		// Repository tools
 		SearchRepositories(t),
 		GetFileContents(t),
+		GetReadme(t),
 		ListCommits(t),
 		SearchCode(t),
 		GetCommit(t),
+		ListCommitComments(t),
+		AddCommitComment(t),
 		ListBranches(t),
 		ListTags(t),
+		GetLatestVersionTag(t),
 		GetTag(t),
+		ListRepositoryRulesets(t),
+		GetRepositoryRuleset(t),
 		ListReleases(t),
 		GetLatestRelease(t),
 		GetReleaseByTag(t),
+		ListReleaseAssets(t),
+		DeleteRelease(t),
 		CreateOrUpdateFile(t),
 		CreateRepository(t),
+		UpdateRepositorySettings(t),
+		DeleteRepository(t),
 		ForkRepository(t),
+		ListForks(t),
+		ListRepositoryInvitations(t),
+		ManageRepositoryInvitation(t),
 		CreateBranch(t),
 		PushFiles(t),
 		DeleteFile(t),
 		ListStarredRepositories(t),
+		ListStargazers(t),
 		StarRepository(t),
 		UnstarRepository(t),
 
 		// Git tools
 		GetRepositoryTree(t),
+		GetGitBlob(t),
+		GetGitCommit(t),
+		CreateGitTree(t),
+		CreateGitCommit(t),
+		UpdateGitRef(t),
+		DeleteTag(t),
 
 		// Issue tools
 		IssueRead(t),
 		SearchIssues(t),
+		FindSimilarIssues(t),
 		ListIssues(t),
 		ListIssueTypes(t),
 		IssueWrite(t),
 		AddIssueComment(t),
+		ListIssueComments(t),
+		UpdateIssueComment(t),
+		DeleteIssueComment(t),
+		UpsertIssueComment(t),
 		SubIssueWrite(t),
+		ListSubIssues(t),
+		ManageIssueLock(t),
+		TransferIssue(t),
 
 		// User tools
 		SearchUsers(t),
+		ListFollowers(t),
+		ListFollowing(t),
+		ManageUserFollow(t),
+		GetUser(t),
 
 		// Organization tools
 		SearchOrgs(t),
+		ListOrganizationMembers(t),
+		GetOrganizationMembership(t),
+		ListOrganizationRepositories(t),
+		ListOrganizationTeams(t),
+		GetOrganization(t),
 
 		// Pull request tools
 		PullRequestRead(t),
+		GetPullRequestConversation(t),
 		ListPullRequests(t),
+		ListPullRequestCommits(t),
+		ListPullRequestReviewComments(t),
 		SearchPullRequests(t),
+		GetPullRequestMergeStatus(t),
 		MergePullRequest(t),
+		EnableAutoMerge(t),
+		DisableAutoMerge(t),
 		UpdatePullRequestBranch(t),
 		CreatePullRequest(t),
+		CreatePullRequestWithChanges(t),
 		UpdatePullRequest(t),
+		ManagePullRequestState(t),
+		ManagePullRequestDraft(t),
+		ManagePullRequestReviewers(t),
 		PullRequestReviewWrite(t),
+		SubmitPullRequestReview(t),
 		AddCommentToPendingReview(t),
 		AddReplyToPullRequestComment(t),
 
@@ -231,6 +288,8 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		// Dependabot tools
 		GetDependabotAlert(t),
 		ListDependabotAlerts(t),
+		UpdateDependabotAlert(t),
+		GetRepositorySBOM(t),
 
 		// Notification tools
 		ListNotifications(t),
@@ -255,7 +314,9 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		// Security advisories tools
 		ListGlobalSecurityAdvisories(t),
 		GetGlobalSecurityAdvisory(t),
+		GetSecurityAdvisoryByCVE(t),
 		ListRepositorySecurityAdvisories(t),
+		CreateRepositorySecurityAdvisory(t),
 		ListOrgRepositorySecurityAdvisories(t),
 
 		// Gist tools
@@ -274,6 +335,12 @@ func AllTools(t translations.TranslationHelperFunc) []inventory.ServerTool {
 		GetLabelForLabelsToolset(t),
 		ListLabels(t),
 		LabelWrite(t),
+
+		// Experimental tools
+		GitHubGet(t),
+		RenderMarkdown(t),
+		ValidateSearchQuery(t),
+		BatchCall(t),
 	}
 }
 
@@ -1,8 +1,12 @@
 package github
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/github/github-mcp-server/pkg/translations"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -185,3 +189,80 @@ func TestGenerateToolsetsHelp(t *testing.T) {
 	assert.Contains(t, helpText, "gists")
 	assert.Contains(t, helpText, "notifications")
 }
+
+func TestRepoAllowlistCoversTools(t *testing.T) {
+	// Tools that reach repositories through arguments other than owner and repo
+	// must still be limited to the allowlist
+	inv, err := NewInventory(translations.NullTranslationHelper).
+		WithToolsets([]string{"all"}).
+		WithRepoAllowlist([]string{"octo-org/*"}).
+		Build()
+	require.NoError(t, err)
+
+	tests := []struct {
+		tool    string
+		allowed map[string]any
+		blocked map[string]any
+	}{
+		{"github_api_get", map[string]any{"path": "/repos/octo-org/app/contents/.env"}, map[string]any{"path": "/repos/evil-org/app/contents/.env"}},
+		{"github_api_get", map[string]any{"path": "/orgs/octo-org"}, map[string]any{"path": "/search/code"}},
+		{"get_organization", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"get_organization_membership", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"get_team_members", map[string]any{"org": "octo-org", "team_slug": "t"}, map[string]any{"org": "evil-org", "team_slug": "t"}},
+		{"list_organization_repositories", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"list_organization_members", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"list_organization_teams", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"list_org_repository_security_advisories", map[string]any{"org": "octo-org"}, map[string]any{"org": "evil-org"}},
+		{"create_repository", map[string]any{"name": "app", "organization": "octo-org"}, map[string]any{"name": "app", "organization": "evil-org"}},
+		{"fork_repository", map[string]any{"owner": "octo-org", "repo": "app", "organization": "octo-org"}, map[string]any{"owner": "octo-org", "repo": "app", "organization": "evil-org"}},
+		{"search_code", map[string]any{"query": "org:octo-org token"}, map[string]any{"query": "token"}},
+		{"search_repositories", map[string]any{"query": "org:octo-org"}, map[string]any{"query": "repo:evil-org/app"}},
+		{"search_issues", map[string]any{"query": "is:open", "owner": "octo-org", "repo": "app"}, map[string]any{"query": "org:evil-org is:open"}},
+		{"search_pull_requests", map[string]any{"query": "repo:octo-org/app is:open"}, map[string]any{"query": "is:open"}},
+		{"transfer_issue", map[string]any{"owner": "octo-org", "repo": "app", "issue_number": 1, "target_repo": "other"}, map[string]any{"owner": "octo-org", "repo": "app", "issue_number": 1, "target_repo": "evil-org/app"}},
+		{"projects_write", map[string]any{"method": "add_project_item", "owner": "octo-org", "project_number": 1, "item_owner": "octo-org", "item_repo": "app"}, map[string]any{"method": "add_project_item", "owner": "octo-org", "project_number": 1, "item_owner": "evil-org", "item_repo": "app"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.tool, func(t *testing.T) {
+			tool, _, err := inv.FindToolByName(tc.tool)
+			require.NoError(t, err)
+
+			allowed, err := json.Marshal(tc.allowed)
+			require.NoError(t, err)
+			assert.NoError(t, inv.CheckRepoArguments(*tool, allowed))
+
+			blocked, err := json.Marshal(tc.blocked)
+			require.NoError(t, err)
+			assert.ErrorContains(t, inv.CheckRepoArguments(*tool, blocked), "not permitted by server policy")
+		})
+	}
+}
+
+func TestExperimentsHiddenWithoutInsidersMode(t *testing.T) {
+	experimental := []string{"github_api_get", "batch_call", "render_markdown", "validate_search_query"}
+	availableNames := func(includeExperimental bool) []string {
+		inv, err := NewInventory(translations.NullTranslationHelper).
+			WithToolsets([]string{"all"}).
+			WithIncludeExperimental(includeExperimental).
+			Build()
+		require.NoError(t, err)
+		var names []string
+		for _, tool := range inv.AvailableTools(context.Background()) {
+			names = append(names, tool.Tool.Name)
+		}
+		return names
+	}
+
+	// Servers include experimental tools only in insiders mode
+	names := availableNames(false)
+	for _, name := range experimental {
+		assert.NotContains(t, names, name)
+	}
+	assert.Contains(t, names, "get_file_contents")
+
+	names = availableNames(true)
+	for _, name := range experimental {
+		assert.Contains(t, names, name)
+	}
+}
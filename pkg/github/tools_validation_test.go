@@ -184,3 +184,9 @@ func TestToolsetMetadataConsistency(t *testing.T) {
 		}
 	}
 }
+
+// TestInventoryValidates runs the inventory's own validation over all tools,
+// resources and prompts (toolset metadata, icons and input schemas)
+func TestInventoryValidates(t *testing.T) {
+	require.NoError(t, NewInventory(stubTranslation).Validate())
+}
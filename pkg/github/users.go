@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// UserFollowStatus is the response returned by manage_user_follow.
+type UserFollowStatus struct {
+	Username  string `json:"username"`
+	Following bool   `json:"following"`
+}
+
+// listUsersSchema is the input schema shared by the follower listing tools.
+func listUsersSchema(description string) *jsonschema.Schema {
+	return WithPagination(&jsonschema.Schema{
+		Type: "object",
+		Properties: map[string]*jsonschema.Schema{
+			"username": {
+				Type:        "string",
+				Description: description,
+			},
+		},
+		Required: []string{"username"},
+	})
+}
+
+// listUsersHandler returns a handler that lists user logins using the given Users service method.
+func listUsersHandler(
+	list func(ctx context.Context, client *github.Client, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error),
+	errorPrefix string,
+) func(context.Context, ToolDependencies, *mcp.CallToolRequest, map[string]any) (*mcp.CallToolResult, any, error) {
+	return func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+		username, err := RequiredParam[string](args, "username")
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+		pagination, err := OptionalPaginationParams(args)
+		if err != nil {
+			return utils.NewToolResultError(err.Error()), nil, nil
+		}
+
+		client, err := deps.GetClient(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+
+		users, resp, err := list(ctx, client, username, &github.ListOptions{
+			Page:    pagination.Page,
+			PerPage: pagination.PerPage,
+		})
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx,
+				fmt.Sprintf("%s for user '%s'", errorPrefix, username),
+				resp,
+				err,
+			), nil, nil
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, errorPrefix, resp, body), nil, nil
+		}
+
+		logins := make([]string, 0, len(users))
+		for _, user := range users {
+			logins = append(logins, user.GetLogin())
+		}
+
+		r, err := json.Marshal(logins)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+		}
+
+		return utils.NewToolResultText(string(r)), nil, nil
+	}
+}
+
+// ListFollowers creates a tool to list the followers of a user.
+func ListFollowers(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataUsers,
+		mcp.Tool{
+			Name:        "list_followers",
+			Description: t("TOOL_LIST_FOLLOWERS_DESCRIPTION", "List the logins of users who follow a GitHub user"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_FOLLOWERS_USER_TITLE", "List followers"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: listUsersSchema("Username to list followers of"),
+		},
+		nil,
+		listUsersHandler(func(ctx context.Context, client *github.Client, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Users.ListFollowers(ctx, user, opts)
+		}, "failed to list followers"),
+	)
+}
+
+// ListFollowing creates a tool to list the users a user follows.
+func ListFollowing(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataUsers,
+		mcp.Tool{
+			Name:        "list_following",
+			Description: t("TOOL_LIST_FOLLOWING_DESCRIPTION", "List the logins of users that a GitHub user follows"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_LIST_FOLLOWING_USER_TITLE", "List followed users"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: listUsersSchema("Username to list followed users of"),
+		},
+		nil,
+		listUsersHandler(func(ctx context.Context, client *github.Client, user string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+			return client.Users.ListFollowing(ctx, user, opts)
+		}, "failed to list followed users"),
+	)
+}
+
+// ManageUserFollow creates a tool to follow or unfollow a user as the authenticated user.
+func ManageUserFollow(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataUsers,
+		mcp.Tool{
+			Name:        "manage_user_follow",
+			Description: t("TOOL_MANAGE_USER_FOLLOW_DESCRIPTION", "Follow or unfollow a GitHub user as the authenticated user"),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_MANAGE_USER_FOLLOW_USER_TITLE", "Follow or unfollow user"),
+				ReadOnlyHint: false,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "Username to follow or unfollow",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Whether to follow or unfollow the user",
+						Enum:        []any{"follow", "unfollow"},
+					},
+				},
+				Required: []string{"username", "action"},
+			},
+		},
+		[]scopes.Scope{scopes.UserFollow},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			action, err := RequiredParam[string](args, "action")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var resp *github.Response
+			switch action {
+			case "follow":
+				resp, err = client.Users.Follow(ctx, username)
+			case "unfollow":
+				resp, err = client.Users.Unfollow(ctx, username)
+			default:
+				return utils.NewToolResultError(fmt.Sprintf("invalid action %q: must be follow or unfollow", action)), nil, nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to %s user '%s'", action, username),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return ghErrors.NewGitHubAPIStatusErrorResponse(ctx, fmt.Sprintf("failed to %s user", action), resp, body), nil, nil
+			}
+
+			r, err := json.Marshal(UserFollowStatus{
+				Username:  username,
+				Following: action == "follow",
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return utils.NewToolResultText(string(r)), nil, nil
+		},
+	)
+}
+
+// GetUser creates a tool to get the public profile of a GitHub user.
+func GetUser(t translations.TranslationHelperFunc) inventory.ServerTool {
+	return NewTool(
+		ToolsetMetadataUsers,
+		mcp.Tool{
+			Name:        "get_user",
+			Description: t("TOOL_GET_USER_DESCRIPTION", "Get the public profile of a GitHub user by username, including name, bio, company, location, public repository and gist counts, follower counts and account creation date. Use get_me for the authenticated user."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_USER_USER_TITLE", "Get user profile"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"username": {
+						Type:        "string",
+						Description: "Username to get the profile of",
+					},
+				},
+				Required: []string{"username"},
+			},
+		},
+		nil,
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			username, err := RequiredParam[string](args, "username")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			user, resp, err := client.Users.Get(ctx, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get user '%s'", username),
+					resp,
+					err,
+				), nil, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Only public profile fields are included; private counts are never set for other users
+			minimalUser := MinimalUser{
+				Login:      user.GetLogin(),
+				ID:         user.GetID(),
+				ProfileURL: user.GetHTMLURL(),
+				AvatarURL:  user.GetAvatarURL(),
+				Details: &UserDetails{
+					Name:            user.GetName(),
+					Company:         user.GetCompany(),
+					Blog:            user.GetBlog(),
+					Location:        user.GetLocation(),
+					Email:           user.GetEmail(),
+					Hireable:        user.GetHireable(),
+					Bio:             user.GetBio(),
+					TwitterUsername: user.GetTwitterUsername(),
+					PublicRepos:     user.GetPublicRepos(),
+					PublicGists:     user.GetPublicGists(),
+					Followers:       user.GetFollowers(),
+					Following:       user.GetFollowing(),
+					CreatedAt:       user.GetCreatedAt().Time,
+					UpdatedAt:       user.GetUpdatedAt().Time,
+				},
+			}
+
+			return MarshalledTextResult(minimalUser), nil, nil
+		},
+	)
+}
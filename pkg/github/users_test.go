@@ -0,0 +1,340 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListFollowers(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListFollowers(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_followers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "username")
+	assert.Contains(t, schema.Properties, "page")
+	assert.Contains(t, schema.Properties, "perPage")
+	assert.ElementsMatch(t, schema.Required, []string{"username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedLogins []string
+		expectedErrMsg string
+	}{
+		{
+			name: "lists follower logins",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersFollowersByUsername: expectPath(t, "/users/octocat/followers").andThen(
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.User{
+							{Login: github.Ptr("hubot")},
+							{Login: github.Ptr("monalisa")},
+						}),
+					),
+				),
+			}),
+			requestArgs: map[string]any{
+				"username": "octocat",
+				"page":     float64(2),
+				"perPage":  float64(10),
+			},
+			expectedLogins: []string{"hubot", "monalisa"},
+		},
+		{
+			name: "user not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersFollowersByUsername: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"username": "ghost-user",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list followers for user 'ghost-user'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var logins []string
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &logins))
+			assert.Equal(t, tc.expectedLogins, logins)
+		})
+	}
+}
+
+func Test_ListFollowing(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ListFollowing(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_following", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	client := github.NewClient(MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+		GetUsersFollowingByUsername: expectPath(t, "/users/octocat/following").andThen(
+			mockResponse(t, http.StatusOK, []*github.User{
+				{Login: github.Ptr("torvalds")},
+			}),
+		),
+	}))
+	deps := BaseDeps{
+		Client: client,
+	}
+	handler := serverTool.Handler(deps)
+
+	request := createMCPRequest(map[string]any{
+		"username": "octocat",
+	})
+	result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	textContent := getTextResult(t, result)
+	var logins []string
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &logins))
+	assert.Equal(t, []string{"torvalds"}, logins)
+}
+
+func Test_ManageUserFollow(t *testing.T) {
+	// Verify tool definition once
+	serverTool := ManageUserFollow(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "manage_user_follow", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.False(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "username")
+	assert.Contains(t, schema.Properties, "action")
+	assert.ElementsMatch(t, schema.Required, []string{"username", "action"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedStatus UserFollowStatus
+		expectedErrMsg string
+	}{
+		{
+			name: "follow user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutUserFollowingByUsername: expectPath(t, "/user/following/octocat").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs: map[string]any{
+				"username": "octocat",
+				"action":   "follow",
+			},
+			expectedStatus: UserFollowStatus{Username: "octocat", Following: true},
+		},
+		{
+			name: "unfollow user",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				DeleteUserFollowingByUsername: expectPath(t, "/user/following/octocat").andThen(
+					mockResponse(t, http.StatusNoContent, nil),
+				),
+			}),
+			requestArgs: map[string]any{
+				"username": "octocat",
+				"action":   "unfollow",
+			},
+			expectedStatus: UserFollowStatus{Username: "octocat", Following: false},
+		},
+		{
+			name:         "invalid action",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{}),
+			requestArgs: map[string]any{
+				"username": "octocat",
+				"action":   "block",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid action",
+		},
+		{
+			name: "follow fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				PutUserFollowingByUsername: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"username": "ghost-user",
+				"action":   "follow",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to follow user 'ghost-user'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var status UserFollowStatus
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &status))
+			assert.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
+
+func Test_GetUser(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetUser(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	schema := tool.InputSchema.(*jsonschema.Schema)
+	assert.Contains(t, schema.Properties, "username")
+	assert.ElementsMatch(t, schema.Required, []string{"username"})
+
+	createdAt := time.Date(2011, 1, 25, 18, 44, 36, 0, time.UTC)
+	mockUser := &github.User{
+		Login:       github.Ptr("octocat"),
+		ID:          github.Ptr(int64(583231)),
+		HTMLURL:     github.Ptr("https://github.com/octocat"),
+		AvatarURL:   github.Ptr("https://github.com/images/error/octocat_happy.gif"),
+		Name:        github.Ptr("The Octocat"),
+		Bio:         github.Ptr("Mascot"),
+		Company:     github.Ptr("@github"),
+		Location:    github.Ptr("San Francisco"),
+		PublicRepos: github.Ptr(8),
+		PublicGists: github.Ptr(3),
+		Followers:   github.Ptr(9000),
+		Following:   github.Ptr(9),
+		CreatedAt:   &github.Timestamp{Time: createdAt},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "maps public profile fields",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersByUsername: expectPath(t, "/users/octocat").andThen(
+					mockResponse(t, http.StatusOK, mockUser),
+				),
+			}),
+			requestArgs: map[string]any{
+				"username": "octocat",
+			},
+		},
+		{
+			name: "user not found",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetUsersByUsername: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"username": "ghost-user",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get user 'ghost-user'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned MinimalUser
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, "octocat", returned.Login)
+			assert.Equal(t, int64(583231), returned.ID)
+			assert.Equal(t, "https://github.com/octocat", returned.ProfileURL)
+			require.NotNil(t, returned.Details)
+			assert.Equal(t, "The Octocat", returned.Details.Name)
+			assert.Equal(t, "Mascot", returned.Details.Bio)
+			assert.Equal(t, "@github", returned.Details.Company)
+			assert.Equal(t, "San Francisco", returned.Details.Location)
+			assert.Equal(t, 8, returned.Details.PublicRepos)
+			assert.Equal(t, 3, returned.Details.PublicGists)
+			assert.Equal(t, 9000, returned.Details.Followers)
+			assert.Equal(t, 9, returned.Details.Following)
+			assert.True(t, createdAt.Equal(returned.Details.CreatedAt))
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/inventory"
+	"github.com/github/github-mcp-server/pkg/scopes"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/github/github-mcp-server/pkg/utils"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxVersionTagPages bounds how many pages of 100 tags get_latest_version_tag scans.
+const maxVersionTagPages = 10
+
+// semanticVersion is a parsed semantic version (https://semver.org). Build
+// metadata is dropped because it does not affect precedence.
+type semanticVersion struct {
+	major, minor, patch uint64
+	prerelease          []string
+}
+
+// parseSemanticVersion parses a tag name such as "v1.2.3" or "1.2.3-rc.1+build.5".
+// It reports false for anything that is not a full MAJOR.MINOR.PATCH version.
+func parseSemanticVersion(tag string) (semanticVersion, bool) {
+	s := strings.TrimPrefix(strings.TrimPrefix(tag, "v"), "V")
+	s, _, _ = strings.Cut(s, "+")
+	core, pre, hasPre := strings.Cut(s, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return semanticVersion{}, false
+	}
+	var nums [3]uint64
+	for i, p := range parts {
+		n, ok := parseSemverNumber(p)
+		if !ok {
+			return semanticVersion{}, false
+		}
+		nums[i] = n
+	}
+
+	v := semanticVersion{major: nums[0], minor: nums[1], patch: nums[2]}
+	if hasPre {
+		v.prerelease = strings.Split(pre, ".")
+		for _, id := range v.prerelease {
+			if id == "" {
+				return semanticVersion{}, false
+			}
+		}
+	}
+	return v, true
+}
+
+// parseSemverNumber parses a numeric identifier, rejecting leading zeros.
+func parseSemverNumber(s string) (uint64, bool) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	return n, err == nil
+}
+
+// compare returns -1, 0 or 1 depending on whether v has lower, equal or higher
+// precedence than o.
+func (v semanticVersion) compare(o semanticVersion) int {
+	for _, d := range [][2]uint64{{v.major, o.major}, {v.minor, o.minor}, {v.patch, o.patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A pre-release has lower precedence than the associated normal version
+	switch {
+	case len(v.prerelease) == 0 && len(o.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(o.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(o.prerelease); i++ {
+		a, b := v.prerelease[i], o.prerelease[i]
+		an, aNum := parseSemverNumber(a)
+		bn, bNum := parseSemverNumber(b)
+		switch {
+		case aNum && bNum:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aNum:
+			return -1
+		case bNum:
+			return 1
+		default:
+			if c := strings.Compare(a, b); c != 0 {
+				return c
+			}
+		}
+	}
+	switch {
+	case len(v.prerelease) < len(o.prerelease):
+		return -1
+	case len(v.prerelease) > len(o.prerelease):
+		return 1
+	}
+	return 0
+}
+
+// LatestVersionTag is the output type of get_latest_version_tag.
+type LatestVersionTag struct {
+	Tag         string `json:"tag"`
+	SHA         string `json:"sha"`
+	Prerelease  bool   `json:"prerelease"`
+	TagsScanned int    `json:"tags_scanned"`
+}
+
+// GetLatestVersionTag creates a tool to find the highest semantic version tag in a repository.
+func GetLatestVersionTag(t translations.TranslationHelperFunc) inventory.ServerTool {
+	tool := NewTool(
+		ToolsetMetadataRepos,
+		mcp.Tool{
+			Name:        "get_latest_version_tag",
+			Description: t("TOOL_GET_LATEST_VERSION_TAG_DESCRIPTION", "Get the highest semantic version tag (e.g. v1.2.3) in a GitHub repository and the commit it points to. Tags that are not semantic versions are ignored. Unlike get_latest_release, this looks at git tags rather than releases."),
+			Annotations: &mcp.ToolAnnotations{
+				Title:        t("TOOL_GET_LATEST_VERSION_TAG_USER_TITLE", "Get latest version tag"),
+				ReadOnlyHint: true,
+			},
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"owner": {
+						Type:        "string",
+						Description: "Repository owner",
+					},
+					"repo": {
+						Type:        "string",
+						Description: "Repository name",
+					},
+					"prerelease": {
+						Type:        "boolean",
+						Description: "Include pre-release versions such as v2.0.0-rc.1",
+						Default:     json.RawMessage(`false`),
+					},
+				},
+				Required: []string{"owner", "repo"},
+			},
+		},
+		[]scopes.Scope{scopes.Repo},
+		func(ctx context.Context, deps ToolDependencies, _ *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+			owner, err := RequiredParam[string](args, "owner")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			repo, err := RequiredParam[string](args, "repo")
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+			includePrerelease, err := OptionalBoolParamWithDefault(args, "prerelease", false)
+			if err != nil {
+				return utils.NewToolResultError(err.Error()), nil, nil
+			}
+
+			client, err := deps.GetClient(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var best *github.RepositoryTag
+			var bestVersion semanticVersion
+			scanned := 0
+			opts := &github.ListOptions{PerPage: 100}
+			for page := 0; page < maxVersionTagPages; page++ {
+				tags, resp, err := client.Repositories.ListTags(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list tags for %s/%s", owner, repo),
+						resp,
+						err,
+					), nil, nil
+				}
+				_ = resp.Body.Close()
+
+				for _, tag := range tags {
+					scanned++
+					v, ok := parseSemanticVersion(tag.GetName())
+					if !ok || (!includePrerelease && len(v.prerelease) > 0) {
+						continue
+					}
+					if best == nil || v.compare(bestVersion) > 0 {
+						best, bestVersion = tag, v
+					}
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			if best == nil {
+				return utils.NewToolResultError(fmt.Sprintf("no semantic version tags found in %s/%s", owner, repo)), nil, nil
+			}
+
+			return MarshalledTextResult(LatestVersionTag{
+				Tag:         best.GetName(),
+				SHA:         best.GetCommit().GetSHA(),
+				Prerelease:  len(bestVersion.prerelease) > 0,
+				TagsScanned: scanned,
+			}), nil, nil
+		},
+	)
+	// Scans up to maxVersionTagPages pages of tags
+	tool.Cost = inventory.ToolCostLarge
+	return tool
+}
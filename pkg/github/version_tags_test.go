@@ -0,0 +1,188 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v82/github"
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_semanticVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.0.0", "v1.0.0", 0},
+		{"v1.0.0", "1.0.0", 0},
+		{"v1.0.0+build.1", "v1.0.0+build.2", 0},
+		{"v1.0.1", "v1.0.0", 1},
+		{"v1.10.0", "v1.9.0", 1},
+		{"v2.0.0", "v10.0.0", -1},
+		{"v1.0.0-rc.1", "v1.0.0", -1},
+		{"v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{"v1.0.0-alpha.1", "v1.0.0-alpha.beta", -1},
+		{"v1.0.0-beta.2", "v1.0.0-beta.11", -1},
+		{"v1.0.0-rc.1", "v1.0.0-beta.11", 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			a, ok := parseSemanticVersion(tc.a)
+			require.True(t, ok)
+			b, ok := parseSemanticVersion(tc.b)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, a.compare(b))
+			assert.Equal(t, -tc.expected, b.compare(a))
+		})
+	}
+
+	for _, invalid := range []string{"latest", "v1", "v1.2", "v1.2.3.4", "v01.2.3", "v1.2.x", "release-1.2.3", "v1.2.3-", "v1.2.3-rc..1"} {
+		t.Run("invalid "+invalid, func(t *testing.T) {
+			_, ok := parseSemanticVersion(invalid)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func Test_GetLatestVersionTag(t *testing.T) {
+	// Verify tool definition once
+	serverTool := GetLatestVersionTag(translations.NullTranslationHelper)
+	tool := serverTool.Tool
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	schema, ok := tool.InputSchema.(*jsonschema.Schema)
+	require.True(t, ok, "InputSchema should be *jsonschema.Schema")
+
+	assert.Equal(t, "get_latest_version_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+	assert.Contains(t, schema.Properties, "owner")
+	assert.Contains(t, schema.Properties, "repo")
+	assert.Contains(t, schema.Properties, "prerelease")
+	assert.ElementsMatch(t, schema.Required, []string{"owner", "repo"})
+
+	tag := func(name, sha string) *github.RepositoryTag {
+		return &github.RepositoryTag{Name: github.Ptr(name), Commit: &github.Commit{SHA: github.Ptr(sha)}}
+	}
+	firstPage := []*github.RepositoryTag{
+		tag("nightly", "sha-nightly"),
+		tag("v1.9.0", "sha-190"),
+		tag("v1.10.0", "sha-1100"),
+		tag("release-2024", "sha-release"),
+	}
+	secondPage := []*github.RepositoryTag{
+		tag("v2.0.0-rc.1", "sha-200rc1"),
+		tag("1.2.3", "sha-123"),
+		tag("v1.10", "sha-110"),
+	}
+	pagedTags := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			mockResponse(t, http.StatusOK, secondPage)(w, r)
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/tags?page=2&per_page=100>; rel="next"`)
+		mockResponse(t, http.StatusOK, firstPage)(w, r)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]any
+		expectError    bool
+		expectedResult LatestVersionTag
+		expectedErrMsg string
+	}{
+		{
+			name: "highest stable version across pages",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTagsByOwnerByRepo: pagedTags,
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: LatestVersionTag{
+				Tag:         "v1.10.0",
+				SHA:         "sha-1100",
+				TagsScanned: 7,
+			},
+		},
+		{
+			name: "pre-releases included on request",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTagsByOwnerByRepo: pagedTags,
+			}),
+			requestArgs: map[string]any{
+				"owner":      "owner",
+				"repo":       "repo",
+				"prerelease": true,
+			},
+			expectedResult: LatestVersionTag{
+				Tag:         "v2.0.0-rc.1",
+				SHA:         "sha-200rc1",
+				Prerelease:  true,
+				TagsScanned: 7,
+			},
+		},
+		{
+			name: "no semantic version tags",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTagsByOwnerByRepo: mockResponse(t, http.StatusOK, []*github.RepositoryTag{tag("latest", "sha-latest")}),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "no semantic version tags found in owner/repo",
+		},
+		{
+			name: "list tags fails",
+			mockedClient: MockHTTPClientWithHandlers(map[string]http.HandlerFunc{
+				GetReposTagsByOwnerByRepo: mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+			}),
+			requestArgs: map[string]any{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list tags for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			deps := BaseDeps{
+				Client: client,
+			}
+			handler := serverTool.Handler(deps)
+
+			request := createMCPRequest(tc.requestArgs)
+
+			result, err := handler(ContextWithDeps(context.Background(), deps), &request)
+
+			require.NoError(t, err)
+			if tc.expectError {
+				require.True(t, result.IsError)
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var returned LatestVersionTag
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &returned))
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+	"go.yaml.in/yaml/v3"
+)
+
+// workflowDispatchInput is an input declared under on.workflow_dispatch.inputs.
+type workflowDispatchInput struct {
+	Description string    `yaml:"description"`
+	Required    bool      `yaml:"required"`
+	Type        string    `yaml:"type"`
+	Default     yaml.Node `yaml:"default"`
+	Options     []string  `yaml:"options"`
+}
+
+// parseWorkflowDispatchInputs returns the workflow_dispatch inputs declared in a
+// workflow file. A workflow without a workflow_dispatch trigger, or one that
+// lists its triggers as a string or sequence, declares no inputs.
+func parseWorkflowDispatchInputs(content []byte) (map[string]workflowDispatchInput, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal(content, &workflow); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow file: %w", err)
+	}
+	if workflow.On.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	var triggers struct {
+		WorkflowDispatch struct {
+			Inputs map[string]workflowDispatchInput `yaml:"inputs"`
+		} `yaml:"workflow_dispatch"`
+	}
+	if err := workflow.On.Decode(&triggers); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow triggers: %w", err)
+	}
+	return triggers.WorkflowDispatch.Inputs, nil
+}
+
+// missingWorkflowInputs lists the required inputs without a default that are
+// absent from provided, sorted by name, formatted as "name (type)".
+func missingWorkflowInputs(declared map[string]workflowDispatchInput, provided map[string]any) []string {
+	var missing []string
+	for name, input := range declared {
+		if !input.Required || input.Default.Kind != 0 {
+			continue
+		}
+		if _, ok := provided[name]; ok {
+			continue
+		}
+		inputType := input.Type
+		if inputType == "" {
+			inputType = "string"
+		}
+		if inputType == "choice" && len(input.Options) > 0 {
+			inputType = fmt.Sprintf("choice: %s", strings.Join(input.Options, ", "))
+		}
+		missing = append(missing, fmt.Sprintf("%s (%s)", name, inputType))
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// getWorkflowDispatchInputs fetches the workflow file at ref and returns its
+// declared workflow_dispatch inputs. workflowID is either a numeric workflow ID
+// or a file name under .github/workflows.
+func getWorkflowDispatchInputs(ctx context.Context, client *github.Client, owner, repo, workflowID, ref string) (map[string]workflowDispatchInput, error) {
+	workflowPath := path.Join(".github/workflows", workflowID)
+	if workflowIDInt, parseErr := strconv.ParseInt(workflowID, 10, 64); parseErr == nil {
+		workflow, resp, err := client.Actions.GetWorkflowByID(ctx, owner, repo, workflowIDInt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workflow: %w", err)
+		}
+		_ = resp.Body.Close()
+		workflowPath = workflow.GetPath()
+	}
+
+	content, _, err := getWorkflowFileContent(ctx, client, owner, repo, workflowPath, ref)
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowDispatchInputs([]byte(content))
+}
+
+// getWorkflowFileContent returns the decoded contents of the workflow file at
+// workflowPath. An empty ref reads the file from the default branch.
+func getWorkflowFileContent(ctx context.Context, client *github.Client, owner, repo, workflowPath, ref string) (string, *github.Response, error) {
+	var opts *github.RepositoryContentGetOptions
+	if ref != "" {
+		opts = &github.RepositoryContentGetOptions{Ref: ref}
+	}
+	fileContent, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, workflowPath, opts)
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to get workflow file %s: %w", workflowPath, err)
+	}
+	_ = resp.Body.Close()
+	if fileContent == nil {
+		return "", resp, fmt.Errorf("workflow path %s is not a file", workflowPath)
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to decode workflow file %s: %w", workflowPath, err)
+	}
+	return content, resp, nil
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -237,11 +238,29 @@ func DefaultGitHubMCPServerFactory(r *http.Request, deps github.ToolDependencies
 }
 
 // DefaultInventoryFactory creates the default inventory factory for HTTP mode
-func DefaultInventoryFactory(_ *ServerConfig, t translations.TranslationHelperFunc, featureChecker inventory.FeatureFlagChecker, scopeFetcher scopes.FetcherInterface) InventoryFactoryFunc {
+func DefaultInventoryFactory(cfg *ServerConfig, t translations.TranslationHelperFunc, featureChecker inventory.FeatureFlagChecker, scopeFetcher scopes.FetcherInterface) InventoryFactoryFunc {
+	// The redactor is stateless, so one instance serves every request
+	var redactor *github.SecretRedactor
+	var redactorErr error
+	if cfg != nil && cfg.RedactSecrets {
+		redactor, redactorErr = github.NewSecretRedactor(cfg.SecretPatterns)
+	}
+
 	return func(r *http.Request) (*inventory.Inventory, error) {
+		if redactorErr != nil {
+			return nil, fmt.Errorf("failed to create secret redactor: %w", redactorErr)
+		}
+
 		b := github.NewInventory(t).
 			WithDeprecatedAliases(github.DeprecatedToolAliases).
 			WithFeatureChecker(featureChecker)
+		if cfg != nil {
+			b = b.WithDefaultPageSize(cfg.DefaultPageSize).
+				WithRepoAllowlist(cfg.RepoAllowlist)
+		}
+		if redactor != nil {
+			b = b.WithResultPostProcessor(redactor.PostProcessor())
+		}
 
 		b = InventoryFiltersForRequest(r, b)
 		b = PATScopeFilter(b, r, scopeFetcher)
@@ -261,6 +280,11 @@ func InventoryFiltersForRequest(r *http.Request, builder *inventory.Builder) *in
 		builder = builder.WithReadOnly(true)
 	}
 
+	// Experimental tools are only offered to insiders
+	if ghcontext.IsInsidersMode(ctx) {
+		builder = builder.WithIncludeExperimental(true)
+	}
+
 	toolsets := ghcontext.GetToolsets(ctx)
 	tools := ghcontext.GetTools(ctx)
 
@@ -543,6 +543,7 @@ func TestSupportedScopes(t *testing.T) {
 		"read:org",
 		"read:user",
 		"user:email",
+		"user:follow",
 		"read:packages",
 		"write:packages",
 		"read:project",
@@ -25,7 +25,9 @@ import (
 
 // knownFeatureFlags are the feature flags that can be enabled via X-MCP-Features header.
 // Only these flags are accepted from headers.
-var knownFeatureFlags = []string{}
+var knownFeatureFlags = []string{
+	github.FeatureFlagExperiments,
+}
 
 type ServerConfig struct {
 	// Version of the server
@@ -58,9 +60,24 @@ type ServerConfig struct {
 	// Content window size
 	ContentWindowSize int
 
+	// DefaultPageSize is the perPage list tools use when the caller omits it (0 = GitHub's default)
+	DefaultPageSize int
+
 	// LockdownMode indicates if we should enable lockdown mode
 	LockdownMode bool
 
+	// RepoAllowlist restricts tools to repositories matching these "owner/repo"
+	// patterns (e.g. "octo-org/*"). Empty allows all repositories.
+	RepoAllowlist []string
+
+	// RedactSecrets scrubs tokens and other secrets from the output of log and
+	// file content tools, see github.SecretRedactor.
+	RedactSecrets bool
+
+	// SecretPatterns are regular expressions matched by the secret redaction in
+	// addition to github.DefaultSecretPatterns.
+	SecretPatterns []string
+
 	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
 	RepoAccessCacheTTL *time.Duration
 
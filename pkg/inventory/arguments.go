@@ -0,0 +1,133 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// objectSchema returns the tool input schema as a *jsonschema.Schema, or nil if it
+// cannot be interpreted as one.
+func objectSchema(schema any) *jsonschema.Schema {
+	if s, ok := schema.(*jsonschema.Schema); ok {
+		return s
+	}
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+	var s jsonschema.Schema
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil
+	}
+	return &s
+}
+
+// schemaType returns the single non-null type declared by a schema, or "" if there
+// is none or it is ambiguous.
+func schemaType(s *jsonschema.Schema) string {
+	if s.Type != "" {
+		return s.Type
+	}
+	var found string
+	for _, t := range s.Types {
+		if t == "null" {
+			continue
+		}
+		if found != "" {
+			return ""
+		}
+		found = t
+	}
+	return found
+}
+
+// coerceValue converts a value that an LLM commonly mis-encodes as a string into the
+// type declared by s. Values that are already well-typed, or cannot be converted, are
+// returned unchanged so the handler still reports them.
+func coerceValue(s *jsonschema.Schema, value any) any {
+	str, ok := value.(string)
+	if !ok || s == nil {
+		return value
+	}
+	switch schemaType(s) {
+	case "number", "integer":
+		if n, err := strconv.ParseFloat(strings.TrimSpace(str), 64); err == nil {
+			return n
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(strings.TrimSpace(str)); err == nil {
+			return b
+		}
+	case "array":
+		if strings.TrimSpace(str) == "" {
+			return []any{}
+		}
+		parts := strings.Split(str, ",")
+		items := make([]any, 0, len(parts))
+		for _, part := range parts {
+			items = append(items, coerceValue(s.Items, strings.TrimSpace(part)))
+		}
+		return items
+	}
+	return value
+}
+
+// coerceArguments rewrites top-level tool arguments whose JSON type does not match
+// the schema but whose string form can be converted: "42" to 42, "true" to true and
+// "a,b,c" to ["a","b","c"]. Arguments that are not a JSON object are returned unchanged.
+func coerceArguments(s *jsonschema.Schema, arguments json.RawMessage) json.RawMessage {
+	if s == nil || len(s.Properties) == 0 || len(arguments) == 0 {
+		return arguments
+	}
+	var args map[string]any
+	if err := json.Unmarshal(arguments, &args); err != nil || args == nil {
+		return arguments
+	}
+
+	changed := false
+	for name, value := range args {
+		if _, isString := value.(string); !isString {
+			continue
+		}
+		if coerced := coerceValue(s.Properties[name], value); coerced != value {
+			args[name] = coerced
+			changed = true
+		}
+	}
+	if !changed {
+		return arguments
+	}
+
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return arguments
+	}
+	return encoded
+}
+
+// lenientArgumentsHandlerFunc wraps handlerFunc so that arguments are coerced to the
+// types declared by schema before the handler decodes them.
+func lenientArgumentsHandlerFunc(schema any, handlerFunc HandlerFunc) HandlerFunc {
+	s := objectSchema(schema)
+	if s == nil {
+		return handlerFunc
+	}
+	return func(deps any) mcp.ToolHandler {
+		handler := handlerFunc(deps)
+		return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if req.Params != nil {
+				params := *req.Params
+				params.Arguments = coerceArguments(s, params.Arguments)
+				reqCopy := *req
+				reqCopy.Params = &params
+				req = &reqCopy
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+var coercionSchema = &jsonschema.Schema{
+	Type: "object",
+	Properties: map[string]*jsonschema.Schema{
+		"issue_number": {Type: "number"},
+		"per_page":     {Types: []string{"null", "integer"}},
+		"draft":        {Type: "boolean"},
+		"labels":       {Type: "array", Items: &jsonschema.Schema{Type: "string"}},
+		"ids":          {Type: "array", Items: &jsonschema.Schema{Type: "number"}},
+		"title":        {Type: "string"},
+	},
+}
+
+func TestCoerceArguments(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "string to number",
+			input:    `{"issue_number": "42", "per_page": " 10 "}`,
+			expected: `{"issue_number": 42, "per_page": 10}`,
+		},
+		{
+			name:     "string to boolean",
+			input:    `{"draft": "true"}`,
+			expected: `{"draft": true}`,
+		},
+		{
+			name:     "comma list to array",
+			input:    `{"labels": "a, b,c", "ids": "1,2"}`,
+			expected: `{"labels": ["a", "b", "c"], "ids": [1, 2]}`,
+		},
+		{
+			name:     "well-typed and string arguments are untouched",
+			input:    `{"issue_number": 7, "labels": ["x"], "title": "123", "unknown": "true"}`,
+			expected: `{"issue_number": 7, "labels": ["x"], "title": "123", "unknown": "true"}`,
+		},
+		{
+			name:     "unconvertible values are left for the handler to reject",
+			input:    `{"issue_number": "forty-two", "draft": "maybe"}`,
+			expected: `{"issue_number": "forty-two", "draft": "maybe"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := coerceArguments(coercionSchema, json.RawMessage(tc.input))
+			require.JSONEq(t, tc.expected, string(result))
+		})
+	}
+
+	require.Equal(t, `not json`, string(coerceArguments(coercionSchema, json.RawMessage(`not json`))))
+}
+
+func TestWithLenientArguments(t *testing.T) {
+	echo := NewServerToolFromHandler(
+		mcp.Tool{
+			Name:        "echo",
+			Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+			InputSchema: coercionSchema,
+		},
+		testToolsetMetadata("toolset1"),
+		func(_ any) mcp.ToolHandler {
+			return func(_ context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{&mcp.TextContent{Text: string(req.Params.Arguments)}},
+				}, nil
+			}
+		},
+	)
+
+	args := map[string]any{"issue_number": "42", "draft": "false", "labels": "bug,docs"}
+
+	// Strict by default
+	strict := mustBuild(t, NewBuilder().SetTools([]ServerTool{echo}).WithToolsets([]string{"all"}))
+	require.JSONEq(t, `{"issue_number": "42", "draft": "false", "labels": "bug,docs"}`, callRegisteredToolWithArgs(t, strict, "echo", args))
+
+	lenient := mustBuild(t, NewBuilder().SetTools([]ServerTool{echo}).WithToolsets([]string{"all"}).WithLenientArguments(true))
+	require.JSONEq(t, `{"issue_number": 42, "draft": false, "labels": ["bug", "docs"]}`, callRegisteredToolWithArgs(t, lenient, "echo", args))
+}
@@ -7,11 +7,16 @@ import (
 	"maps"
 	"slices"
 	"strings"
+	"sync"
 )
 
 var (
 	// ErrUnknownTools is returned when tools specified via WithTools() are not recognized.
 	ErrUnknownTools = errors.New("unknown tools specified in WithTools")
+
+	// ErrInvalidInventory is returned by Validate (and Build in strict mode) when
+	// tools, resources or prompts are misconfigured.
+	ErrInvalidInventory = errors.New("invalid inventory")
 )
 
 // ToolFilter is a function that determines if a tool should be included.
@@ -30,6 +35,7 @@ type ToolFilter func(ctx context.Context, tool *ServerTool) (bool, error)
 //	    SetPrompts(prompts).
 //	    WithDeprecatedAliases(aliases).
 //	    WithReadOnly(true).
+//	    WithReadOnlyToolsets([]ToolsetID{"actions"}).
 //	    WithToolsets([]string{"repos", "issues"}).
 //	    WithFeatureChecker(checker).
 //	    WithFilter(myFilter).
@@ -42,13 +48,28 @@ type Builder struct {
 
 	// Configuration options (processed at Build time)
 	readOnly             bool
+	readOnlyToolsets     []ToolsetID
 	toolsetIDs           []string // raw input, processed at Build()
 	toolsetIDsIsNil      bool     // tracks if nil was passed (nil = defaults)
 	additionalTools      []string // raw input, processed at Build()
 	featureChecker       FeatureFlagChecker
+	batchFeatureChecker  BatchFeatureFlagChecker
 	filters              []ToolFilter // filters to apply to all tools
 	generateInstructions bool
 	insidersMode         bool
+	toolsetChangeHook    func(enabled []ToolsetID)
+	hideDeprecated       bool
+	includeExperimental  bool
+	strict               bool
+	alwaysOnToolsets     []ToolsetID
+	knownToolsets        []ToolsetID
+	defaultPageSize      int
+	toolPageSizes        map[string]int
+	postProcessors       []ResultPostProcessor
+	postProcessErrors    bool
+	lenientArguments     bool
+	toolNamePrefix       string
+	repoAllowlist        []string
 }
 
 // NewBuilder creates a new Builder.
@@ -91,6 +112,71 @@ func (b *Builder) WithReadOnly(readOnly bool) *Builder {
 	return b
 }
 
+// WithReadOnlyToolsets forces specific toolsets into read-only mode while leaving
+// the others writable. Write tools in these toolsets are filtered out. When
+// WithReadOnly(true) is also set, all toolsets are read-only regardless.
+// Returns self for chaining.
+func (b *Builder) WithReadOnlyToolsets(toolsetIDs []ToolsetID) *Builder {
+	b.readOnlyToolsets = toolsetIDs
+	return b
+}
+
+// WithDefaultPageSize sets the page size list tools use when the caller omits perPage,
+// instead of GitHub's default of 30. Values are capped at MaxPageSize; 0 keeps
+// GitHub's default. Returns self for chaining.
+func (b *Builder) WithDefaultPageSize(n int) *Builder {
+	b.defaultPageSize = n
+	return b
+}
+
+// WithToolPageSizes overrides the default page size for specific tools, keyed by
+// tool name. Values are capped at MaxPageSize. Returns self for chaining.
+func (b *Builder) WithToolPageSizes(sizes map[string]int) *Builder {
+	b.toolPageSizes = maps.Clone(sizes)
+	return b
+}
+
+// WithToolsetChangeNotifier registers a callback invoked whenever the set of
+// enabled toolsets changes at runtime (e.g. via EnableToolset in dynamic mode).
+// The callback receives the new, sorted set of enabled toolset IDs, letting the
+// host push a tools/list_changed notification. Returns self for chaining.
+func (b *Builder) WithToolsetChangeNotifier(fn func(enabled []ToolsetID)) *Builder {
+	b.toolsetChangeHook = fn
+	return b
+}
+
+// WithIncludeExperimental sets whether experimental tools, marked individually or
+// through their toolset's metadata, are available. They are hidden by default so
+// new tools can ship dark. Returns self for chaining.
+func (b *Builder) WithIncludeExperimental(include bool) *Builder {
+	b.includeExperimental = include
+	return b
+}
+
+// WithHideDeprecated sets whether tools marked as deprecated for removal
+// (see ServerTool.DeprecatedMessage) should be omitted entirely.
+// Returns self for chaining.
+func (b *Builder) WithHideDeprecated(hide bool) *Builder {
+	b.hideDeprecated = hide
+	return b
+}
+
+// WithStrictValidation sets whether Build should run Validate and fail on any error.
+// Returns self for chaining.
+func (b *Builder) WithStrictValidation(strict bool) *Builder {
+	b.strict = strict
+	return b
+}
+
+// WithKnownToolsets sets the toolset IDs that Validate accepts. Items referencing any
+// other toolset are reported as errors, catching typos that would otherwise silently
+// create a new toolset. If unset, toolset IDs are not checked against a known set.
+// Returns self for chaining.
+func (b *Builder) WithKnownToolsets(toolsetIDs []ToolsetID) *Builder {
+	b.knownToolsets = toolsetIDs
+	return b
+}
+
 func (b *Builder) WithServerInstructions() *Builder {
 	b.generateInstructions = true
 	return b
@@ -101,7 +187,8 @@ func (b *Builder) WithServerInstructions() *Builder {
 //   - "all": enables all toolsets
 //   - "default": expands to toolsets marked with Default: true in their metadata
 //
-// Input strings are trimmed of whitespace and duplicates are removed.
+// Input strings are trimmed of whitespace and duplicates are removed. Toolset names
+// and keywords are matched case-insensitively.
 // Pass nil to use default toolsets. Pass an empty slice to disable all toolsets
 // (useful for dynamic toolsets mode where tools are enabled on demand).
 // Returns self for chaining.
@@ -111,6 +198,15 @@ func (b *Builder) WithToolsets(toolsetIDs []string) *Builder {
 	return b
 }
 
+// WithAlwaysOnToolsets specifies toolsets that are enabled regardless of the
+// WithToolsets selection, e.g. to guarantee the "context" toolset is always present.
+// Tools in these toolsets are still subject to read-only mode, feature flags and filters.
+// Returns self for chaining.
+func (b *Builder) WithAlwaysOnToolsets(toolsetIDs []ToolsetID) *Builder {
+	b.alwaysOnToolsets = toolsetIDs
+	return b
+}
+
 // WithTools specifies additional tools that bypass toolset filtering.
 // These tools are additive - they will be included even if their toolset is not enabled.
 // Read-only filtering still applies to these tools.
@@ -132,6 +228,16 @@ func (b *Builder) WithFeatureChecker(checker FeatureFlagChecker) *Builder {
 	return b
 }
 
+// WithBatchFeatureChecker sets a feature flag checker that evaluates many flags in
+// one call. When set, it is used instead of the single-flag checker: each
+// AvailableTools (or AvailableResourceTemplates, AvailablePrompts) call evaluates
+// all distinct flags its items reference at once. Errors are logged and all flags
+// treated as disabled. Returns self for chaining.
+func (b *Builder) WithBatchFeatureChecker(checker BatchFeatureFlagChecker) *Builder {
+	b.batchFeatureChecker = checker
+	return b
+}
+
 // WithFilter adds a filter function that will be applied to all tools.
 // Multiple filters can be added and are evaluated in order.
 // If any filter returns false or an error, the tool is excluded.
@@ -141,6 +247,56 @@ func (b *Builder) WithFilter(filter ToolFilter) *Builder {
 	return b
 }
 
+// WithResultPostProcessor adds a function that is applied to every tool result
+// after the handler returns. Multiple post-processors run in the order added.
+// Error results are only passed through them with WithPostProcessErrorResults(true).
+// Returns self for chaining.
+func (b *Builder) WithResultPostProcessor(fn ResultPostProcessor) *Builder {
+	b.postProcessors = append(b.postProcessors, fn)
+	return b
+}
+
+// WithPostProcessErrorResults sets whether result post-processors also run on
+// error results (IsError set). Returns self for chaining.
+func (b *Builder) WithPostProcessErrorResults(include bool) *Builder {
+	b.postProcessErrors = include
+	return b
+}
+
+// WithLenientArguments sets whether tool arguments are coerced to the types declared
+// in the tool's input schema before decoding, to tolerate common LLM mistakes:
+// numbers or booleans sent as strings ("42", "true") and comma-separated strings
+// where an array is expected ("a,b,c"). Arguments are decoded strictly by default.
+// Returns self for chaining.
+func (b *Builder) WithLenientArguments(lenient bool) *Builder {
+	b.lenientArguments = lenient
+	return b
+}
+
+// WithRepoAllowlist restricts tools to the repositories matching patterns of the
+// form "owner/repo", where either part may use * and ? wildcards (e.g.
+// "octo-org/*"). A bare owner is shorthand for "owner/*". Calls to any tool, read or
+// write, whose owner and repo arguments (or other repository arguments, see
+// ServerTool.RepoArguments) fall outside the allowlist fail with a "not permitted
+// by server policy" error, as do reads of resources whose owner and repo template
+// variables fall outside it. An empty allowlist allows all repositories.
+// Patterns are validated at Build time. Returns self for chaining.
+func (b *Builder) WithRepoAllowlist(patterns []string) *Builder {
+	b.repoAllowlist = patterns
+	return b
+}
+
+// WithToolNamePrefix prepends prefix to the name of every tool, resource template and
+// prompt, and to deprecated aliases, so several servers can be combined behind one
+// client without name collisions (e.g. "ghes1_" gives "ghes1_issue_read"). Lookups
+// and ForMCPRequest use the prefixed names. Each tool keeps its unprefixed name as
+// its ID, so WithTools, WithExcludeTools and WithToolPageSizes accept either form.
+// Returns self for chaining.
+func (b *Builder) WithToolNamePrefix(prefix string) *Builder {
+	b.toolNamePrefix = prefix
+	return b
+}
+
 // WithExcludeTools specifies tools that should be disabled regardless of other settings.
 // These tools will be excluded even if their toolset is enabled or they are in the
 // additional tools list. This takes precedence over all other tool enablement settings.
@@ -173,6 +329,9 @@ func CreateExcludeToolsFilter(excluded []string) ToolFilter {
 	}
 	return func(_ context.Context, tool *ServerTool) (bool, error) {
 		_, blocked := set[tool.Tool.Name]
+		if !blocked {
+			_, blocked = set[tool.ToolID()]
+		}
 		return !blocked, nil
 	}
 }
@@ -203,30 +362,107 @@ func cleanTools(tools []string) []string {
 // Build returns an error if any tools specified via WithTools() are not recognized
 // (i.e., they don't exist in the tool set and are not deprecated aliases).
 // This ensures invalid tool configurations fail fast at build time.
+// With WithStrictValidation(true), Build also fails if Validate returns an error.
 func (b *Builder) Build() (*Inventory, error) {
+	if b.strict {
+		if err := b.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	// When insiders mode is disabled, strip insiders-only features from tools
 	tools := b.tools
 	if !b.insidersMode {
 		tools = stripInsidersFeatures(b.tools)
 	}
 
+	resourceTemplates, prompts, aliases := b.resourceTemplates, b.prompts, b.deprecatedAliases
+	if b.toolNamePrefix != "" {
+		tools, resourceTemplates, prompts, aliases = applyToolNamePrefix(b.toolNamePrefix, tools, resourceTemplates, prompts, aliases)
+	}
+
 	r := &Inventory{
-		tools:             tools,
-		resourceTemplates: b.resourceTemplates,
-		prompts:           b.prompts,
-		deprecatedAliases: b.deprecatedAliases,
-		readOnly:          b.readOnly,
-		featureChecker:    b.featureChecker,
-		filters:           b.filters,
+		tools:               tools,
+		resourceTemplates:   resourceTemplates,
+		prompts:             prompts,
+		deprecatedAliases:   aliases,
+		readOnly:            b.readOnly,
+		featureChecker:      b.featureChecker,
+		batchFeatureChecker: b.batchFeatureChecker,
+		filters:             b.filters,
+		toolsetChangeHook:   b.toolsetChangeHook,
+		hideDeprecated:      b.hideDeprecated,
+		includeExperimental: b.includeExperimental,
+		defaultPageSize:     b.defaultPageSize,
+		toolPageSizes:       b.toolPageSizes,
+		postProcessors:      b.postProcessors,
+		postProcessErrors:   b.postProcessErrors,
+		lenientArguments:    b.lenientArguments,
+		toolNamePrefix:      b.toolNamePrefix,
+		mu:                  &sync.RWMutex{},
+	}
+
+	repoAllowlist, err := parseRepoAllowlist(b.repoAllowlist)
+	if err != nil {
+		return nil, err
 	}
+	r.repoAllowlist = repoAllowlist
+
+	if len(b.readOnlyToolsets) > 0 {
+		r.readOnlyToolsets = make(map[ToolsetID]bool, len(b.readOnlyToolsets))
+		for _, id := range b.readOnlyToolsets {
+			r.readOnlyToolsets[id] = true
+		}
+	}
+
+	// Index tools by name, ID and alias for O(1) lookups in ForMCPRequest and FindToolByName
+	r.toolIndex = buildToolIndex(tools, aliases)
 
 	// Process toolsets and pre-compute metadata in a single pass
 	r.enabledToolsets, r.unrecognizedToolsets, r.toolsetIDs, r.toolsetIDSet, r.defaultToolsetIDs, r.toolsetDescriptions = b.processToolsets()
 
+	// Always-on toolsets are added on top of the selection (nil already means all enabled)
+	if len(b.alwaysOnToolsets) > 0 {
+		r.alwaysOnToolsets = make(map[ToolsetID]bool, len(b.alwaysOnToolsets))
+		for _, id := range b.alwaysOnToolsets {
+			r.alwaysOnToolsets[id] = true
+			if r.enabledToolsets != nil {
+				r.enabledToolsets[id] = true
+			}
+		}
+	}
+
+	// Dependencies of the selected toolsets are enabled too (nil already means all enabled)
+	r.toolsetDependencies = b.collectToolsetDependencies()
+	if r.enabledToolsets != nil {
+		selected := make([]ToolsetID, 0, len(r.enabledToolsets))
+		for id := range r.enabledToolsets {
+			selected = append(selected, id)
+		}
+		slices.Sort(selected)
+		for _, id := range selected {
+			for _, dep := range r.ToolsetDependencies(id) {
+				if !r.enabledToolsets[dep] {
+					r.enabledToolsets[dep] = true
+					r.dependencyEnabledToolsets = append(r.dependencyEnabledToolsets, dep)
+				}
+			}
+		}
+		slices.Sort(r.dependencyEnabledToolsets)
+	} else {
+		// Spell out "all enabled" so that DisableToolset can update the map in place,
+		// which ForMCPRequest views share
+		r.enabledToolsets = make(map[ToolsetID]bool, len(r.toolsetIDs))
+		for _, id := range r.toolsetIDs {
+			r.enabledToolsets[id] = true
+		}
+	}
+
 	// Build set of valid tool names for validation
 	validToolNames := make(map[string]bool, len(tools))
 	for i := range tools {
 		validToolNames[tools[i].Tool.Name] = true
+		validToolNames[tools[i].ToolID()] = true
 	}
 
 	// Process additional tools (clean, resolve aliases, and track unrecognized)
@@ -320,11 +556,17 @@ func (b *Builder) processToolsets() (map[ToolsetID]bool, []string, []ToolsetID,
 	}
 	slices.Sort(defaultToolsetIDList)
 
+	// Lowercased toolset IDs for case-insensitive matching
+	lowerIDs := make(map[string]ToolsetID, len(validIDs))
+	for _, id := range allToolsetIDs {
+		lowerIDs[strings.ToLower(string(id))] = id
+	}
+
 	toolsetIDs := b.toolsetIDs
 
 	// Check for "all" keyword - enables all toolsets
 	for _, id := range toolsetIDs {
-		if strings.TrimSpace(id) == "all" {
+		if strings.EqualFold(strings.TrimSpace(id), "all") {
 			return nil, nil, allToolsetIDs, validIDs, defaultToolsetIDList, descriptions // nil means all enabled
 		}
 	}
@@ -344,7 +586,7 @@ func (b *Builder) processToolsets() (map[ToolsetID]bool, []string, []ToolsetID,
 		if trimmed == "" {
 			continue
 		}
-		if trimmed == "default" {
+		if strings.EqualFold(trimmed, "default") {
 			for _, defaultID := range defaultToolsetIDList {
 				if !seen[defaultID] {
 					seen[defaultID] = true
@@ -353,6 +595,9 @@ func (b *Builder) processToolsets() (map[ToolsetID]bool, []string, []ToolsetID,
 			}
 		} else {
 			tsID := ToolsetID(trimmed)
+			if canonical, ok := lowerIDs[strings.ToLower(trimmed)]; ok {
+				tsID = canonical
+			}
 			if !seen[tsID] {
 				seen[tsID] = true
 				expanded = append(expanded, tsID)
@@ -375,6 +620,29 @@ func (b *Builder) processToolsets() (map[ToolsetID]bool, []string, []ToolsetID,
 	return enabledToolsets, unrecognized, allToolsetIDs, validIDs, defaultToolsetIDList, descriptions
 }
 
+// collectToolsetDependencies returns the direct dependencies declared in the
+// metadata of each toolset, merged across all items that share the toolset.
+func (b *Builder) collectToolsetDependencies() map[ToolsetID][]ToolsetID {
+	deps := make(map[ToolsetID][]ToolsetID)
+	add := func(ts ToolsetMetadata) {
+		for _, dep := range ts.DependsOn {
+			if !slices.Contains(deps[ts.ID], dep) {
+				deps[ts.ID] = append(deps[ts.ID], dep)
+			}
+		}
+	}
+	for i := range b.tools {
+		add(b.tools[i].Toolset)
+	}
+	for i := range b.resourceTemplates {
+		add(b.resourceTemplates[i].Toolset)
+	}
+	for i := range b.prompts {
+		add(b.prompts[i].Toolset)
+	}
+	return deps
+}
+
 // insidersOnlyMetaKeys lists the Meta keys that are only available in insiders mode.
 // Add new experimental feature keys here to have them automatically stripped
 // when insiders mode is disabled.
@@ -382,6 +650,33 @@ var insidersOnlyMetaKeys = []string{
 	"ui", // MCP Apps UI metadata
 }
 
+// applyToolNamePrefix returns copies of the tools, resource templates, prompts and
+// deprecated aliases with prefix prepended to their names. Tools keep their
+// unprefixed name as their ID.
+func applyToolNamePrefix(prefix string, tools []ServerTool, resourceTemplates []ServerResourceTemplate, prompts []ServerPrompt, aliases map[string]string) ([]ServerTool, []ServerResourceTemplate, []ServerPrompt, map[string]string) {
+	prefixedTools := make([]ServerTool, len(tools))
+	for i, tool := range tools {
+		tool.ID = tool.ToolID()
+		tool.Tool.Name = prefix + tool.Tool.Name
+		prefixedTools[i] = tool
+	}
+	prefixedTemplates := make([]ServerResourceTemplate, len(resourceTemplates))
+	for i, res := range resourceTemplates {
+		res.Template.Name = prefix + res.Template.Name
+		prefixedTemplates[i] = res
+	}
+	prefixedPrompts := make([]ServerPrompt, len(prompts))
+	for i, prompt := range prompts {
+		prompt.Prompt.Name = prefix + prompt.Prompt.Name
+		prefixedPrompts[i] = prompt
+	}
+	prefixedAliases := make(map[string]string, len(aliases))
+	for alias, canonical := range aliases {
+		prefixedAliases[prefix+alias] = prefix + canonical
+	}
+	return prefixedTools, prefixedTemplates, prefixedPrompts, prefixedAliases
+}
+
 // stripInsidersFeatures removes insiders-only features from tools.
 // This includes removing tools marked with InsidersOnly and stripping
 // Meta keys listed in insidersOnlyMetaKeys from remaining tools.
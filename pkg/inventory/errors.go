@@ -1,6 +1,10 @@
 package inventory
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 // ToolsetDoesNotExistError is returned when a toolset is not found.
 type ToolsetDoesNotExistError struct {
@@ -39,3 +43,70 @@ func (e *ToolDoesNotExistError) Error() string {
 func NewToolDoesNotExistError(name string) *ToolDoesNotExistError {
 	return &ToolDoesNotExistError{Name: name}
 }
+
+// maxToolsetSuggestionDistance is the largest edit distance for which an unknown
+// toolset name gets a "did you mean" suggestion.
+const maxToolsetSuggestionDistance = 2
+
+// UnknownToolsetError is returned for a requested toolset that doesn't match any
+// registered toolset. Suggestion holds the closest valid toolset ID, if any.
+type UnknownToolsetError struct {
+	Name       string
+	Suggestion ToolsetID
+}
+
+func (e *UnknownToolsetError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unknown toolset '%s'; did you mean '%s'?", e.Name, e.Suggestion)
+	}
+	return fmt.Sprintf("unknown toolset '%s'", e.Name)
+}
+
+// UnrecognizedToolsetsError returns an error describing each unrecognized toolset,
+// including a "did you mean" suggestion where a valid toolset is close enough.
+// Returns nil if all requested toolsets were recognized.
+func (r *Inventory) UnrecognizedToolsetsError() error {
+	errs := make([]error, 0, len(r.unrecognizedToolsets))
+	for _, name := range r.unrecognizedToolsets {
+		errs = append(errs, &UnknownToolsetError{
+			Name:       name,
+			Suggestion: suggestToolset(name, r.toolsetIDs),
+		})
+	}
+	return errors.Join(errs...)
+}
+
+// suggestToolset returns the valid toolset ID closest to name (case-insensitively),
+// or "" if none is within maxToolsetSuggestionDistance. Ties go to the first ID in order.
+func suggestToolset(name string, validIDs []ToolsetID) ToolsetID {
+	var best ToolsetID
+	bestDistance := maxToolsetSuggestionDistance + 1
+	for _, id := range validIDs {
+		if d := levenshtein(strings.ToLower(name), strings.ToLower(string(id))); d < bestDistance {
+			best, bestDistance = id, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
@@ -1,11 +1,12 @@
 package inventory
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"slices"
-	"sort"
 )
 
 // FeatureFlagChecker is a function that checks if a feature flag is enabled.
@@ -13,8 +14,53 @@ import (
 // Returns (enabled, error). If error occurs, the caller should log and treat as false.
 type FeatureFlagChecker func(ctx context.Context, flagName string) (bool, error)
 
+// BatchFeatureFlagChecker evaluates several feature flags in one call, returning
+// whether each is enabled. Flags missing from the result are treated as disabled.
+type BatchFeatureFlagChecker func(ctx context.Context, flags []string) (map[string]bool, error)
+
+type featureFlagResultsKey struct{}
+
+// withFeatureFlagResults evaluates the given flags with the batch feature checker, if
+// one is set, and returns a context carrying the results for checkFeatureFlag to use.
+// This lets the Available* methods make one batch call instead of one call per flag.
+func (r *Inventory) withFeatureFlagResults(ctx context.Context, flags []string) context.Context {
+	if r.batchFeatureChecker == nil || len(flags) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, featureFlagResultsKey{}, r.evaluateFeatureFlags(ctx, flags))
+}
+
+// evaluateFeatureFlags calls the batch feature checker. Errors are logged and all
+// flags treated as disabled, matching the single-flag checker.
+func (r *Inventory) evaluateFeatureFlags(ctx context.Context, flags []string) map[string]bool {
+	results, err := r.batchFeatureChecker(ctx, flags)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Feature flag batch check error for %v: %v\n", flags, err)
+		return map[string]bool{}
+	}
+	if results == nil {
+		return map[string]bool{}
+	}
+	return results
+}
+
+// featureFlagNames returns the sorted, distinct non-empty flag names in names.
+func featureFlagNames(names ...string) []string {
+	flags := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != "" {
+			flags = append(flags, name)
+		}
+	}
+	slices.Sort(flags)
+	return slices.Compact(flags)
+}
+
 // isToolsetEnabled checks if a toolset is enabled based on current filters.
 func (r *Inventory) isToolsetEnabled(toolsetID ToolsetID) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	// Check enabled toolsets filter
 	if r.enabledToolsets != nil {
 		return r.enabledToolsets[toolsetID]
@@ -22,10 +68,28 @@ func (r *Inventory) isToolsetEnabled(toolsetID ToolsetID) bool {
 	return true
 }
 
-// checkFeatureFlag checks a feature flag using the feature checker.
-// Returns false if checker is nil or returns an error (errors are logged).
+// isToolsetReadOnly checks if write tools in a toolset should be filtered out,
+// either because read-only mode is on globally or the toolset was forced read-only.
+func (r *Inventory) isToolsetReadOnly(toolsetID ToolsetID) bool {
+	return r.readOnly || r.readOnlyToolsets[toolsetID]
+}
+
+// checkFeatureFlag checks a feature flag using the batch feature checker when set,
+// preferring results already evaluated for this call (see withFeatureFlagResults),
+// and otherwise the single-flag feature checker.
+// Returns false if no checker is set or it returns an error (errors are logged).
 func (r *Inventory) checkFeatureFlag(ctx context.Context, flagName string) bool {
-	if r.featureChecker == nil || flagName == "" {
+	if flagName == "" {
+		return false
+	}
+	if r.batchFeatureChecker != nil {
+		results, ok := ctx.Value(featureFlagResultsKey{}).(map[string]bool)
+		if !ok {
+			results = r.evaluateFeatureFlags(ctx, []string{flagName})
+		}
+		return results[flagName]
+	}
+	if r.featureChecker == nil {
 		return false
 	}
 	enabled, err := r.featureChecker(ctx, flagName)
@@ -52,58 +116,160 @@ func (r *Inventory) isFeatureFlagAllowed(ctx context.Context, enableFlag, disabl
 }
 
 // isToolEnabled checks if a specific tool is enabled based on current filters.
-// Filter evaluation order:
+// See toolExclusionReason for the evaluation order.
+func (r *Inventory) isToolEnabled(ctx context.Context, tool *ServerTool) bool {
+	return r.toolExclusionReason(ctx, tool) == ""
+}
+
+// toolExclusionReason returns why a tool is excluded by the current filters, or an
+// empty string if it is enabled. Filter evaluation order:
 //  1. Tool.Enabled (tool self-filtering)
 //  2. FeatureFlagEnable/FeatureFlagDisable
 //  3. Read-only filter
-//  4. Builder filters (via WithFilter)
-//  5. Toolset/additional tools
-func (r *Inventory) isToolEnabled(ctx context.Context, tool *ServerTool) bool {
+//  4. Deprecated and experimental filters
+//  5. Builder filters (via WithFilter)
+//  6. Toolset/additional tools
+func (r *Inventory) toolExclusionReason(ctx context.Context, tool *ServerTool) string {
 	// 1. Check tool's own Enabled function first
 	if tool.Enabled != nil {
 		enabled, err := tool.Enabled(ctx)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Tool.Enabled check error for %q: %v\n", tool.Tool.Name, err)
-			return false
+			return fmt.Sprintf("excluded: tool enabled check failed: %v", err)
 		}
 		if !enabled {
-			return false
+			return "excluded: tool disabled itself"
 		}
 	}
 	// 2. Check feature flags
-	if !r.isFeatureFlagAllowed(ctx, tool.FeatureFlagEnable, tool.FeatureFlagDisable) {
-		return false
+	if tool.FeatureFlagEnable != "" && !r.checkFeatureFlag(ctx, tool.FeatureFlagEnable) {
+		return fmt.Sprintf("excluded: feature flag '%s' disabled", tool.FeatureFlagEnable)
 	}
-	// 3. Check read-only filter (applies to all tools)
-	if r.readOnly && !tool.IsReadOnly() {
-		return false
+	if tool.FeatureFlagDisable != "" && r.checkFeatureFlag(ctx, tool.FeatureFlagDisable) {
+		return fmt.Sprintf("excluded: feature flag '%s' enabled", tool.FeatureFlagDisable)
+	}
+	// 3. Check read-only filter (global or per-toolset)
+	if r.isToolsetReadOnly(tool.Toolset.ID) && !tool.IsReadOnly() {
+		if r.readOnly {
+			return "excluded: read-only mode"
+		}
+		return fmt.Sprintf("excluded: toolset '%s' is read-only", tool.Toolset.ID)
+	}
+	// 4. Check deprecated filter
+	if r.hideDeprecated && tool.IsDeprecated() {
+		return "excluded: deprecated tools are hidden"
 	}
-	// 4. Apply builder filters
-	for _, filter := range r.filters {
+	// 5. Check experimental filter
+	if !r.includeExperimental && tool.IsExperimental() {
+		return "excluded: experimental tools are hidden"
+	}
+	// 6. Apply builder filters
+	for i, filter := range r.filters {
 		allowed, err := filter(ctx, tool)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Builder filter error for tool %q: %v\n", tool.Tool.Name, err)
-			return false
+			return fmt.Sprintf("excluded: filter %d failed: %v", i+1, err)
 		}
 		if !allowed {
-			return false
+			return fmt.Sprintf("excluded: filter %d", i+1)
 		}
 	}
-	// 5. Check if tool is in additionalTools (bypasses toolset filter)
-	if r.additionalTools != nil && r.additionalTools[tool.Tool.Name] {
-		return true
+	// 7. Check if tool is in additionalTools (bypasses toolset filter)
+	if r.additionalTools != nil && (r.additionalTools[tool.Tool.Name] || r.additionalTools[tool.ToolID()]) {
+		return ""
 	}
-	// 5. Check toolset filter
+	// 8. Check toolset filter
 	if !r.isToolsetEnabled(tool.Toolset.ID) {
-		return false
+		return fmt.Sprintf("excluded: toolset '%s' not enabled", tool.Toolset.ID)
 	}
-	return true
+	return ""
+}
+
+// ExplainToolAvailability reports whether the named tool (or deprecated alias) is
+// available and, if not, the first filter that excluded it, walking the same pipeline
+// as AvailableTools. When several variants share the name (e.g. feature-flagged
+// replacements), the tool is available if any variant is, and otherwise the reason
+// for the first variant is reported. Intended for debugging missing tools.
+func (r *Inventory) ExplainToolAvailability(ctx context.Context, name string) (available bool, reason string) {
+	variants := r.filterToolsByName(name)
+	if len(variants) == 0 {
+		return false, fmt.Sprintf("excluded: tool '%s' does not exist", name)
+	}
+	for i := range variants {
+		excluded := r.toolExclusionReason(ctx, &variants[i])
+		if excluded == "" {
+			return true, "available"
+		}
+		if reason == "" {
+			reason = excluded
+		}
+	}
+	return false, reason
+}
+
+// itemSortKey is the shared ordering key for tools, resource templates and prompts.
+// Items are ordered by toolset ID, then name, then a tie-breaker so that items
+// sharing a name (such as feature-flagged variants) sort the same way regardless
+// of the order they were registered in.
+type itemSortKey struct {
+	toolset  ToolsetID
+	name     string
+	tiebreak string
+}
+
+func (k itemSortKey) compare(other itemSortKey) int {
+	return cmp.Or(
+		cmp.Compare(k.toolset, other.toolset),
+		cmp.Compare(k.name, other.name),
+		cmp.Compare(k.tiebreak, other.tiebreak),
+	)
+}
+
+// sortTools sorts tools deterministically: by toolset ID, then tool name,
+// then feature flags.
+func sortTools(tools []ServerTool) {
+	key := func(t *ServerTool) itemSortKey {
+		return itemSortKey{t.Toolset.ID, t.Tool.Name, t.FeatureFlagEnable + "\x00" + t.FeatureFlagDisable}
+	}
+	slices.SortStableFunc(tools, func(a, b ServerTool) int {
+		return key(&a).compare(key(&b))
+	})
+}
+
+// sortResourceTemplates sorts resource templates deterministically: by toolset ID,
+// then template name, then URI template.
+func sortResourceTemplates(templates []ServerResourceTemplate) {
+	key := func(r *ServerResourceTemplate) itemSortKey {
+		return itemSortKey{r.Toolset.ID, r.Template.Name, r.Template.URITemplate}
+	}
+	slices.SortStableFunc(templates, func(a, b ServerResourceTemplate) int {
+		return key(&a).compare(key(&b))
+	})
+}
+
+// sortPrompts sorts prompts deterministically: by toolset ID, then prompt name,
+// then feature flags.
+func sortPrompts(prompts []ServerPrompt) {
+	key := func(p *ServerPrompt) itemSortKey {
+		return itemSortKey{p.Toolset.ID, p.Prompt.Name, p.FeatureFlagEnable + "\x00" + p.FeatureFlagDisable}
+	}
+	slices.SortStableFunc(prompts, func(a, b ServerPrompt) int {
+		return key(&a).compare(key(&b))
+	})
 }
 
 // AvailableTools returns the tools that pass all current filters,
 // sorted deterministically by toolset ID, then tool name.
 // The context is used for feature flag evaluation.
 func (r *Inventory) AvailableTools(ctx context.Context) []ServerTool {
+	if r.batchFeatureChecker != nil {
+		names := make([]string, 0, 2*len(r.tools))
+		for i := range r.tools {
+			names = append(names, r.tools[i].FeatureFlagEnable, r.tools[i].FeatureFlagDisable)
+		}
+		ctx = r.withFeatureFlagResults(ctx, featureFlagNames(names...))
+	}
+
 	var result []ServerTool
 	for i := range r.tools {
 		tool := &r.tools[i]
@@ -112,13 +278,7 @@ func (r *Inventory) AvailableTools(ctx context.Context) []ServerTool {
 		}
 	}
 
-	// Sort deterministically: by toolset ID, then by tool name
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].Toolset.ID != result[j].Toolset.ID {
-			return result[i].Toolset.ID < result[j].Toolset.ID
-		}
-		return result[i].Tool.Name < result[j].Tool.Name
-	})
+	sortTools(result)
 
 	return result
 }
@@ -127,6 +287,14 @@ func (r *Inventory) AvailableTools(ctx context.Context) []ServerTool {
 // sorted deterministically by toolset ID, then template name.
 // The context is used for feature flag evaluation.
 func (r *Inventory) AvailableResourceTemplates(ctx context.Context) []ServerResourceTemplate {
+	if r.batchFeatureChecker != nil {
+		names := make([]string, 0, 2*len(r.resourceTemplates))
+		for i := range r.resourceTemplates {
+			names = append(names, r.resourceTemplates[i].FeatureFlagEnable, r.resourceTemplates[i].FeatureFlagDisable)
+		}
+		ctx = r.withFeatureFlagResults(ctx, featureFlagNames(names...))
+	}
+
 	var result []ServerResourceTemplate
 	for i := range r.resourceTemplates {
 		res := &r.resourceTemplates[i]
@@ -139,13 +307,7 @@ func (r *Inventory) AvailableResourceTemplates(ctx context.Context) []ServerReso
 		}
 	}
 
-	// Sort deterministically: by toolset ID, then by template name
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].Toolset.ID != result[j].Toolset.ID {
-			return result[i].Toolset.ID < result[j].Toolset.ID
-		}
-		return result[i].Template.Name < result[j].Template.Name
-	})
+	sortResourceTemplates(result)
 
 	return result
 }
@@ -154,6 +316,14 @@ func (r *Inventory) AvailableResourceTemplates(ctx context.Context) []ServerReso
 // sorted deterministically by toolset ID, then prompt name.
 // The context is used for feature flag evaluation.
 func (r *Inventory) AvailablePrompts(ctx context.Context) []ServerPrompt {
+	if r.batchFeatureChecker != nil {
+		names := make([]string, 0, 2*len(r.prompts))
+		for i := range r.prompts {
+			names = append(names, r.prompts[i].FeatureFlagEnable, r.prompts[i].FeatureFlagDisable)
+		}
+		ctx = r.withFeatureFlagResults(ctx, featureFlagNames(names...))
+	}
+
 	var result []ServerPrompt
 	for i := range r.prompts {
 		prompt := &r.prompts[i]
@@ -166,27 +336,58 @@ func (r *Inventory) AvailablePrompts(ctx context.Context) []ServerPrompt {
 		}
 	}
 
-	// Sort deterministically: by toolset ID, then by prompt name
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].Toolset.ID != result[j].Toolset.ID {
-			return result[i].Toolset.ID < result[j].Toolset.ID
-		}
-		return result[i].Prompt.Name < result[j].Prompt.Name
-	})
+	sortPrompts(result)
 
 	return result
 }
 
+// buildToolIndex maps each tool name and stable ID to the positions of all tools sharing
+// it, and each deprecated alias to the positions of its canonical tools. An alias that is
+// also a real tool name is not indexed, since exact matches take precedence.
+func buildToolIndex(tools []ServerTool, aliases map[string]string) map[string][]int {
+	index := make(map[string][]int, len(tools)+len(aliases))
+	for i := range tools {
+		name := tools[i].Tool.Name
+		index[name] = append(index[name], i)
+		if id := tools[i].ToolID(); id != name {
+			index[id] = append(index[id], i)
+		}
+	}
+	for alias, canonical := range aliases {
+		if _, exists := index[alias]; exists {
+			continue
+		}
+		if idx, ok := index[canonical]; ok {
+			index[alias] = idx
+		}
+	}
+	return index
+}
+
 // filterToolsByName returns tools matching the given name, checking deprecated aliases.
-// Uses linear scan - optimized for single-lookup per-request scenarios (ForMCPRequest).
 // Returns ALL tools matching the name to support feature-flagged tool variants
 // (e.g., GetJobLogs and ActionsGetJobLogs both use name "get_job_logs" but are
 // controlled by different feature flags).
+// Uses the prebuilt tool index when available, falling back to a linear scan.
 func (r *Inventory) filterToolsByName(name string) []ServerTool {
+	if r.toolIndex != nil {
+		idx := r.toolIndex[name]
+		result := make([]ServerTool, 0, len(idx))
+		for _, i := range idx {
+			result = append(result, r.tools[i])
+		}
+		return result
+	}
+	return r.scanToolsByName(name)
+}
+
+// scanToolsByName is the linear-scan equivalent of filterToolsByName, used when
+// no tool index has been built.
+func (r *Inventory) scanToolsByName(name string) []ServerTool {
 	var result []ServerTool
 	// Check for exact matches - multiple tools may share the same name with different feature flags
 	for i := range r.tools {
-		if r.tools[i].Tool.Name == name {
+		if r.tools[i].matchesName(name) {
 			result = append(result, r.tools[i])
 		}
 	}
@@ -224,17 +425,15 @@ func (r *Inventory) ToolsForToolset(toolsetID ToolsetID) []ServerTool {
 		tool := &r.tools[i]
 		// Only check read-only filter, not toolset enabled filter
 		if tool.Toolset.ID == toolsetID {
-			if r.readOnly && !tool.IsReadOnly() {
+			if r.isToolsetReadOnly(toolsetID) && !tool.IsReadOnly() {
 				continue
 			}
 			result = append(result, *tool)
 		}
 	}
 
-	// Sort by tool name for deterministic order
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Tool.Name < result[j].Tool.Name
-	})
+	// All tools share a toolset, so this orders by tool name
+	sortTools(result)
 
 	return result
 }
@@ -244,19 +443,137 @@ func (r *Inventory) IsToolsetEnabled(toolsetID ToolsetID) bool {
 	return r.isToolsetEnabled(toolsetID)
 }
 
-// EnableToolset marks a toolset as enabled in this group.
+// ToolsetDependencies returns the toolsets that toolsetID depends on, directly or
+// transitively, in the order they should be enabled (dependencies first). The
+// toolset itself is not included, and dependency cycles are cut where they close.
+func (r *Inventory) ToolsetDependencies(toolsetID ToolsetID) []ToolsetID {
+	var ordered []ToolsetID
+	visited := map[ToolsetID]bool{toolsetID: true}
+	var visit func(id ToolsetID)
+	visit = func(id ToolsetID) {
+		for _, dep := range r.toolsetDependencies[id] {
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			visit(dep)
+			ordered = append(ordered, dep)
+		}
+	}
+	visit(toolsetID)
+	return ordered
+}
+
+// EnableToolset marks a toolset, and any toolsets it depends on, as enabled in this group.
 // This is used by dynamic toolset management to track which toolsets have been enabled.
+// If a change notifier was registered, it is called when the enabled set changes.
+// It is safe to call concurrently with other Inventory methods.
 func (r *Inventory) EnableToolset(toolsetID ToolsetID) {
-	if r.enabledToolsets == nil {
+	r.mu.Lock()
+	if r.enabledToolsets == nil || r.enabledToolsets[toolsetID] {
 		// nil means all enabled, so nothing to do
+		r.mu.Unlock()
 		return
 	}
 	r.enabledToolsets[toolsetID] = true
+	for _, dep := range r.ToolsetDependencies(toolsetID) {
+		r.enabledToolsets[dep] = true
+	}
+	enabled := r.enabledToolsetIDsLocked()
+	r.mu.Unlock()
+
+	// Notify outside the lock so the callback may query the inventory
+	if r.toolsetChangeHook != nil {
+		r.toolsetChangeHook(enabled)
+	}
+}
+
+// IsToolsetAlwaysOn reports whether a toolset was configured via WithAlwaysOnToolsets
+// and therefore cannot be disabled.
+func (r *Inventory) IsToolsetAlwaysOn(toolsetID ToolsetID) bool {
+	return r.alwaysOnToolsets[toolsetID]
+}
+
+// DisableToolset removes a toolset from the enabled set. It is the counterpart of
+// EnableToolset for dynamic toolset management and notifies the change hook in the
+// same way. Always-on toolsets are left enabled; callers should check
+// IsToolsetAlwaysOn first. It is safe to call concurrently with other Inventory methods.
+func (r *Inventory) DisableToolset(toolsetID ToolsetID) {
+	if r.IsToolsetAlwaysOn(toolsetID) {
+		return
+	}
+
+	r.mu.Lock()
+	// The map is updated in place, never replaced, as ForMCPRequest views share it.
+	// Build always sets it, so it is only nil for an Inventory not made by a Builder
+	if r.enabledToolsets == nil || !r.enabledToolsets[toolsetID] {
+		r.mu.Unlock()
+		return
+	}
+	delete(r.enabledToolsets, toolsetID)
+	enabled := r.enabledToolsetIDsLocked()
+	r.mu.Unlock()
+
+	// Notify outside the lock so the callback may query the inventory
+	if r.toolsetChangeHook != nil {
+		r.toolsetChangeHook(enabled)
+	}
+}
+
+// EnableToolsetsByPattern enables every known toolset whose ID matches a glob pattern,
+// where * matches any run of characters and ? matches a single character (e.g.
+// "security_*"). Like EnableToolset, the toolsets they depend on are enabled too. It
+// complements EnableToolset for enabling families of toolsets and returns the sorted
+// IDs, including dependencies, that were not already enabled. The change hook is
+// notified once. It is safe to call concurrently with other Inventory methods.
+func (r *Inventory) EnableToolsetsByPattern(glob string) (enabled []string, err error) {
+	if _, err := path.Match(glob, ""); err != nil {
+		return nil, fmt.Errorf("invalid toolset pattern %q: %w", glob, err)
+	}
+
+	enabled = []string{}
+	r.mu.Lock()
+	if r.enabledToolsets == nil {
+		// nil means all enabled, so nothing to do
+		r.mu.Unlock()
+		return enabled, nil
+	}
+	for _, id := range r.toolsetIDs {
+		if matched, _ := path.Match(glob, string(id)); !matched {
+			continue
+		}
+		for _, toEnable := range append(r.ToolsetDependencies(id), id) {
+			if !r.enabledToolsets[toEnable] {
+				r.enabledToolsets[toEnable] = true
+				enabled = append(enabled, string(toEnable))
+			}
+		}
+	}
+	slices.Sort(enabled)
+	if len(enabled) == 0 {
+		r.mu.Unlock()
+		return enabled, nil
+	}
+	ids := r.enabledToolsetIDsLocked()
+	r.mu.Unlock()
+
+	// Notify outside the lock so the callback may query the inventory
+	if r.toolsetChangeHook != nil {
+		r.toolsetChangeHook(ids)
+	}
+	return enabled, nil
 }
 
 // EnabledToolsetIDs returns the list of enabled toolset IDs based on current filters.
 // Returns all toolset IDs if no filter is set.
 func (r *Inventory) EnabledToolsetIDs() []ToolsetID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.enabledToolsetIDsLocked()
+}
+
+// enabledToolsetIDsLocked is EnabledToolsetIDs for callers already holding mu.
+func (r *Inventory) enabledToolsetIDsLocked() []ToolsetID {
 	if r.enabledToolsets == nil {
 		return r.ToolsetIDs()
 	}
@@ -3,9 +3,11 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"slices"
-	"sort"
+	"strings"
+	"sync"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -24,6 +26,9 @@ import (
 //   - Deterministic ordering for documentation generation
 //   - Lazy dependency injection during registration via RegisterAll()
 //   - Runtime toolset enabling for dynamic toolsets mode
+//
+// An Inventory is safe for concurrent use: EnableToolset may be called while other
+// goroutines query or register items.
 type Inventory struct {
 	// tools holds all tools in this group (ordered for iteration)
 	tools []ServerTool
@@ -33,19 +38,50 @@ type Inventory struct {
 	prompts []ServerPrompt
 	// deprecatedAliases maps old tool names to new canonical names
 	deprecatedAliases map[string]string
+	// toolIndex maps tool names (and deprecated aliases) to positions in tools.
+	// Built once at Build() time; nil when tools has been narrowed by ForMCPRequest.
+	toolIndex map[string][]int
 
 	// Pre-computed toolset metadata (set during Build)
-	toolsetIDs          []ToolsetID          // sorted list of all toolset IDs
-	toolsetIDSet        map[ToolsetID]bool   // set for O(1) HasToolset lookup
-	defaultToolsetIDs   []ToolsetID          // sorted list of default toolset IDs
-	toolsetDescriptions map[ToolsetID]string // toolset ID -> description
+	toolsetIDs          []ToolsetID               // sorted list of all toolset IDs
+	toolsetIDSet        map[ToolsetID]bool        // set for O(1) HasToolset lookup
+	defaultToolsetIDs   []ToolsetID               // sorted list of default toolset IDs
+	toolsetDescriptions map[ToolsetID]string      // toolset ID -> description
+	toolsetDependencies map[ToolsetID][]ToolsetID // toolset ID -> direct dependencies
 
 	// Filters - these control what's returned by Available* methods
 	// readOnly when true filters out write tools
 	readOnly bool
+	// hideDeprecated when true filters out tools marked as deprecated for removal
+	hideDeprecated bool
+	// includeExperimental when false filters out experimental tools
+	includeExperimental bool
+	// readOnlyToolsets when non-nil, filters out write tools from these toolsets only
+	readOnlyToolsets map[ToolsetID]bool
 	// enabledToolsets when non-nil, only include tools/resources/prompts from these toolsets
-	// when nil, all toolsets are enabled
+	// when nil, all toolsets are enabled. Build always sets it. Guarded by mu, since
+	// EnableToolset and DisableToolset mutate it in place at runtime.
 	enabledToolsets map[ToolsetID]bool
+	// alwaysOnToolsets are toolsets that stay enabled regardless of the selection
+	// and cannot be disabled at runtime
+	alwaysOnToolsets map[ToolsetID]bool
+	// defaultPageSize is the perPage used by list tools when the caller omits it (0 = GitHub's default)
+	defaultPageSize int
+	// toolPageSizes overrides defaultPageSize for specific tools, keyed by tool name
+	toolPageSizes map[string]int
+	// postProcessors are applied, in order, to tool results when registered tools are called
+	postProcessors []ResultPostProcessor
+	// postProcessErrors when true also passes error results through postProcessors
+	postProcessErrors bool
+	// lenientArguments when true coerces mistyped tool arguments to their schema types
+	lenientArguments bool
+	// toolNamePrefix is prepended to item names at Build time (see WithToolNamePrefix)
+	toolNamePrefix string
+	// repoAllowlist when non-nil, restricts the repositories tools may act on (see WithRepoAllowlist)
+	repoAllowlist []repoAllowlistPattern
+	// mu guards enabledToolsets. It is a pointer so ForMCPRequest views, which share the
+	// map, also share the lock; Clone gets its own. All other fields are immutable after Build.
+	mu *sync.RWMutex
 	// additionalTools are specific tools that bypass toolset filtering (but still respect read-only)
 	// These are additive - a tool is included if it matches toolset filters OR is in this set
 	additionalTools map[string]bool
@@ -53,11 +89,20 @@ type Inventory struct {
 	// Takes context and flag name, returns (enabled, error). If error, log and treat as false.
 	// If checker is nil, all flag checks return false.
 	featureChecker FeatureFlagChecker
+	// batchFeatureChecker when non-nil, is used instead of featureChecker to evaluate
+	// all flags referenced by an Available* call at once
+	batchFeatureChecker BatchFeatureFlagChecker
 	// filters are functions that will be applied to all tools during filtering.
 	// If any filter returns false or an error, the tool is excluded.
 	filters []ToolFilter
+	// toolsetChangeHook when non-nil, is called with the enabled toolset IDs
+	// whenever EnableToolset changes the enabled set
+	toolsetChangeHook func(enabled []ToolsetID)
 	// unrecognizedToolsets holds toolset IDs that were requested but don't match any registered toolsets
 	unrecognizedToolsets []string
+	// dependencyEnabledToolsets holds toolset IDs enabled at build time only because
+	// a selected toolset depends on them
+	dependencyEnabledToolsets []ToolsetID
 	// server instructions hold high-level instructions for agents to use the server effectively
 	instructions string
 }
@@ -68,6 +113,12 @@ func (r *Inventory) UnrecognizedToolsets() []string {
 	return r.unrecognizedToolsets
 }
 
+// DependencyEnabledToolsets returns the toolset IDs that were not selected but were
+// enabled at build time because a selected toolset depends on them, sorted by ID.
+func (r *Inventory) DependencyEnabledToolsets() []ToolsetID {
+	return r.dependencyEnabledToolsets
+}
+
 // MCP method constants for use with ForMCPRequest.
 const (
 	MCPMethodInitialize             = "initialize"
@@ -101,19 +152,34 @@ const (
 // All existing filters (read-only, toolsets, etc.) still apply to the returned items.
 func (r *Inventory) ForMCPRequest(method string, itemName string) *Inventory {
 	// Create a shallow copy with shared filter settings
-	// Note: lazy-init maps (toolsByName, etc.) are NOT copied - the new Registry
-	// will initialize its own maps on first use if needed
+	// Note: the tool index is only shared when the tools slice is unchanged;
+	// narrowed views fall back to scanning their (small) tools slice
 	result := &Inventory{
 		tools:                r.tools,
 		resourceTemplates:    r.resourceTemplates,
 		prompts:              r.prompts,
 		deprecatedAliases:    r.deprecatedAliases,
 		readOnly:             r.readOnly,
-		enabledToolsets:      r.enabledToolsets, // shared, not modified
+		hideDeprecated:       r.hideDeprecated,
+		includeExperimental:  r.includeExperimental,
+		readOnlyToolsets:     r.readOnlyToolsets, // shared, not modified
+		enabledToolsets:      r.enabledToolsets,  // shared, guarded by mu
+		alwaysOnToolsets:     r.alwaysOnToolsets, // shared, not modified
+		defaultPageSize:      r.defaultPageSize,
+		toolPageSizes:        r.toolPageSizes,  // shared, not modified
+		postProcessors:       r.postProcessors, // shared, not modified
+		postProcessErrors:    r.postProcessErrors,
+		lenientArguments:     r.lenientArguments,
+		toolNamePrefix:       r.toolNamePrefix,
+		repoAllowlist:        r.repoAllowlist, // shared, not modified
+		mu:                   r.mu,
 		additionalTools:      r.additionalTools, // shared, not modified
 		featureChecker:       r.featureChecker,
+		batchFeatureChecker:  r.batchFeatureChecker,
 		filters:              r.filters, // shared, not modified
+		toolsetChangeHook:    r.toolsetChangeHook,
 		unrecognizedToolsets: r.unrecognizedToolsets,
+		toolsetDependencies:  r.toolsetDependencies, // shared, not modified
 	}
 
 	// Helper to clear all item types
@@ -128,6 +194,7 @@ func (r *Inventory) ForMCPRequest(method string, itemName string) *Inventory {
 		clearAll()
 	case MCPMethodToolsList:
 		result.resourceTemplates, result.prompts = nil, nil
+		result.toolIndex = r.toolIndex // shared, not modified
 	case MCPMethodToolsCall:
 		result.resourceTemplates, result.prompts = nil, nil
 		if itemName != "" {
@@ -152,6 +219,75 @@ func (r *Inventory) ForMCPRequest(method string, itemName string) *Inventory {
 	return result
 }
 
+// Clone returns a deep copy of the inventory that can be mutated independently,
+// e.g. so each session in a multi-session server can enable its own toolsets via
+// EnableToolset without affecting other sessions.
+//
+// The item slices, alias map, toolset metadata and filter state are copied.
+// The items themselves are copied by value, so their handlers and RegisterFunc
+// closures (and the tool schemas they point to) are shared; these are stateless
+// and must not be mutated after Build.
+func (r *Inventory) Clone() *Inventory {
+	r.mu.RLock()
+	enabledToolsets := maps.Clone(r.enabledToolsets) // Build always sets it; nil (all enabled) only outside a Builder
+	r.mu.RUnlock()
+
+	return &Inventory{
+		tools:                     slices.Clone(r.tools),
+		resourceTemplates:         slices.Clone(r.resourceTemplates),
+		prompts:                   slices.Clone(r.prompts),
+		deprecatedAliases:         maps.Clone(r.deprecatedAliases),
+		toolIndex:                 maps.Clone(r.toolIndex), // positions stay valid, the tools order is preserved
+		toolsetIDs:                slices.Clone(r.toolsetIDs),
+		toolsetIDSet:              maps.Clone(r.toolsetIDSet),
+		defaultToolsetIDs:         slices.Clone(r.defaultToolsetIDs),
+		toolsetDescriptions:       maps.Clone(r.toolsetDescriptions),
+		toolsetDependencies:       maps.Clone(r.toolsetDependencies),
+		readOnly:                  r.readOnly,
+		hideDeprecated:            r.hideDeprecated,
+		includeExperimental:       r.includeExperimental,
+		readOnlyToolsets:          maps.Clone(r.readOnlyToolsets),
+		enabledToolsets:           enabledToolsets,
+		alwaysOnToolsets:          maps.Clone(r.alwaysOnToolsets),
+		defaultPageSize:           r.defaultPageSize,
+		toolPageSizes:             maps.Clone(r.toolPageSizes),
+		postProcessors:            slices.Clone(r.postProcessors),
+		postProcessErrors:         r.postProcessErrors,
+		lenientArguments:          r.lenientArguments,
+		toolNamePrefix:            r.toolNamePrefix,
+		repoAllowlist:             slices.Clone(r.repoAllowlist),
+		mu:                        &sync.RWMutex{},
+		additionalTools:           maps.Clone(r.additionalTools),
+		featureChecker:            r.featureChecker,
+		batchFeatureChecker:       r.batchFeatureChecker,
+		filters:                   slices.Clone(r.filters),
+		toolsetChangeHook:         r.toolsetChangeHook,
+		unrecognizedToolsets:      slices.Clone(r.unrecognizedToolsets),
+		dependencyEnabledToolsets: slices.Clone(r.dependencyEnabledToolsets),
+		instructions:              r.instructions,
+	}
+}
+
+// MaxPageSize is the largest page size the GitHub API accepts.
+const MaxPageSize = 100
+
+// PageSize returns the configured default page size for a tool, or 0 if list
+// calls should use GitHub's default. See WithDefaultPageSize and WithToolPageSizes.
+func (r *Inventory) PageSize(toolName string) int {
+	size, ok := r.toolPageSizes[toolName]
+	if !ok && r.toolNamePrefix != "" {
+		// Page sizes may be configured by unprefixed name (see WithToolNamePrefix)
+		size, ok = r.toolPageSizes[strings.TrimPrefix(toolName, r.toolNamePrefix)]
+	}
+	if !ok {
+		size = r.defaultPageSize
+	}
+	if size <= 0 {
+		return 0
+	}
+	return min(size, MaxPageSize)
+}
+
 // ToolsetIDs returns a sorted list of unique toolset IDs from all tools in this group.
 func (r *Inventory) ToolsetIDs() []ToolsetID {
 	return r.toolsetIDs
@@ -172,13 +308,60 @@ func (r *Inventory) ToolsetDescriptions() map[ToolsetID]string {
 // The context is used for feature flag evaluation.
 func (r *Inventory) RegisterTools(ctx context.Context, s *mcp.Server, deps any) {
 	for _, tool := range r.AvailableTools(ctx) {
-		tool.RegisterFunc(s, deps)
+		r.RegisterTool(s, tool, deps)
+	}
+}
+
+// RegisterTool registers a single tool with the server, with its handler wrapped
+// as described in WrappedTool. Use this rather than ServerTool.RegisterFunc when
+// registering tools from the inventory at runtime.
+func (r *Inventory) RegisterTool(s *mcp.Server, tool ServerTool, deps any) {
+	tool = r.WrappedTool(tool)
+	tool.RegisterFunc(s, deps)
+}
+
+// WrappedTool returns a copy of tool whose handler applies lenient argument decoding
+// (WithLenientArguments), the repository allowlist (WithRepoAllowlist) and any result
+// post-processors configured with WithResultPostProcessor, as registered tools do.
+// Tools that call other tools' handlers directly should call them through this.
+func (r *Inventory) WrappedTool(tool ServerTool) ServerTool {
+	if tool.HandlerFunc == nil {
+		return tool
+	}
+	if r.lenientArguments {
+		tool.HandlerFunc = lenientArgumentsHandlerFunc(tool.Tool.InputSchema, tool.HandlerFunc)
+	}
+	if r.repoAllowlist != nil {
+		tool.HandlerFunc = r.repoAllowlistHandlerFunc(tool)
+	}
+	if len(r.postProcessors) > 0 {
+		tool.HandlerFunc = r.postProcessHandlerFunc(tool.Tool.Name, tool.HandlerFunc)
+	}
+	return tool
+}
+
+// postProcessHandlerFunc wraps handlerFunc so its results pass through the
+// configured post-processors.
+func (r *Inventory) postProcessHandlerFunc(toolName string, handlerFunc HandlerFunc) HandlerFunc {
+	return func(deps any) mcp.ToolHandler {
+		handler := handlerFunc(deps)
+		return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := handler(ctx, req)
+			if err != nil || result == nil || (result.IsError && !r.postProcessErrors) {
+				return result, err
+			}
+			for _, process := range r.postProcessors {
+				result = process(toolName, result)
+			}
+			return result, nil
+		}
 	}
 }
 
 // RegisterResourceTemplates registers all available resource templates with the server.
 // The context is used for feature flag evaluation.
-// Icons are automatically applied from the toolset metadata if not already set.
+// Icons are automatically applied from the toolset metadata if not already set, and
+// reads are checked against the repository allowlist (WithRepoAllowlist).
 func (r *Inventory) RegisterResourceTemplates(ctx context.Context, s *mcp.Server, deps any) {
 	for _, res := range r.AvailableResourceTemplates(ctx) {
 		// Make a shallow copy to avoid mutating the original
@@ -187,7 +370,11 @@ func (r *Inventory) RegisterResourceTemplates(ctx context.Context, s *mcp.Server
 		if len(templateCopy.Icons) == 0 {
 			templateCopy.Icons = res.Toolset.Icons()
 		}
-		s.AddResourceTemplate(&templateCopy, res.Handler(deps))
+		handler := res.Handler(deps)
+		if r.repoAllowlist != nil {
+			handler = r.repoAllowlistResourceHandler(templateCopy.URITemplate, handler)
+		}
+		s.AddResourceTemplate(&templateCopy, handler)
 	}
 }
 
@@ -234,18 +421,101 @@ func (r *Inventory) ResolveToolAliases(toolNames []string) (resolved []string, a
 	return resolved, aliasesUsed
 }
 
-// FindToolByName searches all tools for one matching the given name.
+// FindToolByName searches all tools for one whose name or stable ID matches toolName.
 // Returns the tool, its toolset ID, and an error if not found.
 // This searches ALL tools regardless of filters.
 func (r *Inventory) FindToolByName(toolName string) (*ServerTool, ToolsetID, error) {
+	if r.toolIndex != nil {
+		// The index also holds alias keys, so confirm this is an exact name or ID match
+		if idx := r.toolIndex[toolName]; len(idx) > 0 && r.tools[idx[0]].matchesName(toolName) {
+			return &r.tools[idx[0]], r.tools[idx[0]].Toolset.ID, nil
+		}
+		return nil, "", NewToolDoesNotExistError(toolName)
+	}
 	for i := range r.tools {
-		if r.tools[i].Tool.Name == toolName {
+		if r.tools[i].matchesName(toolName) {
 			return &r.tools[i], r.tools[i].Toolset.ID, nil
 		}
 	}
 	return nil, "", NewToolDoesNotExistError(toolName)
 }
 
+// ToolByCanonicalOrAlias looks up a tool by its name or by a deprecated alias of it.
+// It returns the tool and, when name is an alias, the alias that was resolved.
+// Like FindToolByName, this searches ALL tools regardless of filters.
+func (r *Inventory) ToolByCanonicalOrAlias(name string) (tool *ServerTool, alias string, err error) {
+	if canonical, isAlias := r.deprecatedAliases[name]; isAlias {
+		tool, _, err = r.FindToolByName(canonical)
+		if err != nil {
+			return nil, "", NewToolDoesNotExistError(name)
+		}
+		return tool, name, nil
+	}
+	tool, _, err = r.FindToolByName(name)
+	return tool, "", err
+}
+
+// ToolCost returns the approximate API cost of the named tool, resolving deprecated
+// aliases. Like FindToolByName, this searches ALL tools regardless of filters.
+func (r *Inventory) ToolCost(name string) (ToolCost, error) {
+	tool, _, err := r.ToolByCanonicalOrAlias(name)
+	if err != nil {
+		return "", err
+	}
+	return tool.EstimatedCost(), nil
+}
+
+// ToolsRequiringScope returns all tools that list scope among their RequiredScopes,
+// regardless of filters, in the same deterministic order as AllTools. It is intended
+// for auditing which tools a token change would affect.
+func (r *Inventory) ToolsRequiringScope(scope string) []ServerTool {
+	var result []ServerTool
+	for _, tool := range r.AllTools() {
+		if slices.Contains(tool.RequiredScopes, scope) {
+			result = append(result, tool)
+		}
+	}
+	return result
+}
+
+// DeprecatedTools returns all tools marked as deprecated for removal, regardless of
+// filters, in the same deterministic order as AllTools.
+func (r *Inventory) DeprecatedTools() []ServerTool {
+	var result []ServerTool
+	for i := range r.tools {
+		if r.tools[i].IsDeprecated() {
+			result = append(result, r.tools[i])
+		}
+	}
+	sortTools(result)
+	return result
+}
+
+// ReferencedFeatureFlags returns the sorted, deduplicated names of every feature flag
+// used by FeatureFlagEnable or FeatureFlagDisable across all tools, resources and
+// prompts, regardless of filters. Operators can use it to check that a flag service
+// defines every flag the catalog depends on.
+func (r *Inventory) ReferencedFeatureFlags() []string {
+	flags := make(map[string]bool)
+	add := func(names ...string) {
+		for _, name := range names {
+			if name != "" {
+				flags[name] = true
+			}
+		}
+	}
+	for i := range r.tools {
+		add(r.tools[i].FeatureFlagEnable, r.tools[i].FeatureFlagDisable)
+	}
+	for i := range r.resourceTemplates {
+		add(r.resourceTemplates[i].FeatureFlagEnable, r.resourceTemplates[i].FeatureFlagDisable)
+	}
+	for i := range r.prompts {
+		add(r.prompts[i].FeatureFlagEnable, r.prompts[i].FeatureFlagDisable)
+	}
+	return slices.Sorted(maps.Keys(flags))
+}
+
 // HasToolset checks if any tool/resource/prompt belongs to the given toolset.
 func (r *Inventory) HasToolset(toolsetID ToolsetID) bool {
 	return r.toolsetIDSet[toolsetID]
@@ -254,15 +524,7 @@ func (r *Inventory) HasToolset(toolsetID ToolsetID) bool {
 // AllTools returns all tools without any filtering, sorted deterministically.
 func (r *Inventory) AllTools() []ServerTool {
 	result := slices.Clone(r.tools)
-
-	// Sort deterministically: by toolset ID, then by tool name
-	sort.Slice(result, func(i, j int) bool {
-		if result[i].Toolset.ID != result[j].Toolset.ID {
-			return result[i].Toolset.ID < result[j].Toolset.ID
-		}
-		return result[i].Tool.Name < result[j].Tool.Name
-	})
-
+	sortTools(result)
 	return result
 }
 
@@ -302,6 +564,9 @@ func (r *Inventory) EnabledToolsets() []ToolsetMetadata {
 	// Get all available toolsets first (already sorted by ID)
 	allToolsets := r.AvailableToolsets()
 
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	// If no filter is set, all toolsets are enabled
 	if r.enabledToolsets == nil {
 		return allToolsets
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -148,6 +151,380 @@ func TestWithReadOnly(t *testing.T) {
 	}
 }
 
+func TestWithReadOnlyToolsets(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("repos_read", "repos", true),
+		mockTool("repos_write", "repos", false),
+		mockTool("issues_read", "issues", true),
+		mockTool("issues_write", "issues", false),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithReadOnlyToolsets([]ToolsetID{"repos"}))
+
+	var names []string
+	for _, tool := range reg.AvailableTools(context.Background()) {
+		names = append(names, tool.Tool.Name)
+	}
+	require.Equal(t, []string{"issues_read", "issues_write", "repos_read"}, names)
+
+	// ToolsForToolset (used by dynamic toolsets) respects the per-toolset override too
+	reposTools := reg.ToolsForToolset("repos")
+	require.Len(t, reposTools, 1)
+	require.Equal(t, "repos_read", reposTools[0].Tool.Name)
+	require.Len(t, reg.ToolsForToolset("issues"), 2)
+
+	// The override carries over to per-request views
+	callReg := reg.ForMCPRequest(MCPMethodToolsCall, "repos_write")
+	require.Empty(t, callReg.AvailableTools(context.Background()))
+}
+
+func TestWithReadOnlyToolsets_GlobalReadOnlyWins(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("repos_read", "repos", true),
+		mockTool("repos_write", "repos", false),
+		mockTool("issues_read", "issues", true),
+		mockTool("issues_write", "issues", false),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithReadOnly(true).
+		WithReadOnlyToolsets([]ToolsetID{"repos"}))
+
+	var names []string
+	for _, tool := range reg.AvailableTools(context.Background()) {
+		names = append(names, tool.Tool.Name)
+	}
+	require.Equal(t, []string{"issues_read", "repos_read"}, names)
+}
+
+func TestWithAlwaysOnToolsets(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("context_write", "context", false),
+		mockTool("repos_read", "repos", true),
+		mockTool("issues_read", "issues", true),
+		mockToolWithFlags("context_flagged", "context", true, "context_flag", ""),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"repos"}).
+		WithAlwaysOnToolsets([]ToolsetID{"context"}).
+		WithReadOnly(true))
+
+	var names []string
+	for _, tool := range reg.AvailableTools(context.Background()) {
+		names = append(names, tool.Tool.Name)
+	}
+	// context is included even though only repos was selected, but read-only mode
+	// and feature flags still apply to it
+	require.Equal(t, []string{"get_me", "repos_read"}, names)
+	require.Equal(t, []ToolsetID{"context", "repos"}, reg.EnabledToolsetIDs())
+
+	// Always-on toolsets are present even when no toolsets are selected (dynamic mode)
+	empty := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{}).
+		WithAlwaysOnToolsets([]ToolsetID{"context"}))
+	require.Equal(t, []ToolsetID{"context"}, empty.EnabledToolsetIDs())
+}
+
+func TestWithToolsetChangeNotifier(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("repos_read", "repos", true),
+		mockTool("issues_read", "issues", true),
+		mockTool("actions_read", "actions", true),
+	}
+
+	var calls [][]ToolsetID
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"repos"}).
+		WithToolsetChangeNotifier(func(enabled []ToolsetID) {
+			calls = append(calls, enabled)
+		}))
+
+	reg.EnableToolset("issues")
+	require.Equal(t, [][]ToolsetID{{"issues", "repos"}}, calls)
+
+	// Enabling an already-enabled toolset doesn't change the set, so no notification
+	reg.EnableToolset("repos")
+	reg.EnableToolset("issues")
+	require.Len(t, calls, 1)
+
+	reg.EnableToolset("actions")
+	require.Equal(t, [][]ToolsetID{{"issues", "repos"}, {"actions", "issues", "repos"}}, calls)
+}
+
+func TestWithToolsetChangeNotifier_AllEnabled(t *testing.T) {
+	called := false
+	reg := mustBuild(t, NewBuilder().
+		SetTools([]ServerTool{mockTool("repos_read", "repos", true)}).
+		WithToolsets([]string{"all"}).
+		WithToolsetChangeNotifier(func(_ []ToolsetID) { called = true }))
+
+	// With every toolset already enabled there is nothing to change
+	reg.EnableToolset("repos")
+	require.False(t, called)
+}
+
+func TestClone_EnableToolsetIsIndependent(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("repos_read", "repos", true),
+		mockTool("issues_read", "issues", true),
+	}
+
+	original := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"repos"}))
+
+	clone := original.Clone()
+	clone.EnableToolset("issues")
+
+	require.True(t, clone.IsToolsetEnabled("issues"))
+	require.False(t, original.IsToolsetEnabled("issues"))
+	require.Equal(t, []ToolsetID{"repos"}, original.EnabledToolsetIDs())
+	require.Len(t, original.AvailableTools(context.Background()), 1)
+	require.Len(t, clone.AvailableTools(context.Background()), 2)
+
+	// Lookups on the clone still work against its own copy of the tools
+	tool, toolsetID, err := clone.FindToolByName("issues_read")
+	require.NoError(t, err)
+	require.Equal(t, "issues_read", tool.Tool.Name)
+	require.Equal(t, ToolsetID("issues"), toolsetID)
+}
+
+func TestDisableToolset(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("repos_read", "repos", true),
+		mockTool("issues_read", "issues", true),
+	}
+
+	var calls [][]ToolsetID
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"repos", "issues"}).
+		WithAlwaysOnToolsets([]ToolsetID{"context"}).
+		WithToolsetChangeNotifier(func(enabled []ToolsetID) {
+			calls = append(calls, enabled)
+		}))
+
+	reg.DisableToolset("issues")
+	require.False(t, reg.IsToolsetEnabled("issues"))
+	require.Equal(t, []ToolsetID{"context", "repos"}, reg.EnabledToolsetIDs())
+
+	// Disabling an already disabled or always-on toolset is a no-op
+	reg.DisableToolset("issues")
+	require.True(t, reg.IsToolsetAlwaysOn("context"))
+	reg.DisableToolset("context")
+	require.True(t, reg.IsToolsetEnabled("context"))
+	require.Equal(t, [][]ToolsetID{{"context", "repos"}}, calls)
+
+	// When every toolset is enabled, disabling one keeps the rest
+	all := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}))
+	all.DisableToolset("repos")
+	require.Equal(t, []ToolsetID{"context", "issues"}, all.EnabledToolsetIDs())
+	require.Len(t, all.AvailableTools(context.Background()), 2)
+}
+
+func TestDisableToolset_SharedWithViews(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("repos_read", "repos", true),
+		mockTool("issues_read", "issues", true),
+	}
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}))
+
+	// Views made before a toolset is disabled see the change too
+	view := reg.ForMCPRequest(MCPMethodToolsList, "")
+
+	// Disabling while views are made must not race (run with -race)
+	var wg sync.WaitGroup
+	for _, id := range []ToolsetID{"repos", "issues"} {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			reg.DisableToolset(id)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = reg.ForMCPRequest(MCPMethodToolsList, "").AvailableTools(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	require.False(t, view.IsToolsetEnabled("repos"))
+	require.Len(t, view.AvailableTools(context.Background()), 1)
+	require.Equal(t, []ToolsetID{"context"}, reg.EnabledToolsetIDs())
+}
+
+func TestToolsetDependencies(t *testing.T) {
+	withDeps := func(tool ServerTool, deps ...ToolsetID) ServerTool {
+		tool.Toolset.DependsOn = deps
+		return tool
+	}
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		withDeps(mockTool("repos_read", "repos", true), "context"),
+		withDeps(mockTool("actions_read", "actions", true), "repos"),
+		mockTool("issues_read", "issues", true),
+	}
+
+	// Selecting a toolset at build time pulls in its dependencies transitively
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"actions"}))
+	require.Equal(t, []ToolsetID{"actions", "context", "repos"}, reg.EnabledToolsetIDs())
+	require.Equal(t, []ToolsetID{"context", "repos"}, reg.DependencyEnabledToolsets())
+	require.Equal(t, []ToolsetID{"context", "repos"}, reg.ToolsetDependencies("actions"))
+
+	// Enabling a toolset at runtime enables its dependencies too
+	var calls [][]ToolsetID
+	reg = mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"issues"}).
+		WithToolsetChangeNotifier(func(enabled []ToolsetID) {
+			calls = append(calls, enabled)
+		}))
+	require.Empty(t, reg.DependencyEnabledToolsets())
+	reg.EnableToolset("repos")
+	require.Equal(t, []ToolsetID{"context", "issues", "repos"}, reg.EnabledToolsetIDs())
+	require.Equal(t, [][]ToolsetID{{"context", "issues", "repos"}}, calls)
+
+	// A dependency cycle is cut instead of looping forever
+	cyclic := []ServerTool{
+		withDeps(mockTool("tool1", "toolset1", true), "toolset2"),
+		withDeps(mockTool("tool2", "toolset2", true), "toolset1"),
+	}
+	reg = mustBuild(t, NewBuilder().
+		SetTools(cyclic).
+		WithToolsets([]string{"toolset1"}))
+	require.Equal(t, []ToolsetID{"toolset1", "toolset2"}, reg.EnabledToolsetIDs())
+	require.Equal(t, []ToolsetID{"toolset2"}, reg.ToolsetDependencies("toolset1"))
+}
+
+func TestEnableToolsetsByPattern(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("list_code_alerts", "security_code", true),
+		mockTool("list_secret_alerts", "security_secrets", true),
+		mockTool("list_advisories", "security_advisories", true),
+		mockTool("issues_read", "issues", true),
+	}
+
+	var calls [][]ToolsetID
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"context", "security_advisories"}).
+		WithToolsetChangeNotifier(func(enabled []ToolsetID) {
+			calls = append(calls, enabled)
+		}))
+
+	// Already enabled toolsets are not reported again
+	enabled, err := reg.EnableToolsetsByPattern("security_*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"security_code", "security_secrets"}, enabled)
+	require.Equal(t, []ToolsetID{"context", "security_advisories", "security_code", "security_secrets"}, reg.EnabledToolsetIDs())
+	require.False(t, reg.IsToolsetEnabled("issues"))
+	require.Len(t, calls, 1)
+
+	enabled, err = reg.EnableToolsetsByPattern("secur?ty_code")
+	require.NoError(t, err)
+	require.Empty(t, enabled)
+
+	enabled, err = reg.EnableToolsetsByPattern("billing_*")
+	require.NoError(t, err)
+	require.Empty(t, enabled)
+	require.Len(t, calls, 1)
+
+	_, err = reg.EnableToolsetsByPattern("security_[")
+	require.Error(t, err)
+
+	// Dependencies of matched toolsets are enabled and reported too
+	withDeps := func(tool ServerTool, deps ...ToolsetID) ServerTool {
+		tool.Toolset.DependsOn = deps
+		return tool
+	}
+	reg = mustBuild(t, NewBuilder().
+		SetTools([]ServerTool{
+			mockTool("get_me", "context", true),
+			withDeps(mockTool("repos_read", "repos", true), "context"),
+			withDeps(mockTool("list_code_alerts", "security_code", true), "repos"),
+			withDeps(mockTool("list_secret_alerts", "security_secrets", true), "repos"),
+			mockTool("issues_read", "issues", true),
+		}).
+		WithToolsets([]string{"issues"}))
+	enabled, err = reg.EnableToolsetsByPattern("security_*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"context", "repos", "security_code", "security_secrets"}, enabled)
+	require.Equal(t, []ToolsetID{"context", "issues", "repos", "security_code", "security_secrets"}, reg.EnabledToolsetIDs())
+}
+
+func TestPageSize(t *testing.T) {
+	tools := []ServerTool{mockTool("list_things", "toolset1", true)}
+
+	unset := mustBuild(t, NewBuilder().SetTools(tools))
+	require.Equal(t, 0, unset.PageSize("list_things"))
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithDefaultPageSize(10).
+		WithToolPageSizes(map[string]int{"list_big": 500, "list_small": 5}))
+	require.Equal(t, 10, reg.PageSize("list_things"))
+	require.Equal(t, 5, reg.PageSize("list_small"))
+	require.Equal(t, MaxPageSize, reg.PageSize("list_big"))
+	require.Equal(t, 10, reg.ForMCPRequest(MCPMethodToolsCall, "list_things").PageSize("list_things"))
+	require.Equal(t, 5, reg.Clone().PageSize("list_small"))
+}
+
+func TestEnableToolset_Concurrent(t *testing.T) {
+	toolsetIDs := []ToolsetID{"actions", "issues", "pulls", "repos", "users"}
+	var tools []ServerTool
+	for _, id := range toolsetIDs {
+		tools = append(tools, mockTool(string(id)+"_read", string(id), true))
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"repos"}).
+		WithToolsetChangeNotifier(func(_ []ToolsetID) {}))
+	// A per-request view shares the enabled set with its parent
+	view := reg.ForMCPRequest(MCPMethodToolsList, "")
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 50 {
+				id := toolsetIDs[(i+j)%len(toolsetIDs)]
+				reg.EnableToolset(id)
+				_ = reg.IsToolsetEnabled(id)
+				_ = view.IsToolsetEnabled(id)
+				_ = reg.EnabledToolsetIDs()
+				_ = reg.EnabledToolsets()
+				_ = view.AvailableTools(context.Background())
+				_, _, _ = reg.FindToolByName(string(id) + "_read")
+				_ = reg.Clone()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, toolsetIDs, reg.EnabledToolsetIDs())
+	require.Len(t, view.AvailableTools(context.Background()), len(toolsetIDs))
+}
+
 func TestWithToolsets(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("tool1", "toolset1", true),
@@ -280,6 +657,153 @@ func TestUnrecognizedToolsets(t *testing.T) {
 	}
 }
 
+func TestBuilderValidate(t *testing.T) {
+	withSchema := func(tool ServerTool, schema any) ServerTool {
+		tool.Tool.InputSchema = schema
+		return tool
+	}
+	withToolset := func(tool ServerTool, ts ToolsetMetadata) ServerTool {
+		tool.Toolset = ts
+		return tool
+	}
+
+	tests := []struct {
+		name          string
+		tools         []ServerTool
+		knownToolsets []ToolsetID
+		expectedErr   string
+	}{
+		{
+			name:          "clean",
+			tools:         []ServerTool{mockTool("tool1", "toolset1", true), mockTool("tool2", "toolset2", false)},
+			knownToolsets: []ToolsetID{"toolset1", "toolset2"},
+		},
+		{
+			name:        "missing toolset ID",
+			tools:       []ServerTool{withToolset(mockTool("tool1", "toolset1", true), ToolsetMetadata{})},
+			expectedErr: `tool "tool1" has no toolset ID`,
+		},
+		{
+			name:          "unknown toolset ID",
+			tools:         []ServerTool{mockTool("tool1", "toolset1", true), mockTool("tool2", "tolset2", true)},
+			knownToolsets: []ToolsetID{"toolset1", "toolset2"},
+			expectedErr:   `tool "tool2": unknown toolset 'tolset2'; did you mean 'toolset2'?`,
+		},
+		{
+			name: "conflicting toolset metadata",
+			tools: []ServerTool{
+				mockTool("tool1", "toolset1", true),
+				withToolset(mockTool("tool2", "toolset1", true), ToolsetMetadata{ID: "toolset1", Description: "Something else"}),
+			},
+			expectedErr: `tool "tool2": toolset "toolset1" metadata conflicts`,
+		},
+		{
+			name:        "icon not embedded",
+			tools:       []ServerTool{withToolset(mockTool("tool1", "toolset1", true), ToolsetMetadata{ID: "toolset1", Icon: "no-such-icon"})},
+			expectedErr: `toolset "toolset1": icon "no-such-icon" is not an embedded octicon`,
+		},
+		{
+			name:        "missing input schema",
+			tools:       []ServerTool{withSchema(mockTool("tool1", "toolset1", true), nil)},
+			expectedErr: `tool "tool1": input schema is missing`,
+		},
+		{
+			name:        "malformed input schema",
+			tools:       []ServerTool{withSchema(mockTool("tool1", "toolset1", true), json.RawMessage(`{"type":"object","required":"owner"}`))},
+			expectedErr: `tool "tool1": input schema is not valid JSON Schema`,
+		},
+		{
+			name: "dependency on unknown toolset",
+			tools: []ServerTool{
+				withToolset(mockTool("tool1", "toolset1", true), ToolsetMetadata{ID: "toolset1", DependsOn: []ToolsetID{"toolset2"}}),
+			},
+			expectedErr: `toolset "toolset1" depends on unknown toolset "toolset2"`,
+		},
+		{
+			name: "dependency cycle",
+			tools: []ServerTool{
+				withToolset(mockTool("tool1", "toolset1", true), ToolsetMetadata{ID: "toolset1", DependsOn: []ToolsetID{"toolset2"}}),
+				withToolset(mockTool("tool2", "toolset2", true), ToolsetMetadata{ID: "toolset2", DependsOn: []ToolsetID{"toolset3"}}),
+				withToolset(mockTool("tool3", "toolset3", true), ToolsetMetadata{ID: "toolset3", DependsOn: []ToolsetID{"toolset1"}}),
+			},
+			expectedErr: `toolset dependency cycle: toolset1 -> toolset2 -> toolset3 -> toolset1`,
+		},
+		{
+			name:        "non-object input schema",
+			tools:       []ServerTool{withSchema(mockTool("tool1", "toolset1", true), json.RawMessage(`{"type":"string"}`))},
+			expectedErr: `tool "tool1": input schema type must be "object", got "string"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder().SetTools(tt.tools).WithKnownToolsets(tt.knownToolsets)
+
+			err := b.Validate()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				_, err = b.WithStrictValidation(true).Build()
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidInventory)
+			require.ErrorContains(t, err, tt.expectedErr)
+
+			// Validation only fails Build in strict mode
+			_, err = b.Build()
+			require.NoError(t, err)
+			_, err = b.WithStrictValidation(true).Build()
+			require.ErrorIs(t, err, ErrInvalidInventory)
+		})
+	}
+}
+
+func TestToolsetsCaseInsensitive(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("pr_read", "pull_requests", true),
+		mockTool("notif_read", "notifications", true),
+		mockTool("repo_read", "repos", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"Pull_Requests", "NOTIFICATIONS"}))
+
+	require.Empty(t, reg.UnrecognizedToolsets())
+	require.Equal(t, []ToolsetID{"notifications", "pull_requests"}, reg.EnabledToolsetIDs())
+	require.NoError(t, reg.UnrecognizedToolsetsError())
+
+	// Keywords are case-insensitive too
+	all := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{"ALL"}))
+	require.Len(t, all.AvailableTools(context.Background()), 3)
+}
+
+func TestUnrecognizedToolsetsError_Suggestions(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("notif_read", "notifications", true),
+		mockTool("repo_read", "repos", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"notificatons", "Repoz", "completely_wrong"}))
+
+	// The original input is still reported as-is
+	require.Equal(t, []string{"notificatons", "Repoz", "completely_wrong"}, reg.UnrecognizedToolsets())
+
+	err := reg.UnrecognizedToolsetsError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown toolset 'notificatons'; did you mean 'notifications'?")
+	require.Contains(t, err.Error(), "unknown toolset 'Repoz'; did you mean 'repos'?")
+	require.Contains(t, err.Error(), "unknown toolset 'completely_wrong'")
+	require.NotContains(t, err.Error(), "'completely_wrong'; did you mean")
+
+	var unknownErr *UnknownToolsetError
+	require.ErrorAs(t, err, &unknownErr)
+	require.Equal(t, "notificatons", unknownErr.Name)
+	require.Equal(t, ToolsetID("notifications"), unknownErr.Suggestion)
+}
+
 func TestBuildErrorsOnUnrecognizedTools(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("tool1", "toolset1", true),
@@ -560,6 +1084,34 @@ func TestFindToolByName(t *testing.T) {
 	}
 }
 
+func TestToolByCanonicalOrAlias(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("issue_read", "toolset1", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithDeprecatedAliases(map[string]string{
+			"get_issue":   "issue_read",
+			"get_missing": "missing_tool",
+		}))
+
+	tool, alias, err := reg.ToolByCanonicalOrAlias("issue_read")
+	require.NoError(t, err)
+	require.Equal(t, "issue_read", tool.Tool.Name)
+	require.Empty(t, alias)
+
+	tool, alias, err = reg.ToolByCanonicalOrAlias("get_issue")
+	require.NoError(t, err)
+	require.Equal(t, "issue_read", tool.Tool.Name)
+	require.Equal(t, "get_issue", alias)
+
+	_, _, err = reg.ToolByCanonicalOrAlias("get_missing")
+	require.Error(t, err)
+	_, _, err = reg.ToolByCanonicalOrAlias("nonexistent")
+	require.Error(t, err)
+}
+
 func TestWithToolsAdditive(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("issue_read", "toolset1", true),
@@ -726,6 +1278,86 @@ func mockPrompt(name string, toolsetID string) ServerPrompt {
 	)
 }
 
+func TestDeterministicOrder_ResourcesAndPrompts(t *testing.T) {
+	resources := []ServerResourceTemplate{
+		mockResource("content", "repos", "repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}"),
+		mockResource("branch", "repos", "repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}"),
+		mockResource("content", "repos", "repo://{owner}/{repo}/contents{/path*}"),
+		mockResource("alerts", "code_security", "alerts://{owner}/{repo}"),
+	}
+	prompts := []ServerPrompt{
+		mockPrompt("triage", "issues"),
+		mockPrompt("assign", "issues"),
+		mockPrompt("review", "pull_requests"),
+		mockPrompt("overview", "code_security"),
+	}
+
+	resourceOrder := func(reg *Inventory) []string {
+		var keys []string
+		for _, r := range reg.AvailableResourceTemplates(context.Background()) {
+			keys = append(keys, string(r.Toolset.ID)+"/"+r.Template.Name+" "+r.Template.URITemplate)
+		}
+		return keys
+	}
+	promptOrder := func(reg *Inventory) []string {
+		var keys []string
+		for _, p := range reg.AvailablePrompts(context.Background()) {
+			keys = append(keys, string(p.Toolset.ID)+"/"+p.Prompt.Name)
+		}
+		return keys
+	}
+
+	expectedResources := []string{
+		"code_security/alerts alerts://{owner}/{repo}",
+		"repos/branch repo://{owner}/{repo}/refs/heads/{branch}/contents{/path*}",
+		"repos/content repo://{owner}/{repo}/contents{/path*}",
+		"repos/content repo://{owner}/{repo}/refs/tags/{tag}/contents{/path*}",
+	}
+	expectedPrompts := []string{
+		"code_security/overview",
+		"issues/assign",
+		"issues/triage",
+		"pull_requests/review",
+	}
+
+	reg := mustBuild(t, NewBuilder().SetResources(resources).SetPrompts(prompts).WithToolsets([]string{"all"}))
+	for range 5 {
+		require.Equal(t, expectedResources, resourceOrder(reg))
+		require.Equal(t, expectedPrompts, promptOrder(reg))
+	}
+
+	// Registries built from differently-ordered input produce the same order
+	reversedResources := slices.Clone(resources)
+	slices.Reverse(reversedResources)
+	reversedPrompts := slices.Clone(prompts)
+	slices.Reverse(reversedPrompts)
+	reversed := mustBuild(t, NewBuilder().SetResources(reversedResources).SetPrompts(reversedPrompts).WithToolsets([]string{"all"}))
+	require.Equal(t, expectedResources, resourceOrder(reversed))
+	require.Equal(t, expectedPrompts, promptOrder(reversed))
+}
+
+func TestDeterministicOrder_FeatureFlaggedToolVariants(t *testing.T) {
+	tools := []ServerTool{
+		mockToolWithFlags("get_job_logs", "actions", true, "new_logs", ""),
+		mockToolWithFlags("get_job_logs", "actions", true, "", "new_logs"),
+		mockTool("list_workflows", "actions", true),
+	}
+	reversed := slices.Clone(tools)
+	slices.Reverse(reversed)
+
+	order := func(tools []ServerTool) []string {
+		var keys []string
+		for _, tool := range mustBuild(t, NewBuilder().SetTools(tools)).AllTools() {
+			keys = append(keys, tool.Tool.Name+"+"+tool.FeatureFlagEnable+"-"+tool.FeatureFlagDisable)
+		}
+		return keys
+	}
+
+	expected := []string{"get_job_logs+-new_logs", "get_job_logs+new_logs-", "list_workflows+-"}
+	require.Equal(t, expected, order(tools))
+	require.Equal(t, expected, order(reversed))
+}
+
 func TestForMCPRequest_Initialize(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("tool1", "repos", true),
@@ -1063,6 +1695,187 @@ func TestForMCPRequest_ResourcesTemplatesList(t *testing.T) {
 	}
 }
 
+func toolNames(tools []ServerTool) []string {
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.Tool.Name+"@"+string(tool.Toolset.ID))
+	}
+	return names
+}
+
+func TestToolIndex_MatchesLinearScan(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("issue_read", "issues", true),
+		mockTool("create_issue", "issues", false),
+		mockToolWithFlags("get_job_logs", "actions", true, "", "new_logs"),
+		mockToolWithFlags("get_job_logs", "actions", true, "new_logs", ""),
+		mockTool("shadowed", "repos", true),
+	}
+	aliases := map[string]string{
+		"get_issue":    "issue_read",
+		"old_logs":     "get_job_logs",
+		"shadowed":     "get_me", // exact tool name takes precedence over the alias
+		"missing_tool": "does_not_exist",
+	}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithDeprecatedAliases(aliases).WithToolsets([]string{"all"}))
+	require.NotNil(t, reg.toolIndex)
+
+	lookups := []string{"get_me", "issue_read", "create_issue", "get_job_logs", "shadowed", "get_issue", "old_logs", "missing_tool", "unknown", ""}
+	for _, name := range lookups {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, toolNames(reg.scanToolsByName(name)), toolNames(reg.filterToolsByName(name)))
+
+			indexedTool, indexedToolset, indexedErr := reg.FindToolByName(name)
+			unindexed := *reg
+			unindexed.toolIndex = nil
+			scannedTool, scannedToolset, scannedErr := unindexed.FindToolByName(name)
+			require.Equal(t, scannedErr, indexedErr)
+			require.Equal(t, scannedToolset, indexedToolset)
+			require.Equal(t, scannedTool, indexedTool)
+		})
+	}
+
+	require.Equal(t, []string{"issue_read@issues"}, toolNames(reg.filterToolsByName("get_issue")))
+	require.Len(t, reg.filterToolsByName("old_logs"), 2)
+	require.Equal(t, []string{"shadowed@repos"}, toolNames(reg.filterToolsByName("shadowed")))
+}
+
+func TestToolIDSurvivesRename(t *testing.T) {
+	// The tool was originally published as "get_issue" and later renamed for display
+	renamed := mockTool("issue_read", "issues", true)
+	renamed.ID = "get_issue"
+	tools := []ServerTool{mockTool("get_me", "context", true), renamed}
+
+	// Tools without an explicit ID fall back to their name
+	require.Equal(t, "get_me", tools[0].ToolID())
+	require.Equal(t, "get_issue", tools[1].ToolID())
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{"all"}))
+	for _, name := range []string{"get_issue", "issue_read"} {
+		t.Run(name, func(t *testing.T) {
+			tool, toolsetID, err := reg.FindToolByName(name)
+			require.NoError(t, err)
+			require.Equal(t, "issue_read", tool.Tool.Name)
+			require.Equal(t, ToolsetID("issues"), toolsetID)
+
+			// The linear scan used by narrowed inventories agrees with the index
+			unindexed := *reg
+			unindexed.toolIndex = nil
+			tool, _, err = unindexed.FindToolByName(name)
+			require.NoError(t, err)
+			require.Equal(t, "issue_read", tool.Tool.Name)
+
+			callReg := reg.ForMCPRequest(MCPMethodToolsCall, name)
+			require.Equal(t, []string{"issue_read@issues"}, toolNames(callReg.tools))
+		})
+	}
+}
+
+func TestWithToolNamePrefix(t *testing.T) {
+	tools := []ServerTool{
+		mockToolWithResult("issue_read", "issue", false),
+		mockTool("get_me", "context", true),
+		mockTool("create_issue", "issues", false),
+	}
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		SetResources([]ServerResourceTemplate{mockResource("repo_content", "toolset1", "repo://{owner}/{repo}")}).
+		SetPrompts([]ServerPrompt{mockPrompt("triage", "toolset1")}).
+		WithDeprecatedAliases(map[string]string{"get_issue": "issue_read"}).
+		WithToolsets([]string{"toolset1"}).
+		WithTools([]string{"get_me"}).
+		WithExcludeTools([]string{"create_issue"}).
+		WithToolNamePrefix("ghes1_"))
+
+	// The original tools are not modified
+	require.Equal(t, "issue_read", tools[0].Tool.Name)
+
+	// Unprefixed names in WithTools and WithExcludeTools still apply
+	require.Equal(t, []string{"ghes1_get_me@context", "ghes1_issue_read@toolset1"}, toolNames(reg.AvailableTools(context.Background())))
+	require.Equal(t, "ghes1_repo_content", reg.AvailableResourceTemplates(context.Background())[0].Template.Name)
+	require.Equal(t, "ghes1_triage", reg.AvailablePrompts(context.Background())[0].Prompt.Name)
+
+	tool, _, err := reg.FindToolByName("ghes1_issue_read")
+	require.NoError(t, err)
+	require.Equal(t, "issue_read", tool.ToolID())
+
+	tool, alias, err := reg.ToolByCanonicalOrAlias("ghes1_get_issue")
+	require.NoError(t, err)
+	require.Equal(t, "ghes1_issue_read", tool.Tool.Name)
+	require.Equal(t, "ghes1_get_issue", alias)
+
+	for _, name := range []string{"ghes1_issue_read", "ghes1_get_issue"} {
+		callReg := reg.ForMCPRequest(MCPMethodToolsCall, name)
+		require.Equal(t, []string{"ghes1_issue_read@toolset1"}, toolNames(callReg.AvailableTools(context.Background())))
+	}
+	promptReg := reg.ForMCPRequest(MCPMethodPromptsGet, "ghes1_triage")
+	require.Len(t, promptReg.AvailablePrompts(context.Background()), 1)
+
+	// Tools register and are called under their prefixed names
+	require.Equal(t, "issue", callRegisteredTool(t, reg, "ghes1_issue_read"))
+}
+
+func TestForMCPRequest_ToolIndexScoping(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("get_me", "context", true),
+		mockTool("issue_read", "issues", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithDeprecatedAliases(map[string]string{"get_issue": "issue_read"}))
+
+	// tools/list keeps the full tools slice, so it can share the index
+	listReg := reg.ForMCPRequest(MCPMethodToolsList, "")
+	require.NotNil(t, listReg.toolIndex)
+	tool, _, err := listReg.FindToolByName("issue_read")
+	require.NoError(t, err)
+	require.Equal(t, "issue_read", tool.Tool.Name)
+
+	// tools/call narrows the tools slice, so positions in the shared index no longer apply
+	callReg := reg.ForMCPRequest(MCPMethodToolsCall, "get_issue")
+	require.Nil(t, callReg.toolIndex)
+	require.Equal(t, []string{"issue_read@issues"}, toolNames(callReg.tools))
+	tool, _, err = callReg.FindToolByName("issue_read")
+	require.NoError(t, err)
+	require.Equal(t, "issue_read", tool.Tool.Name)
+	_, _, err = callReg.FindToolByName("get_me")
+	require.Error(t, err)
+
+	// The original index is untouched
+	require.Len(t, reg.toolIndex, 3)
+}
+
+func benchmarkInventory(b *testing.B, n int) *Inventory {
+	b.Helper()
+	tools := make([]ServerTool, 0, n)
+	for i := range n {
+		tools = append(tools, mockTool(fmt.Sprintf("tool_%04d", i), fmt.Sprintf("toolset_%d", i%20), i%2 == 0))
+	}
+	inv, err := NewBuilder().SetTools(tools).WithToolsets([]string{"all"}).Build()
+	require.NoError(b, err)
+	return inv
+}
+
+func BenchmarkForMCPRequest_ToolsCall(b *testing.B) {
+	inv := benchmarkInventory(b, 500)
+	name := "tool_0499"
+
+	b.Run("indexed", func(b *testing.B) {
+		for b.Loop() {
+			_ = inv.ForMCPRequest(MCPMethodToolsCall, name)
+		}
+	})
+
+	b.Run("linear", func(b *testing.B) {
+		unindexed := *inv
+		unindexed.toolIndex = nil
+		for b.Loop() {
+			_ = unindexed.ForMCPRequest(MCPMethodToolsCall, name)
+		}
+	})
+}
+
 func TestMCPMethodConstants(t *testing.T) {
 	// Verify constants match expected MCP method names
 	tests := []struct {
@@ -1094,6 +1907,139 @@ func mockToolWithFlags(name string, toolsetID string, readOnly bool, enableFlag,
 	return tool
 }
 
+func TestExplainToolAvailability(t *testing.T) {
+	selfDisabled := mockTool("self_disabled", "toolset1", true)
+	selfDisabled.Enabled = func(_ context.Context) (bool, error) { return false, nil }
+	experimental := mockTool("experimental_tool", "toolset1", true)
+	experimental.Experimental = true
+
+	tools := []ServerTool{
+		mockTool("available_tool", "toolset1", true),
+		mockTool("other_toolset_tool", "actions", true),
+		mockTool("write_tool", "toolset1", false),
+		mockToolWithFlags("flagged_tool", "toolset1", true, "beta_x", ""),
+		mockToolWithFlags("legacy_tool", "toolset1", true, "", "beta_y"),
+		mockDeprecatedTool("deprecated_tool", "toolset1", "use available_tool", ""),
+		experimental,
+		selfDisabled,
+		mockTool("excluded_tool", "toolset1", true),
+	}
+	checker := func(_ context.Context, flag string) (bool, error) { return flag == "beta_y", nil }
+
+	build := func(readOnly bool) *Inventory {
+		return mustBuild(t, NewBuilder().
+			SetTools(tools).
+			WithDeprecatedAliases(map[string]string{"old_name": "available_tool"}).
+			WithToolsets([]string{"toolset1"}).
+			WithReadOnly(readOnly).
+			WithReadOnlyToolsets([]ToolsetID{"toolset1"}).
+			WithFeatureChecker(checker).
+			WithHideDeprecated(true).
+			WithExcludeTools([]string{"excluded_tool"}))
+	}
+	reg := build(false)
+
+	tests := []struct {
+		tool      string
+		available bool
+		reason    string
+	}{
+		{"available_tool", true, "available"},
+		{"old_name", true, "available"},
+		{"other_toolset_tool", false, "excluded: toolset 'actions' not enabled"},
+		{"write_tool", false, "excluded: toolset 'toolset1' is read-only"},
+		{"flagged_tool", false, "excluded: feature flag 'beta_x' disabled"},
+		{"legacy_tool", false, "excluded: feature flag 'beta_y' enabled"},
+		{"deprecated_tool", false, "excluded: deprecated tools are hidden"},
+		{"experimental_tool", false, "excluded: experimental tools are hidden"},
+		{"self_disabled", false, "excluded: tool disabled itself"},
+		{"excluded_tool", false, "excluded: filter 1"},
+		{"missing_tool", false, "excluded: tool 'missing_tool' does not exist"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.tool, func(t *testing.T) {
+			available, reason := reg.ExplainToolAvailability(context.Background(), tc.tool)
+			require.Equal(t, tc.available, available)
+			require.Equal(t, tc.reason, reason)
+
+			// The explanation agrees with AvailableTools
+			listed := slices.ContainsFunc(reg.AvailableTools(context.Background()), func(st ServerTool) bool {
+				return st.Tool.Name == tc.tool
+			})
+			if tc.tool != "old_name" {
+				require.Equal(t, tc.available, listed)
+			}
+		})
+	}
+
+	available, reason := build(true).ExplainToolAvailability(context.Background(), "write_tool")
+	require.False(t, available)
+	require.Equal(t, "excluded: read-only mode", reason)
+}
+
+func TestReferencedFeatureFlags(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("plain", "toolset1", true),
+		mockToolWithFlags("new_tool", "toolset1", true, "flag_b", ""),
+		mockToolWithFlags("old_tool", "toolset1", true, "", "flag_b"),
+		mockToolWithFlags("both", "toolset2", true, "flag_c", "flag_a"),
+	}
+	resource := mockResource("res", "toolset1", "res://{id}")
+	resource.FeatureFlagDisable = "flag_d"
+	prompt := mockPrompt("prompt", "toolset2")
+	prompt.FeatureFlagEnable = "flag_a"
+
+	// Flags are reported even for items filtered out of the inventory
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		SetResources([]ServerResourceTemplate{resource}).
+		SetPrompts([]ServerPrompt{prompt}).
+		WithToolsets([]string{}))
+
+	require.Equal(t, []string{"flag_a", "flag_b", "flag_c", "flag_d"}, reg.ReferencedFeatureFlags())
+	require.Empty(t, mustBuild(t, NewBuilder()).ReferencedFeatureFlags())
+}
+
+func TestWithBatchFeatureChecker(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("always_available", "toolset1", true),
+		mockToolWithFlags("needs_a", "toolset1", true, "flag_a", ""),
+		mockToolWithFlags("needs_b", "toolset1", true, "flag_b", ""),
+		mockToolWithFlags("hidden_by_a", "toolset2", true, "", "flag_a"),
+		mockToolWithFlags("needs_a_too", "toolset2", true, "flag_a", ""),
+	}
+
+	var calls [][]string
+	batch := func(_ context.Context, flags []string) (map[string]bool, error) {
+		calls = append(calls, flags)
+		return map[string]bool{"flag_a": true}, nil
+	}
+	single := func(_ context.Context, _ string) (bool, error) {
+		t.Fatal("single-flag checker should not be called when a batch checker is set")
+		return false, nil
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithFeatureChecker(single).
+		WithBatchFeatureChecker(batch))
+
+	available := reg.AvailableTools(context.Background())
+	require.Equal(t, []string{"always_available@toolset1", "needs_a@toolset1", "needs_a_too@toolset2"}, toolNames(available))
+	require.Equal(t, [][]string{{"flag_a", "flag_b"}}, calls)
+
+	// Batch errors are treated as every flag disabled
+	failing := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithBatchFeatureChecker(func(_ context.Context, _ []string) (map[string]bool, error) {
+			return nil, fmt.Errorf("flag service unavailable")
+		}))
+	require.Equal(t, []string{"always_available@toolset1", "hidden_by_a@toolset2"}, toolNames(failing.AvailableTools(context.Background())))
+}
+
 func TestFeatureFlagEnable(t *testing.T) {
 	tools := []ServerTool{
 		mockTool("always_available", "toolset1", true),
@@ -1283,6 +2229,266 @@ func TestServerToolHandlerPanicOnNil(t *testing.T) {
 }
 
 // Tests for Enabled function on ServerTool
+func mockDeprecatedTool(name string, toolsetID string, message, removalVersion string) ServerTool {
+	tool := mockTool(name, toolsetID, true)
+	tool.DeprecatedMessage = message
+	tool.RemovalVersion = removalVersion
+	return tool
+}
+
+func TestDeprecatedTools(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("current", "toolset1", true),
+		mockDeprecatedTool("old_b", "toolset2", "use current instead", "v2.0.0"),
+		mockDeprecatedTool("old_a", "toolset1", "", "v2.0.0"),
+	}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{"all"}))
+
+	deprecated := reg.DeprecatedTools()
+	require.Len(t, deprecated, 2)
+	require.Equal(t, "old_a", deprecated[0].Tool.Name)
+	require.Equal(t, "old_b", deprecated[1].Tool.Name)
+
+	// Deprecated tools are still available unless explicitly hidden
+	require.Len(t, reg.AvailableTools(context.Background()), 3)
+
+	require.Equal(t, "DEPRECATED (will be removed in v2.0.0): use current instead", deprecated[1].DeprecationNotice())
+	require.Equal(t, "DEPRECATED (will be removed in v2.0.0)", deprecated[0].DeprecationNotice())
+	require.Equal(t, "DEPRECATED: going away", (&ServerTool{DeprecatedMessage: "going away"}).DeprecationNotice())
+}
+
+func TestToolCost(t *testing.T) {
+	expensive := mockTool("list_everything", "toolset1", true)
+	expensive.Cost = ToolCostLarge
+	tools := []ServerTool{mockTool("get_one", "toolset1", true), expensive}
+
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithDeprecatedAliases(map[string]string{"list_all": "list_everything"}))
+
+	cost, err := reg.ToolCost("list_everything")
+	require.NoError(t, err)
+	require.Equal(t, ToolCostLarge, cost)
+
+	cost, err = reg.ToolCost("list_all")
+	require.NoError(t, err)
+	require.Equal(t, ToolCostLarge, cost)
+
+	// Tools without a declared cost are assumed to make a single call
+	cost, err = reg.ToolCost("get_one")
+	require.NoError(t, err)
+	require.Equal(t, ToolCostSmall, cost)
+
+	_, err = reg.ToolCost("missing")
+	require.Error(t, err)
+}
+
+// mockToolWithResult creates a ServerTool whose handler always returns the given text.
+func mockToolWithResult(name string, text string, isError bool) ServerTool {
+	return NewServerToolFromHandler(
+		mcp.Tool{
+			Name:        name,
+			Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+			InputSchema: json.RawMessage(`{"type":"object","properties":{}}`),
+		},
+		testToolsetMetadata("toolset1"),
+		func(_ any) mcp.ToolHandler {
+			return func(_ context.Context, _ *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return &mcp.CallToolResult{
+					Content: []mcp.Content{&mcp.TextContent{Text: text}},
+					IsError: isError,
+				}, nil
+			}
+		},
+	)
+}
+
+// callRegisteredTool registers the inventory with a server and calls a tool through
+// an in-memory client session, returning the result text.
+func callRegisteredTool(t *testing.T, reg *Inventory, name string) string {
+	t.Helper()
+	return callRegisteredToolWithArgs(t, reg, name, map[string]any{})
+}
+
+// callRegisteredToolWithArgs is callRegisteredTool with explicit arguments.
+func callRegisteredToolWithArgs(t *testing.T, reg *Inventory, name string, args map[string]any) string {
+	t.Helper()
+	ctx := context.Background()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	reg.RegisterAll(ctx, server, nil)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	result, err := clientSession.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: args})
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+	text, ok := result.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	return text.Text
+}
+
+func TestRegisterTool_Examples(t *testing.T) {
+	tool := mockTool("issue_read", "issues", true)
+	tool.Tool.Meta = mcp.Meta{"custom": "keep"}
+	tool.Examples = []ToolExample{
+		{Description: "Get an issue", Arguments: map[string]any{"owner": "octo", "repo": "hello", "issue_number": 1}},
+	}
+	reg := mustBuild(t, NewBuilder().SetTools([]ServerTool{tool}).WithToolsets([]string{"all"}))
+
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	reg.RegisterAll(ctx, server, nil)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	result, err := clientSession.ListTools(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Tools, 1)
+	meta := result.Tools[0].Meta
+	require.Equal(t, "keep", meta["custom"])
+	require.Equal(t, []any{
+		map[string]any{
+			"description": "Get an issue",
+			"arguments":   map[string]any{"owner": "octo", "repo": "hello", "issue_number": float64(1)},
+		},
+	}, meta["examples"])
+
+	// Registration must not mutate the inventory's tool definition
+	require.NotContains(t, tool.Tool.Meta, "examples")
+	require.NotContains(t, reg.AvailableTools(ctx)[0].Tool.Meta, "examples")
+}
+
+func TestWithResultPostProcessor(t *testing.T) {
+	tokenPattern := regexp.MustCompile(`ghp_[A-Za-z0-9]+`)
+	redact := func(_ string, res *mcp.CallToolResult) *mcp.CallToolResult {
+		for _, content := range res.Content {
+			if text, ok := content.(*mcp.TextContent); ok {
+				text.Text = tokenPattern.ReplaceAllString(text.Text, "[REDACTED]")
+			}
+		}
+		return res
+	}
+	var seen []string
+	record := func(toolName string, res *mcp.CallToolResult) *mcp.CallToolResult {
+		seen = append(seen, toolName)
+		// Returning a new result is as valid as modifying the existing one
+		return &mcp.CallToolResult{Content: res.Content, IsError: res.IsError}
+	}
+
+	tools := []ServerTool{
+		mockToolWithResult("get_logs", "auth with ghp_abc123XYZ succeeded", false),
+		mockToolWithResult("failing", "bad token ghp_abc123XYZ", true),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithResultPostProcessor(redact).
+		WithResultPostProcessor(record))
+
+	require.Equal(t, "auth with [REDACTED] succeeded", callRegisteredTool(t, reg, "get_logs"))
+	require.Equal(t, []string{"get_logs"}, seen)
+
+	// Error results are left alone unless configured
+	require.Equal(t, "bad token ghp_abc123XYZ", callRegisteredTool(t, reg, "failing"))
+
+	withErrors := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithResultPostProcessor(redact).
+		WithPostProcessErrorResults(true))
+	require.Equal(t, "bad token [REDACTED]", callRegisteredTool(t, withErrors, "failing"))
+}
+
+func TestToolsRequiringScope(t *testing.T) {
+	withScopes := func(name string, required ...string) ServerTool {
+		tool := mockTool(name, "toolset1", false)
+		tool.RequiredScopes = required
+		return tool
+	}
+	tools := []ServerTool{
+		withScopes("write_b", "repo"),
+		withScopes("gist_tool", "gist"),
+		withScopes("write_a", "repo", "read:org"),
+		mockTool("no_scopes", "toolset1", true),
+	}
+
+	// Audits see every tool, including ones filtered out of this inventory
+	reg := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{}))
+
+	require.Equal(t, []string{"write_a@toolset1", "write_b@toolset1"}, toolNames(reg.ToolsRequiringScope("repo")))
+	require.Equal(t, []string{"gist_tool@toolset1"}, toolNames(reg.ToolsRequiringScope("gist")))
+	require.Empty(t, reg.ToolsRequiringScope("admin:org"))
+}
+
+func TestWithHideDeprecated(t *testing.T) {
+	tools := []ServerTool{
+		mockTool("current", "toolset1", true),
+		mockDeprecatedTool("old", "toolset1", "use current instead", ""),
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithHideDeprecated(true))
+
+	available := reg.AvailableTools(context.Background())
+	require.Len(t, available, 1)
+	require.Equal(t, "current", available[0].Tool.Name)
+
+	// Hidden tools can't be called either, but are still listed as deprecated
+	require.Empty(t, reg.ForMCPRequest(MCPMethodToolsCall, "old").AvailableTools(context.Background()))
+	require.Len(t, reg.DeprecatedTools(), 1)
+}
+
+func TestWithIncludeExperimental(t *testing.T) {
+	experimental := mockTool("new_tool", "toolset1", true)
+	experimental.Experimental = true
+	experimentalToolset := mockTool("lab_tool", "lab", true)
+	experimentalToolset.Toolset.Experimental = true
+	tools := []ServerTool{
+		mockTool("current", "toolset1", true),
+		experimental,
+		experimentalToolset,
+	}
+
+	names := func(reg *Inventory) []string {
+		var result []string
+		for _, tool := range reg.AvailableTools(context.Background()) {
+			result = append(result, tool.Tool.Name)
+		}
+		return result
+	}
+
+	// Experimental tools are hidden by default, even when requested by name
+	hidden := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithTools([]string{"new_tool"}))
+	require.Equal(t, []string{"current"}, names(hidden))
+
+	shown := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithIncludeExperimental(true))
+	require.Equal(t, []string{"lab_tool", "current", "new_tool"}, names(shown))
+}
+
 func TestServerToolEnabled(t *testing.T) {
 	tests := []struct {
 		name           string
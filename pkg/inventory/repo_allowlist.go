@@ -0,0 +1,273 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/yosida95/uritemplate/v3"
+)
+
+// repoAllowlistPattern is a cleaned WithRepoAllowlist entry, split into lowercased
+// owner and repository glob patterns.
+type repoAllowlistPattern struct {
+	owner string
+	repo  string
+}
+
+// parseRepoAllowlist cleans and validates allowlist patterns of the form
+// "owner/repo", where either part may use * and ? wildcards. A bare owner is
+// shorthand for "owner/*". Matching is case-insensitive, like GitHub names.
+func parseRepoAllowlist(patterns []string) ([]repoAllowlistPattern, error) {
+	var parsed []repoAllowlistPattern
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+		owner, repo, found := strings.Cut(pattern, "/")
+		if !found {
+			repo = "*"
+		}
+		if owner == "" || repo == "" || strings.Contains(repo, "/") {
+			return nil, fmt.Errorf("invalid repository allowlist pattern %q: expected owner/repo", pattern)
+		}
+		for _, part := range []string{owner, repo} {
+			if _, err := path.Match(part, ""); err != nil {
+				return nil, fmt.Errorf("invalid repository allowlist pattern %q: %w", pattern, err)
+			}
+		}
+		parsed = append(parsed, repoAllowlistPattern{owner: owner, repo: repo})
+	}
+	return parsed, nil
+}
+
+// RepoAllowed reports whether tools may act on owner/repo under the allowlist set
+// with WithRepoAllowlist. An empty repo checks the owner alone, as for tools that
+// act on a user or organization: it is allowed if any pattern matches the owner.
+// Everything is allowed when no allowlist is configured.
+func (r *Inventory) RepoAllowed(owner, repo string) bool {
+	if r.repoAllowlist == nil {
+		return true
+	}
+	owner, repo = strings.ToLower(owner), strings.ToLower(repo)
+	for _, p := range r.repoAllowlist {
+		if matched, _ := path.Match(p.owner, owner); !matched {
+			continue
+		}
+		if repo == "" {
+			return true
+		}
+		if matched, _ := path.Match(p.repo, repo); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// allOwnerReposAllowed reports whether every repository of owner is allowed, as
+// needed for searches scoped to an owner.
+func (r *Inventory) allOwnerReposAllowed(owner string) bool {
+	if r.repoAllowlist == nil {
+		return true
+	}
+	owner = strings.ToLower(owner)
+	for _, p := range r.repoAllowlist {
+		if matched, _ := path.Match(p.owner, owner); matched && p.repo == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ownerArguments are the tool arguments that name a user or organization that owns
+// repositories, checked like an owner without a repo.
+var ownerArguments = []string{"org", "organization"}
+
+// CheckRepoArguments returns an error if the tool arguments in arguments name a
+// repository or owner outside the allowlist. It checks the owner and repo
+// arguments, the owner named by org and organization arguments, and the arguments
+// named by the tool's RepoArguments, APIPathArgument and SearchQueryArgument. Arguments that
+// cannot be decoded are left for the tool handler to validate.
+func (r *Inventory) CheckRepoArguments(tool ServerTool, arguments json.RawMessage) error {
+	if r.repoAllowlist == nil || len(arguments) == 0 {
+		return nil
+	}
+	var args map[string]any
+	if err := json.Unmarshal(arguments, &args); err != nil {
+		return nil
+	}
+	owner, _ := args["owner"].(string)
+	repo, _ := args["repo"].(string)
+	if err := r.checkRepo(owner, repo); err != nil {
+		return err
+	}
+	for _, name := range ownerArguments {
+		if org, _ := args[name].(string); org != "" {
+			if err := r.checkRepo(org, ""); err != nil {
+				return err
+			}
+		}
+	}
+	for _, arg := range tool.RepoArguments {
+		if err := r.checkRepo(repoArgumentValue(arg, owner, args)); err != nil {
+			return err
+		}
+	}
+	if tool.APIPathArgument != "" {
+		if apiPath, ok := args[tool.APIPathArgument].(string); ok {
+			if err := r.checkAPIPath(apiPath); err != nil {
+				return err
+			}
+		}
+	}
+	if tool.SearchQueryArgument != "" {
+		query, _ := args[tool.SearchQueryArgument].(string)
+		if err := r.checkSearchQuery(query, owner != "" && repo != ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repoArgumentValue returns the owner and repository named by arg in args,
+// resolving a bare repository name against owner, the tool's owner argument.
+func repoArgumentValue(arg RepoArgument, owner string, args map[string]any) (string, string) {
+	repo, _ := args[arg.Repo].(string)
+	if arg.Owner != "" {
+		argOwner, _ := args[arg.Owner].(string)
+		return argOwner, repo
+	}
+	if repo == "" {
+		return "", ""
+	}
+	if o, n, found := strings.Cut(repo, "/"); found {
+		return o, n
+	}
+	return owner, repo
+}
+
+// checkRepo returns an error if owner/repo, or owner alone when repo is empty, is
+// outside the allowlist. An empty owner is left for the tool handler to validate.
+func (r *Inventory) checkRepo(owner, repo string) error {
+	if owner == "" || r.RepoAllowed(owner, repo) {
+		return nil
+	}
+	if repo == "" {
+		return fmt.Errorf("owner %s is not permitted by server policy", owner)
+	}
+	return fmt.Errorf("repository %s/%s is not permitted by server policy", owner, repo)
+}
+
+// checkAPIPath returns an error unless apiPath is a REST API path under a permitted
+// /repos/{owner}/{repo}, /orgs/{org} or /users/{user}. Other paths may reach any
+// repository, e.g. through /search or /repositories/{id}, so they are rejected.
+// Malformed paths are left for the tool handler to validate.
+func (r *Inventory) checkAPIPath(apiPath string) error {
+	u, err := url.Parse(strings.TrimSpace(apiPath))
+	if err != nil {
+		return nil
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case len(segments) >= 3 && segments[0] == "repos":
+		return r.checkRepo(segments[1], segments[2])
+	case len(segments) >= 2 && (segments[0] == "orgs" || segments[0] == "users"):
+		return r.checkRepo(segments[1], "")
+	}
+	return fmt.Errorf("path %s is not permitted by server policy: only /repos/{owner}/{repo}, /orgs/{org} and /users/{user} paths are allowed", apiPath)
+}
+
+// searchQualifier matches the repo:, org: and user: qualifiers of a search query,
+// with quoted or unquoted values.
+var searchQualifier = regexp.MustCompile(`(?i)(repo|org|user):("[^"]*"|[^\s)]+)`)
+
+// searchOrOperator matches the OR operator of a search query, which could widen a
+// search beyond the repositories its qualifiers name.
+var searchOrOperator = regexp.MustCompile(`(^|[\s)])OR($|[\s(])`)
+
+// checkSearchQuery returns an error unless query is limited to permitted
+// repositories: every repo:, org: and user: qualifier must be allowed, and there
+// must be at least one of them unless scoped is set, e.g. because the tool limits
+// the search to its owner and repo arguments. Negated qualifiers only narrow a
+// search and are not checked.
+func (r *Inventory) checkSearchQuery(query string, scoped bool) error {
+	if searchOrOperator.MatchString(query) {
+		return fmt.Errorf("search queries using OR are not permitted by server policy")
+	}
+	for _, match := range searchQualifier.FindAllStringSubmatchIndex(query, -1) {
+		if isNegatedSearchTerm(query[:match[0]]) {
+			continue
+		}
+		qualifier := strings.ToLower(query[match[2]:match[3]])
+		value := strings.Trim(query[match[4]:match[5]], `"`)
+		if qualifier == "repo" {
+			owner, repo, _ := strings.Cut(value, "/")
+			if owner == "" || repo == "" || !r.RepoAllowed(owner, repo) {
+				return fmt.Errorf("repository %s is not permitted by server policy", value)
+			}
+		} else if !r.allOwnerReposAllowed(value) {
+			return fmt.Errorf("searching all repositories of %s is not permitted by server policy", value)
+		}
+		scoped = true
+	}
+	if !scoped {
+		return fmt.Errorf("unscoped search queries are not permitted by server policy: limit the query with repo:, org: or user: qualifiers")
+	}
+	return nil
+}
+
+// isNegatedSearchTerm reports whether the search term following prefix is negated
+// with - or NOT.
+func isNegatedSearchTerm(prefix string) bool {
+	if rest, ok := strings.CutSuffix(prefix, "-"); ok {
+		return rest == "" || strings.HasSuffix(rest, " ") || strings.HasSuffix(rest, "(")
+	}
+	fields := strings.Fields(prefix)
+	return strings.HasSuffix(prefix, " ") && len(fields) > 0 && fields[len(fields)-1] == "NOT"
+}
+
+// repoAllowlistHandlerFunc wraps the handler of tool so that calls naming a
+// repository outside the allowlist fail before the handler runs.
+func (r *Inventory) repoAllowlistHandlerFunc(tool ServerTool) HandlerFunc {
+	handlerFunc := tool.HandlerFunc
+	return func(deps any) mcp.ToolHandler {
+		handler := handlerFunc(deps)
+		return func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if req.Params != nil {
+				if err := r.CheckRepoArguments(tool, req.Params.Arguments); err != nil {
+					return &mcp.CallToolResult{
+						Content: []mcp.Content{&mcp.TextContent{Text: err.Error()}},
+						IsError: true,
+					}, nil
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// repoAllowlistResourceHandler wraps handler, the handler of resources matching
+// uriTemplate, so that reads of a resource whose owner and repo template variables
+// name a repository outside the allowlist fail before the handler runs.
+func (r *Inventory) repoAllowlistResourceHandler(uriTemplate string, handler mcp.ResourceHandler) mcp.ResourceHandler {
+	tmpl, err := uritemplate.New(uriTemplate)
+	return func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource template %s: %w", uriTemplate, err)
+		}
+		if req.Params != nil {
+			if values := tmpl.Match(req.Params.URI); values != nil {
+				if err := r.checkRepo(values.Get("owner").String(), values.Get("repo").String()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
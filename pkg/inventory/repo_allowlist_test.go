@@ -0,0 +1,295 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRepoAllowlist(t *testing.T) {
+	writeTool := mockToolWithResult("update_thing", "updated", false)
+	writeTool.Tool.Annotations = &mcp.ToolAnnotations{ReadOnlyHint: false}
+	tools := []ServerTool{
+		mockToolWithResult("get_thing", "ok", false),
+		writeTool,
+	}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools(tools).
+		WithToolsets([]string{"all"}).
+		WithRepoAllowlist([]string{"octo-org/*", " octocat/Hello-World ", "monalisa"}))
+
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]any
+		expected string
+	}{
+		{
+			name:     "allowed repository",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world"},
+			expected: "ok",
+		},
+		{
+			name:     "wildcard match",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "Octo-Org", "repo": "any-repo"},
+			expected: "ok",
+		},
+		{
+			name:     "bare owner allows all its repositories",
+			tool:     "update_thing",
+			args:     map[string]any{"owner": "monalisa", "repo": "smile"},
+			expected: "updated",
+		},
+		{
+			name:     "owner only tool with an allowed owner",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "octocat"},
+			expected: "ok",
+		},
+		{
+			name:     "tool without owner",
+			tool:     "get_thing",
+			args:     map[string]any{"query": "is:open"},
+			expected: "ok",
+		},
+		{
+			name:     "blocked repository",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "secret"},
+			expected: "repository octocat/secret is not permitted by server policy",
+		},
+		{
+			name:     "blocked write",
+			tool:     "update_thing",
+			args:     map[string]any{"owner": "evil-org", "repo": "repo"},
+			expected: "repository evil-org/repo is not permitted by server policy",
+		},
+		{
+			name:     "blocked owner",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "evil-org"},
+			expected: "owner evil-org is not permitted by server policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, callRegisteredToolWithArgs(t, reg, tt.tool, tt.args))
+		})
+	}
+
+	// ForMCPRequest views and clones enforce the same allowlist
+	require.False(t, reg.ForMCPRequest(MCPMethodToolsCall, "get_thing").RepoAllowed("octocat", "secret"))
+	require.False(t, reg.Clone().RepoAllowed("octocat", "secret"))
+
+	// Without an allowlist everything is allowed
+	open := mustBuild(t, NewBuilder().SetTools(tools).WithToolsets([]string{"all"}))
+	require.True(t, open.RepoAllowed("anyone", "anything"))
+	require.Equal(t, "ok", callRegisteredToolWithArgs(t, open, "get_thing", map[string]any{"owner": "octocat", "repo": "secret"}))
+	require.Len(t, open.AvailableTools(context.Background()), 2)
+}
+
+func TestWithRepoAllowlist_InvalidPattern(t *testing.T) {
+	for _, pattern := range []string{"/repo", "owner/", "owner/repo/extra", "owner/[repo"} {
+		_, err := NewBuilder().WithRepoAllowlist([]string{pattern}).Build()
+		require.ErrorContains(t, err, "invalid repository allowlist pattern", pattern)
+	}
+}
+
+func TestWithRepoAllowlist_OtherArguments(t *testing.T) {
+	apiTool := mockToolWithResult("api_get", "ok", false)
+	apiTool.APIPathArgument = "path"
+	searchTool := mockToolWithResult("search_things", "ok", false)
+	searchTool.SearchQueryArgument = "query"
+	transferTool := mockToolWithResult("transfer_thing", "ok", false)
+	transferTool.RepoArguments = []RepoArgument{{Repo: "target_repo"}}
+	addItemTool := mockToolWithResult("add_item", "ok", false)
+	addItemTool.RepoArguments = []RepoArgument{{Owner: "item_owner", Repo: "item_repo"}}
+
+	reg := mustBuild(t, NewBuilder().
+		SetTools([]ServerTool{mockToolWithResult("get_thing", "ok", false), apiTool, searchTool, transferTool, addItemTool}).
+		WithToolsets([]string{"all"}).
+		WithRepoAllowlist([]string{"octo-org/*", "octocat/hello-world"}))
+
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]any
+		expected string
+	}{
+		{
+			name:     "allowed org",
+			tool:     "get_thing",
+			args:     map[string]any{"org": "octo-org"},
+			expected: "ok",
+		},
+		{
+			name:     "blocked org",
+			tool:     "get_thing",
+			args:     map[string]any{"org": "evil-org"},
+			expected: "owner evil-org is not permitted by server policy",
+		},
+		{
+			name:     "blocked organization next to an allowed repository",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world", "organization": "evil-org"},
+			expected: "owner evil-org is not permitted by server policy",
+		},
+		{
+			name:     "target repository named by owner/repo",
+			tool:     "transfer_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world", "target_repo": "octo-org/app"},
+			expected: "ok",
+		},
+		{
+			name:     "blocked target repository",
+			tool:     "transfer_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world", "target_repo": "evil-org/app"},
+			expected: "repository evil-org/app is not permitted by server policy",
+		},
+		{
+			name:     "bare target repository name resolved against the owner",
+			tool:     "transfer_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world", "target_repo": "secret"},
+			expected: "repository octocat/secret is not permitted by server policy",
+		},
+		{
+			name:     "allowed item repository",
+			tool:     "add_item",
+			args:     map[string]any{"owner": "octo-org", "item_owner": "octocat", "item_repo": "hello-world"},
+			expected: "ok",
+		},
+		{
+			name:     "blocked item repository",
+			tool:     "add_item",
+			args:     map[string]any{"owner": "octo-org", "item_owner": "octocat", "item_repo": "secret"},
+			expected: "repository octocat/secret is not permitted by server policy",
+		},
+		{
+			name:     "API path under an allowed repository",
+			tool:     "api_get",
+			args:     map[string]any{"path": "/repos/octocat/Hello-World/contents/README.md"},
+			expected: "ok",
+		},
+		{
+			name:     "API path under an allowed org",
+			tool:     "api_get",
+			args:     map[string]any{"path": "orgs/octo-org/teams"},
+			expected: "ok",
+		},
+		{
+			name:     "API path under a blocked repository",
+			tool:     "api_get",
+			args:     map[string]any{"path": "/repos/octocat/secret/contents/.env"},
+			expected: "repository octocat/secret is not permitted by server policy",
+		},
+		{
+			name:     "API path outside repositories and owners",
+			tool:     "api_get",
+			args:     map[string]any{"path": "/repositories/42/contents/.env"},
+			expected: "path /repositories/42/contents/.env is not permitted by server policy: only /repos/{owner}/{repo}, /orgs/{org} and /users/{user} paths are allowed",
+		},
+		{
+			name:     "path arguments of other tools are file paths",
+			tool:     "get_thing",
+			args:     map[string]any{"owner": "octocat", "repo": "hello-world", "path": "repos/evil/repo"},
+			expected: "ok",
+		},
+		{
+			name:     "search limited to allowed repositories",
+			tool:     "search_things",
+			args:     map[string]any{"query": `is:open repo:octocat/hello-world repo:"octo-org/app" -repo:evil/repo`},
+			expected: "ok",
+		},
+		{
+			name:     "search limited to an allowed org",
+			tool:     "search_things",
+			args:     map[string]any{"query": "org:Octo-Org language:go"},
+			expected: "ok",
+		},
+		{
+			name:     "search limited by owner and repo arguments",
+			tool:     "search_things",
+			args:     map[string]any{"query": "is:open", "owner": "octocat", "repo": "hello-world"},
+			expected: "ok",
+		},
+		{
+			name:     "unscoped search",
+			tool:     "search_things",
+			args:     map[string]any{"query": "password"},
+			expected: "unscoped search queries are not permitted by server policy: limit the query with repo:, org: or user: qualifiers",
+		},
+		{
+			name:     "search of a blocked repository",
+			tool:     "search_things",
+			args:     map[string]any{"query": "repo:octocat/hello-world repo:evil/repo"},
+			expected: "repository evil/repo is not permitted by server policy",
+		},
+		{
+			name:     "search of an owner with only some repositories allowed",
+			tool:     "search_things",
+			args:     map[string]any{"query": "user:octocat password"},
+			expected: "searching all repositories of octocat is not permitted by server policy",
+		},
+		{
+			name:     "search widened with OR",
+			tool:     "search_things",
+			args:     map[string]any{"query": "repo:octocat/hello-world OR password"},
+			expected: "search queries using OR are not permitted by server policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, callRegisteredToolWithArgs(t, reg, tt.tool, tt.args))
+		})
+	}
+
+	// Without an allowlist none of these are checked
+	open := mustBuild(t, NewBuilder().SetTools([]ServerTool{apiTool, searchTool}).WithToolsets([]string{"all"}))
+	require.Equal(t, "ok", callRegisteredToolWithArgs(t, open, "api_get", map[string]any{"path": "/search/code"}))
+	require.Equal(t, "ok", callRegisteredToolWithArgs(t, open, "search_things", map[string]any{"query": "password"}))
+}
+
+func TestWithRepoAllowlist_Resources(t *testing.T) {
+	resource := NewServerResourceTemplate(
+		testToolsetMetadata("repos"),
+		mcp.ResourceTemplate{Name: "content", URITemplate: "repo://{owner}/{repo}/contents{/path*}"},
+		func(_ any) mcp.ResourceHandler {
+			return func(_ context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+				return &mcp.ReadResourceResult{Contents: []*mcp.ResourceContents{{URI: req.Params.URI, Text: "ok"}}}, nil
+			}
+		},
+	)
+	reg := mustBuild(t, NewBuilder().
+		SetResources([]ServerResourceTemplate{resource}).
+		WithToolsets([]string{"all"}).
+		WithRepoAllowlist([]string{"octocat/hello-world"}))
+
+	ctx := context.Background()
+	server := mcp.NewServer(&mcp.Implementation{Name: "test"}, nil)
+	reg.RegisterAll(ctx, server, nil)
+
+	clientTransport, serverTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = serverSession.Close() })
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client"}, nil)
+	clientSession, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = clientSession.Close() })
+
+	result, err := clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: "repo://octocat/hello-world/contents/README.md"})
+	require.NoError(t, err)
+	require.Len(t, result.Contents, 1)
+	require.Equal(t, "ok", result.Contents[0].Text)
+
+	_, err = clientSession.ReadResource(ctx, &mcp.ReadResourceParams{URI: "repo://octocat/secret/contents/.env"})
+	require.ErrorContains(t, err, "repository octocat/secret is not permitted by server policy")
+}
@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"encoding/json"
+	"maps"
 
 	"github.com/github/github-mcp-server/pkg/octicons"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -15,6 +16,11 @@ import (
 // should define their own typed dependencies struct and type-assert as needed.
 type HandlerFunc func(deps any) mcp.ToolHandler
 
+// ResultPostProcessor rewrites a tool result after the handler returns, e.g. to
+// redact secrets or rewrite URLs. It may modify res in place and return it, or
+// return a new result.
+type ResultPostProcessor func(toolName string, res *mcp.CallToolResult) *mcp.CallToolResult
+
 // ToolsetID is a unique identifier for a toolset.
 // Using a distinct type provides compile-time type safety.
 type ToolsetID string
@@ -31,6 +37,11 @@ type ToolsetMetadata struct {
 	// Use the base name without size suffix, e.g., "repo" not "repo-16".
 	// See https://primer.style/foundations/icons for available icons.
 	Icon string
+	// Experimental marks every tool in this toolset as experimental, see ServerTool.Experimental
+	Experimental bool
+	// DependsOn lists toolsets that must be enabled for this toolset to be useful.
+	// Enabling this toolset, at build time or via EnableToolset, enables them too.
+	DependsOn []ToolsetID
 	// InstructionsFunc optionally returns instructions for this toolset.
 	// It receives the inventory so it can check what other toolsets are enabled.
 	InstructionsFunc func(inv *Inventory) string
@@ -42,12 +53,39 @@ func (tm ToolsetMetadata) Icons() []mcp.Icon {
 	return octicons.Icons(tm.Icon)
 }
 
+// ToolCost is a rough indication of how many GitHub API calls a tool makes per call,
+// so planners can prefer cheaper tools when budgeting requests.
+type ToolCost string
+
+const (
+	// ToolCostSmall means the tool makes a single API call. This is the default.
+	ToolCostSmall ToolCost = "small"
+	// ToolCostMedium means the tool makes a few API calls.
+	ToolCostMedium ToolCost = "medium"
+	// ToolCostLarge means the tool paginates or fans out, making many API calls.
+	ToolCostLarge ToolCost = "large"
+)
+
+// ToolExample is an example call of a tool, shown to agents alongside the tool's
+// input schema to help them build valid arguments.
+type ToolExample struct {
+	// Description says what the example call does.
+	Description string `json:"description"`
+	// Arguments is the argument object passed to the tool.
+	Arguments map[string]any `json:"arguments"`
+}
+
 // ServerTool represents an MCP tool with metadata and a handler generator function.
 // The tool definition is static, while the handler is generated on-demand
 // when the tool is registered with a server.
 // Tools are now self-describing with their toolset membership and read-only status
 // derived from the Tool.Annotations.ReadOnlyHint field.
 type ServerTool struct {
+	// ID is a stable identifier for this tool, independent of Tool.Name. Lookups
+	// match on either, so the display name can change without breaking references
+	// to the old one. Defaults to Tool.Name when unset; see ToolID.
+	ID string
+
 	// Tool is the MCP tool definition containing name, description, schema, etc.
 	Tool mcp.Tool
 
@@ -86,6 +124,74 @@ type ServerTool struct {
 	// InsidersOnly marks this tool as only available when insiders mode is enabled.
 	// When insiders mode is disabled, tools with this flag set are completely omitted.
 	InsidersOnly bool
+
+	// DeprecatedMessage marks this tool as deprecated for removal (as opposed to a rename,
+	// which is handled by deprecated aliases). The message is prepended to the tool
+	// description when registered and should point users at an alternative.
+	DeprecatedMessage string
+
+	// RemovalVersion optionally names the release in which a deprecated tool will be removed.
+	RemovalVersion string
+
+	// Experimental marks this tool as experimental. Experimental tools are hidden
+	// unless the inventory is built with WithIncludeExperimental(true).
+	Experimental bool
+
+	// Cost is the approximate API cost of calling this tool. Defaults to
+	// ToolCostSmall when unset; see EstimatedCost.
+	Cost ToolCost
+
+	// APIPathArgument names an argument holding a GitHub REST API path. While a
+	// repository allowlist is configured (see Builder.WithRepoAllowlist), the path
+	// must be under a permitted /repos/{owner}/{repo}, /orgs/{org} or /users/{user}.
+	APIPathArgument string
+
+	// SearchQueryArgument names an argument holding a GitHub search query. While a
+	// repository allowlist is configured, the query must be limited to permitted
+	// repositories with repo:, org: or user: qualifiers.
+	SearchQueryArgument string
+
+	// RepoArguments name arguments other than owner and repo that hold a repository
+	// the tool acts on. While a repository allowlist is configured, each repository
+	// they name must be permitted.
+	RepoArguments []RepoArgument
+
+	// Examples are example calls of this tool. They are registered in the tool's
+	// metadata under the "examples" key.
+	Examples []ToolExample
+}
+
+// RepoArgument names the tool arguments holding a repository other than the owner
+// and repo arguments.
+type RepoArgument struct {
+	// Owner names the argument holding the repository owner. When empty, Repo holds
+	// "owner/repo", or a bare repository name owned by the owner argument.
+	Owner string
+	// Repo names the argument holding the repository.
+	Repo string
+}
+
+// ToolID returns the stable identifier of this tool, falling back to Tool.Name
+// when ID is unset.
+func (st *ServerTool) ToolID() string {
+	if st.ID != "" {
+		return st.ID
+	}
+	return st.Tool.Name
+}
+
+// matchesName returns true if name is this tool's display name or stable ID.
+func (st *ServerTool) matchesName(name string) bool {
+	return st.Tool.Name == name || st.ToolID() == name
+}
+
+// EstimatedCost returns the approximate API cost of this tool, falling back to
+// ToolCostSmall when Cost is unset.
+func (st *ServerTool) EstimatedCost() ToolCost {
+	if st.Cost != "" {
+		return st.Cost
+	}
+	return ToolCostSmall
 }
 
 // IsReadOnly returns true if this tool is marked as read-only via annotations.
@@ -93,6 +199,32 @@ func (st *ServerTool) IsReadOnly() bool {
 	return st.Tool.Annotations != nil && st.Tool.Annotations.ReadOnlyHint
 }
 
+// IsExperimental returns true if this tool or its toolset is marked as experimental.
+func (st *ServerTool) IsExperimental() bool {
+	return st.Experimental || st.Toolset.Experimental
+}
+
+// IsDeprecated returns true if this tool is marked as deprecated for removal.
+func (st *ServerTool) IsDeprecated() bool {
+	return st.DeprecatedMessage != "" || st.RemovalVersion != ""
+}
+
+// DeprecationNotice returns the notice prepended to a deprecated tool's description,
+// or an empty string if the tool is not deprecated.
+func (st *ServerTool) DeprecationNotice() string {
+	if !st.IsDeprecated() {
+		return ""
+	}
+	notice := "DEPRECATED"
+	if st.RemovalVersion != "" {
+		notice += " (will be removed in " + st.RemovalVersion + ")"
+	}
+	if st.DeprecatedMessage != "" {
+		notice += ": " + st.DeprecatedMessage
+	}
+	return notice
+}
+
 // HasHandler returns true if this tool has a handler function.
 func (st *ServerTool) HasHandler() bool {
 	return st.HandlerFunc != nil
@@ -108,7 +240,8 @@ func (st *ServerTool) Handler(deps any) mcp.ToolHandler {
 }
 
 // RegisterFunc registers the tool with the server using the provided dependencies.
-// Icons are automatically applied from the toolset metadata if not already set.
+// Icons are automatically applied from the toolset metadata if not already set,
+// and deprecated tools have a deprecation notice prepended to their description.
 // A shallow copy of the tool is made to avoid mutating the original ServerTool.
 // Panics if the tool has no handler - all tools should have handlers.
 func (st *ServerTool) RegisterFunc(s *mcp.Server, deps any) {
@@ -119,6 +252,16 @@ func (st *ServerTool) RegisterFunc(s *mcp.Server, deps any) {
 	if len(toolCopy.Icons) == 0 {
 		toolCopy.Icons = st.Toolset.Icons()
 	}
+	if st.IsDeprecated() {
+		toolCopy.Description = st.DeprecationNotice() + "\n\n" + toolCopy.Description
+	}
+	if len(st.Examples) > 0 {
+		toolCopy.Meta = maps.Clone(toolCopy.Meta)
+		if toolCopy.Meta == nil {
+			toolCopy.Meta = mcp.Meta{}
+		}
+		toolCopy.Meta["examples"] = st.Examples
+	}
 	s.AddTool(&toolCopy, handler)
 }
 
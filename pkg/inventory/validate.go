@@ -0,0 +1,142 @@
+package inventory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/octicons"
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// Validate checks the configured tools, resources and prompts for mistakes that
+// would otherwise go unnoticed until runtime:
+//   - every item has a toolset ID, and it is known (if WithKnownToolsets was used)
+//   - items sharing a toolset ID agree on its description and icon
+//   - toolset icons are embedded Octicons
+//   - tool input schemas are well-formed JSON Schema objects
+//   - toolset dependencies name known toolsets and contain no cycles
+//
+// All problems are reported together, wrapped in ErrInvalidInventory.
+func (b *Builder) Validate() error {
+	var errs []error
+	seen := make(map[ToolsetID]ToolsetMetadata)
+
+	checkToolset := func(kind, name string, ts ToolsetMetadata) {
+		if ts.ID == "" {
+			errs = append(errs, fmt.Errorf("%s %q has no toolset ID", kind, name))
+			return
+		}
+		if len(b.knownToolsets) > 0 && !slices.Contains(b.knownToolsets, ts.ID) {
+			err := &UnknownToolsetError{Name: string(ts.ID), Suggestion: suggestToolset(string(ts.ID), b.knownToolsets)}
+			errs = append(errs, fmt.Errorf("%s %q: %w", kind, name, err))
+		}
+		if prev, ok := seen[ts.ID]; ok {
+			if prev.Description != ts.Description || prev.Icon != ts.Icon {
+				errs = append(errs, fmt.Errorf("%s %q: toolset %q metadata conflicts with other items in the toolset", kind, name, ts.ID))
+			}
+			return
+		}
+		seen[ts.ID] = ts
+		if ts.Icon != "" && !octicons.IsEmbedded(ts.Icon) {
+			errs = append(errs, fmt.Errorf("toolset %q: icon %q is not an embedded octicon", ts.ID, ts.Icon))
+		}
+	}
+
+	for i := range b.tools {
+		tool := &b.tools[i]
+		checkToolset("tool", tool.Tool.Name, tool.Toolset)
+		if err := validateInputSchema(tool.Tool.InputSchema); err != nil {
+			errs = append(errs, fmt.Errorf("tool %q: %w", tool.Tool.Name, err))
+		}
+	}
+	for i := range b.resourceTemplates {
+		checkToolset("resource template", b.resourceTemplates[i].Template.Name, b.resourceTemplates[i].Toolset)
+	}
+	for i := range b.prompts {
+		checkToolset("prompt", b.prompts[i].Prompt.Name, b.prompts[i].Toolset)
+	}
+
+	errs = append(errs, validateToolsetDependencies(b.collectToolsetDependencies(), seen)...)
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidInventory, errors.Join(errs...))
+}
+
+// validateInputSchema checks that a tool input schema is a well-formed JSON Schema
+// describing an object, as MCP requires. The schema may be a *jsonschema.Schema or
+// anything that marshals to one (e.g. json.RawMessage).
+func validateInputSchema(schema any) error {
+	if schema == nil {
+		return errors.New("input schema is missing")
+	}
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("input schema is not valid JSON: %w", err)
+	}
+	var s jsonschema.Schema
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return fmt.Errorf("input schema is not valid JSON Schema: %w", err)
+	}
+	if s.Type != "object" {
+		return fmt.Errorf("input schema type must be \"object\", got %q", s.Type)
+	}
+	if _, err := s.Resolve(nil); err != nil {
+		return fmt.Errorf("input schema is not valid JSON Schema: %w", err)
+	}
+	return nil
+}
+
+// validateToolsetDependencies reports dependencies on toolsets that have no items
+// and each dependency cycle, e.g. "a -> b -> a".
+func validateToolsetDependencies(deps map[ToolsetID][]ToolsetID, known map[ToolsetID]ToolsetMetadata) []error {
+	var errs []error
+	ids := slices.Sorted(maps.Keys(deps))
+	for _, id := range ids {
+		for _, dep := range deps[id] {
+			if _, ok := known[dep]; !ok {
+				errs = append(errs, fmt.Errorf("toolset %q depends on unknown toolset %q", id, dep))
+			}
+		}
+	}
+
+	// Depth-first search, reporting a cycle whenever an edge leads back onto the current path
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[ToolsetID]int, len(deps))
+	var path []ToolsetID
+	var visit func(id ToolsetID)
+	visit = func(id ToolsetID) {
+		state[id] = inProgress
+		path = append(path, id)
+		for _, dep := range deps[id] {
+			switch state[dep] {
+			case inProgress:
+				cycle := append(slices.Clone(path[slices.Index(path, dep):]), dep)
+				names := make([]string, len(cycle))
+				for i, c := range cycle {
+					names[i] = string(c)
+				}
+				errs = append(errs, fmt.Errorf("toolset dependency cycle: %s", strings.Join(names, " -> ")))
+			case unvisited:
+				visit(dep)
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+	for _, id := range ids {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+	return errs
+}
@@ -58,6 +58,17 @@ func DataURI(name string, theme Theme) string {
 	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(data)
 }
 
+// IsEmbedded reports whether both the light and dark variants of the named
+// Octicon are embedded. Names not listed in required_icons.txt return false.
+func IsEmbedded(name string) bool {
+	for _, theme := range []Theme{ThemeLight, ThemeDark} {
+		if _, err := iconsFS.ReadFile(fmt.Sprintf("icons/%s-%s.png", name, theme)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // Icons returns MCP Icon objects for the given octicon name in light and dark themes.
 // Icons are embedded as 24x24 PNG data URIs for offline use and faster loading.
 // The name should be the base octicon name without size suffix (e.g., "repo" not "repo-16").
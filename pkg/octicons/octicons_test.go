@@ -117,3 +117,11 @@ func TestEmbeddedIconsExist(t *testing.T) {
 		})
 	}
 }
+
+func TestIsEmbedded(t *testing.T) {
+	for _, icon := range RequiredIcons() {
+		assert.True(t, IsEmbedded(icon), "icon %s should be embedded", icon)
+	}
+	assert.False(t, IsEmbedded("nonexistent-icon"))
+	assert.False(t, IsEmbedded(""))
+}
@@ -53,11 +53,17 @@ const (
 	// UserEmail grants read access to user email addresses
 	UserEmail Scope = "user:email"
 
+	// UserFollow grants access to follow or unfollow other users
+	UserFollow Scope = "user:follow"
+
 	// ReadPackages grants read access to packages
 	ReadPackages Scope = "read:packages"
 
 	// WritePackages grants write access to packages
 	WritePackages Scope = "write:packages"
+
+	// DeleteRepo grants access to delete repositories
+	DeleteRepo Scope = "delete_repo"
 )
 
 // ScopeHierarchy defines parent-child relationships between scopes.
@@ -69,7 +75,7 @@ var ScopeHierarchy = map[Scope][]Scope{
 	WriteOrg:      {ReadOrg},
 	Project:       {ReadProject},
 	WritePackages: {ReadPackages},
-	User:          {ReadUser, UserEmail},
+	User:          {ReadUser, UserEmail, UserFollow},
 }
 
 // ScopeSet represents a set of OAuth scopes.
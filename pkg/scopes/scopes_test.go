@@ -189,12 +189,13 @@ func TestExpandScopeSet(t *testing.T) {
 			},
 		},
 		{
-			name:   "user expands to include read:user and user:email",
+			name:   "user expands to include read:user, user:email and user:follow",
 			scopes: []string{"user"},
 			expected: map[string]bool{
-				"user":       true,
-				"read:user":  true,
-				"user:email": true,
+				"user":        true,
+				"read:user":   true,
+				"user:email":  true,
+				"user:follow": true,
 			},
 		},
 		{
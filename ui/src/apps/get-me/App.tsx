@@ -15,6 +15,7 @@ import { useMcpApp } from "../../hooks/useMcpApp";
 
 interface UserData {
   login: string;
+  name?: string;
   avatar_url?: string;
   details?: {
     name?: string;
@@ -80,7 +81,7 @@ function UserCard({ user }: { user: UserData }) {
         <AvatarWithFallback src={user.avatar_url} login={user.login} size={48} />
         <Box>
           <Heading as="h2" sx={{ fontSize: 2, mb: 0 }}>
-            {d.name || user.login}
+            {d.name || user.name || user.login}
           </Heading>
           <Text sx={{ color: "fg.muted", fontSize: 1 }}>@{user.login}</Text>
         </Box>